@@ -10,9 +10,16 @@ import (
 	"time"
 
 	"github.com/pirellik/sequence-api/internal/config"
+	"github.com/pirellik/sequence-api/internal/contact"
 	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/deadletter"
+	"github.com/pirellik/sequence-api/internal/enrollment"
+	"github.com/pirellik/sequence-api/internal/idempotency"
+	"github.com/pirellik/sequence-api/internal/mailbox"
+	"github.com/pirellik/sequence-api/internal/secret"
 	"github.com/pirellik/sequence-api/internal/sequence"
 	"github.com/pirellik/sequence-api/internal/server"
+	"github.com/pirellik/sequence-api/internal/tracking"
 	"github.com/pirellik/sequence-api/pkg/logger"
 )
 
@@ -43,9 +50,36 @@ func main() {
 		os.Exit(1)
 	}
 
-	seqService := sequence.NewService(dbPool)
-	handler := server.NewHandler(seqService)
-	srv := server.New(handler, cfg.API.Port)
+	idempotencyStore := idempotency.NewStore(dbPool)
+	go idempotencyStore.PurgeExpired(ctx, time.Hour)
+
+	contactService := contact.NewService(dbPool)
+	enrollmentService := enrollment.NewService(dbPool)
+	deadLetterService := deadletter.NewService(dbPool)
+
+	box, err := secret.NewBox(cfg.Mailbox.EncryptionKey)
+	if err != nil {
+		slog.ErrorContext(ctx, "loading mailbox encryption key", "env", "MAILBOX_ENCRYPTION_KEY", "err", err)
+		os.Exit(1)
+	}
+	mailboxService := mailbox.NewService(dbPool, box)
+
+	signer, err := tracking.NewSigner(cfg.Tracking.SigningKey)
+	if err != nil {
+		slog.ErrorContext(ctx, "loading tracking signing key", "env", "TRACKING_SIGNING_KEY", "err", err)
+		os.Exit(1)
+	}
+	trackingService := tracking.NewService(dbPool, signer)
+	seqService := sequence.NewService(dbPool, &tracking.URLs{BaseURL: cfg.Tracking.BaseURL, Signer: signer})
+
+	handler := server.NewHandler(seqService, contactService, enrollmentService, deadLetterService, mailboxService, trackingService)
+	srv := server.New(handler, server.Options{
+		Port:             cfg.API.Port,
+		IdempotencyStore: idempotencyStore,
+		IdempotencyTTL:   cfg.API.IdempotencyTTL,
+		IdempotencyLease: cfg.API.IdempotencyLease,
+		TrustedProxies:   cfg.API.TrustedProxies,
+	})
 
 	go func() {
 		slog.InfoContext(ctx, "starting api server", "addr", srv.Addr)
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pirellik/sequence-api/internal/config"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/queue"
+	"github.com/pirellik/sequence-api/internal/scheduler"
+	"github.com/pirellik/sequence-api/pkg/logger"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load(nil)
+	if err != nil {
+		log.Fatal(ctx, "loading config", "err", err)
+	}
+
+	logger := logger.New(
+		cfg.Logger.SlogLevel(),
+		cfg.Logger.HumanReadable,
+	)
+	slog.SetDefault(logger)
+
+	dbPool, err := db.New(ctx, cfg.DB.URL())
+	if err != nil {
+		slog.ErrorContext(ctx, "initializing db", "err", err)
+		os.Exit(1)
+	}
+	defer dbPool.Close()
+
+	// Migrations are run by the API, the scheduler only waits for due steps.
+	sendQueue := queue.NewPostgres(dbPool, scheduler.SendQueue)
+	s := scheduler.New(dbPool, sendQueue, scheduler.Options{
+		Interval:  cfg.Scheduler.Interval,
+		BatchSize: cfg.Scheduler.BatchSize,
+	})
+
+	slog.InfoContext(ctx, "starting scheduler", "interval", cfg.Scheduler.Interval, "batch_size", cfg.Scheduler.BatchSize)
+	s.Run(ctx)
+	slog.InfoContext(ctx, "shutting down the scheduler")
+}
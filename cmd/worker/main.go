@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pirellik/sequence-api/internal/config"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/queue"
+	"github.com/pirellik/sequence-api/internal/scheduler"
+	"github.com/pirellik/sequence-api/internal/secret"
+	"github.com/pirellik/sequence-api/internal/sender"
+	"github.com/pirellik/sequence-api/internal/tracking"
+	"github.com/pirellik/sequence-api/internal/worker"
+	"github.com/pirellik/sequence-api/pkg/logger"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load(nil)
+	if err != nil {
+		log.Fatal(ctx, "loading config", "err", err)
+	}
+
+	logger := logger.New(
+		cfg.Logger.SlogLevel(),
+		cfg.Logger.HumanReadable,
+	)
+	slog.SetDefault(logger)
+
+	dbPool, err := db.New(ctx, cfg.DB.URL())
+	if err != nil {
+		slog.ErrorContext(ctx, "initializing db", "err", err)
+		os.Exit(1)
+	}
+	defer dbPool.Close()
+
+	opts := worker.Options{
+		Concurrency:       cfg.Worker.Concurrency,
+		VisibilityTimeout: cfg.Worker.VisibilityTimeout,
+		PollInterval:      cfg.Worker.PollInterval,
+		SendTimeout:       cfg.Worker.SendTimeout,
+		Retry: worker.RetryPolicy{
+			MaxAttempts: cfg.Worker.MaxAttempts,
+			BaseDelay:   cfg.Worker.RetryBaseDelay,
+			MaxDelay:    cfg.Worker.RetryMaxDelay,
+		},
+		From: cfg.Worker.From,
+	}
+
+	signer, err := tracking.NewSigner(cfg.Tracking.SigningKey)
+	if err != nil {
+		slog.ErrorContext(ctx, "loading tracking signing key", "env", "TRACKING_SIGNING_KEY", "err", err)
+		os.Exit(1)
+	}
+	opts.Tracking = &tracking.URLs{BaseURL: cfg.Tracking.BaseURL, Signer: signer}
+
+	var s sender.Sender
+	switch cfg.Worker.Sender {
+	case "smtp":
+		s = sender.NewSMTP(sender.SMTPOptions{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+		})
+		opts.Mailbox = func(account sender.SMTPOptions) sender.Sender {
+			return sender.NewSMTP(account)
+		}
+		opts.Secrets, err = secret.NewBox(cfg.Mailbox.EncryptionKey)
+		if err != nil {
+			slog.ErrorContext(ctx, "loading mailbox encryption key", "env", "MAILBOX_ENCRYPTION_KEY", "err", err)
+			os.Exit(1)
+		}
+	case "file":
+		s = sender.NewFile(cfg.Worker.OutboxDir)
+	default:
+		slog.ErrorContext(ctx, "unknown sender", "sender", cfg.Worker.Sender)
+		os.Exit(1)
+	}
+
+	w := worker.New(dbPool, queue.NewPostgres(dbPool, scheduler.SendQueue), s, opts)
+
+	slog.InfoContext(ctx, "starting worker", "concurrency", cfg.Worker.Concurrency, "sender", cfg.Worker.Sender)
+	w.Run(ctx)
+	slog.InfoContext(ctx, "shutting down the worker")
+}
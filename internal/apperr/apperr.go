@@ -0,0 +1,56 @@
+// Package apperr defines the domain errors returned by the services. The
+// HTTP layer maps them onto status codes in one place.
+package apperr
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrSequenceNotFound = errors.New("sequence not found")
+	ErrStepNotFound     = errors.New("sequence step not found")
+	ErrConflict         = errors.New("conflict")
+	ErrValidation       = errors.New("validation failed")
+	ErrVersionMismatch  = errors.New("version mismatch")
+	ErrContactNotFound  = errors.New("contact not found")
+	ErrEmailTaken       = errors.New("email already taken")
+	ErrSequenceArchived = errors.New("sequence is archived")
+
+	ErrEnrollmentNotFound = errors.New("enrollment not found")
+	ErrAlreadyEnrolled    = errors.New("contact already enrolled")
+	ErrInvalidTransition  = errors.New("invalid enrollment state transition")
+
+	ErrDeadLetterNotFound = errors.New("dead letter not found")
+	ErrMailboxNotFound    = errors.New("mailbox not found")
+	ErrMailboxInUse       = errors.New("mailbox has unsent emails")
+)
+
+// FieldError describes a problem with a single input field. Field uses the
+// JSON name of the field, with nested fields separated by dots and array
+// indexes in brackets, e.g. "steps[1].emailSubject".
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError carries the field level details of an ErrValidation.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func Validation(fields ...FieldError) *ValidationError {
+	return &ValidationError{Fields: fields}
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		msgs[i] = f.Field + ": " + f.Message
+	}
+	return ErrValidation.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
@@ -0,0 +1,25 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationError(t *testing.T) {
+	err := Validation(
+		FieldError{Field: "name", Message: "must not be empty"},
+		FieldError{Field: "steps[0].emailSubject", Message: "too long"},
+	)
+
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.ErrorIs(t, fmt.Errorf("wrapped: %w", err), ErrValidation)
+	assert.NotErrorIs(t, err, ErrConflict)
+	assert.Equal(t, "validation failed: name: must not be empty; steps[0].emailSubject: too long", err.Error())
+
+	var validationErr *ValidationError
+	assert.True(t, errors.As(fmt.Errorf("wrapped: %w", err), &validationErr))
+	assert.Len(t, validationErr.Fields, 2)
+}
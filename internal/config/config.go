@@ -3,18 +3,79 @@ package config
 import (
 	"fmt"
 	"log/slog"
+	"net/netip"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
 
 type Config struct {
-	API    API    `envPrefix:"API_"`
-	DB     DB     `envPrefix:"DB_"`
-	Logger Logger `envPrefix:"LOGGER_"`
+	API       API       `envPrefix:"API_"`
+	DB        DB        `envPrefix:"DB_"`
+	Logger    Logger    `envPrefix:"LOGGER_"`
+	Scheduler Scheduler `envPrefix:"SCHEDULER_"`
+	Worker    Worker    `envPrefix:"WORKER_"`
+	SMTP      SMTP      `envPrefix:"SMTP_"`
+	Mailbox   Mailbox   `envPrefix:"MAILBOX_"`
+	Tracking  Tracking  `envPrefix:"TRACKING_"`
 }
 
 type API struct {
 	Port int `env:"PORT" envDefault:"8080"`
+	// IdempotencyTTL is how long responses to requests sent with an
+	// Idempotency-Key header are kept for replay.
+	IdempotencyTTL time.Duration `env:"IDEMPOTENCY_TTL" envDefault:"24h"`
+	// IdempotencyLease is how long a request in progress holds its
+	// Idempotency-Key. Retries after that are processed again, so it has to
+	// be longer than requests take.
+	IdempotencyLease time.Duration `env:"IDEMPOTENCY_LEASE" envDefault:"1m"`
+	// TrustedProxies are the comma separated networks of the proxies in front
+	// of the API, e.g. `10.0.0.0/8`. The client IP addresses recorded with
+	// tracking events are only taken from X-Forwarded-For headers they set.
+	TrustedProxies []netip.Prefix `env:"TRUSTED_PROXIES"`
+}
+
+type Scheduler struct {
+	// Interval is how often due enrollments are polled for.
+	Interval  time.Duration `env:"INTERVAL" envDefault:"10s"`
+	BatchSize int           `env:"BATCH_SIZE" envDefault:"100"`
+}
+
+type Worker struct {
+	Concurrency       int           `env:"CONCURRENCY" envDefault:"4"`
+	VisibilityTimeout time.Duration `env:"VISIBILITY_TIMEOUT" envDefault:"1m"`
+	PollInterval      time.Duration `env:"POLL_INTERVAL" envDefault:"1s"`
+	SendTimeout       time.Duration `env:"SEND_TIMEOUT" envDefault:"2m"`
+	MaxAttempts       int           `env:"MAX_ATTEMPTS" envDefault:"5"`
+	RetryBaseDelay    time.Duration `env:"RETRY_BASE_DELAY" envDefault:"1m"`
+	RetryMaxDelay     time.Duration `env:"RETRY_MAX_DELAY" envDefault:"1h"`
+	From              string        `env:"FROM" envDefault:"Sequence API <no-reply@example.com>"`
+	// Sender is "smtp" to send through the SMTP server or "file" to write
+	// the emails into OutboxDir instead.
+	Sender    string `env:"SENDER" envDefault:"file"`
+	OutboxDir string `env:"OUTBOX_DIR" envDefault:"outbox"`
+}
+
+type SMTP struct {
+	Host     string `env:"HOST"`
+	Port     int    `env:"PORT" envDefault:"587"`
+	Username string `env:"USERNAME"`
+	Password string `env:"PASSWORD"`
+}
+
+type Mailbox struct {
+	// EncryptionKey encrypts the stored SMTP passwords of mailboxes. It is
+	// the base64 encoding of 32 random bytes, e.g. `openssl rand -base64 32`.
+	EncryptionKey string `env:"ENCRYPTION_KEY"`
+}
+
+type Tracking struct {
+	// BaseURL is where contacts reach the API, the tracking URLs in emails
+	// start with it.
+	BaseURL string `env:"BASE_URL" envDefault:"http://localhost:8080"`
+	// SigningKey signs the tracking URLs. It is the base64 encoding of 32
+	// random bytes, e.g. `openssl rand -base64 32`.
+	SigningKey string `env:"SIGNING_KEY"`
 }
 
 type DB struct {
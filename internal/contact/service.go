@@ -0,0 +1,183 @@
+// Package contact manages the people sequences are sent to.
+package contact
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/paging"
+)
+
+// emailConstraint is the unique index on lower(email).
+const emailConstraint = "contacts_email_key"
+
+type Service struct {
+	db *pgxpool.Pool
+}
+
+type ListContactsParams struct {
+	// Query filters contacts whose email or name contains it, ignoring case.
+	Query  *string
+	Cursor *string
+	Limit  int
+}
+
+type ContactPage struct {
+	Contacts   []*models.Contact
+	NextCursor *string
+}
+
+func NewService(db *pgxpool.Pool) *Service {
+	return &Service{db: db}
+}
+
+// dbError replaces pgx.ErrNoRows and violations of the email index with the
+// matching domain errors.
+func dbError(err error) error {
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return apperr.ErrContactNotFound
+	case errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == emailConstraint:
+		return apperr.ErrEmailTaken
+	default:
+		return err
+	}
+}
+
+// customFields defaults missing custom fields to an empty JSON object.
+func customFields(contact *models.Contact) []byte {
+	if len(contact.CustomFields) == 0 {
+		return []byte("{}")
+	}
+	return contact.CustomFields
+}
+
+func (s *Service) CreateContact(ctx context.Context, contact *models.Contact) (*models.Contact, error) {
+	var created *models.Contact
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		id, err := q.CreateContact(ctx, &models.CreateContactParams{
+			Email:        contact.Email,
+			FirstName:    contact.FirstName,
+			LastName:     contact.LastName,
+			CustomFields: customFields(contact),
+			TimeZone:     contact.TimeZone,
+		})
+		if err != nil {
+			return dbError(err)
+		}
+
+		created, err = q.GetContactByID(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *Service) GetContact(ctx context.Context, id uuid.UUID) (*models.Contact, error) {
+	var contact *models.Contact
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		var err error
+		contact, err = q.GetContactByID(ctx, id)
+		return dbError(err)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+func (s *Service) ListContacts(ctx context.Context, params ListContactsParams) (*ContactPage, error) {
+	p := models.ListContactsParams{
+		// One extra row tells us whether there is a next page.
+		Limit: int32(params.Limit) + 1,
+	}
+	if params.Query != nil {
+		p.Query = pgtype.Text{String: db.EscapeLike(*params.Query), Valid: true}
+	}
+	if params.Cursor != nil {
+		after, err := paging.DecodeNewest(*params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		p.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+		p.CursorCreatedAt = pgtype.Timestamptz{Time: after.CreatedAt, Valid: true}
+	}
+
+	page := &ContactPage{}
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		contacts, err := q.ListContacts(ctx, &p)
+		if err != nil {
+			return err
+		}
+
+		if len(contacts) > params.Limit {
+			contacts = contacts[:params.Limit]
+			last := contacts[len(contacts)-1]
+			next := paging.Newest{ID: last.ID, CreatedAt: last.CreatedAt.Time}.Encode()
+			page.NextCursor = &next
+		}
+		page.Contacts = contacts
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// UpdateContact replaces all fields of the contact.
+func (s *Service) UpdateContact(ctx context.Context, id uuid.UUID, contact *models.Contact) (*models.Contact, error) {
+	var updated *models.Contact
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		rows, err := q.UpdateContact(ctx, &models.UpdateContactParams{
+			ID:           id,
+			Email:        contact.Email,
+			FirstName:    contact.FirstName,
+			LastName:     contact.LastName,
+			CustomFields: customFields(contact),
+			TimeZone:     contact.TimeZone,
+		})
+		if err != nil {
+			return dbError(err)
+		}
+		if rows == 0 {
+			return apperr.ErrContactNotFound
+		}
+
+		updated, err = q.GetContactByID(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *Service) DeleteContact(ctx context.Context, id uuid.UUID) error {
+	return db.InTx(ctx, s.db, func(q *models.Queries) error {
+		rows, err := q.DeleteContact(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return apperr.ErrContactNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,92 @@
+package contact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	svc := NewService(dbtest.New(t, ""))
+	ctx := context.Background()
+
+	created, err := svc.CreateContact(ctx, &models.Contact{
+		Email:        "Jane.Doe@example.com",
+		FirstName:    "Jane",
+		LastName:     "Doe",
+		CustomFields: []byte(`{"company":"Acme"}`),
+	})
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, created.ID)
+	assert.Equal(t, "Jane.Doe@example.com", created.Email)
+	assert.JSONEq(t, `{"company":"Acme"}`, string(created.CustomFields))
+
+	t.Run("email is unique regardless of case", func(t *testing.T) {
+		_, err := svc.CreateContact(ctx, &models.Contact{Email: "jane.doe@EXAMPLE.com"})
+		assert.ErrorIs(t, err, apperr.ErrEmailTaken)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		found, err := svc.GetContact(ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.Email, found.Email)
+
+		_, err = svc.GetContact(ctx, uuid.New())
+		assert.ErrorIs(t, err, apperr.ErrContactNotFound)
+	})
+
+	t.Run("update replaces all fields", func(t *testing.T) {
+		updated, err := svc.UpdateContact(ctx, created.ID, &models.Contact{Email: "jane@example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, "jane@example.com", updated.Email)
+		assert.Empty(t, updated.FirstName)
+		assert.JSONEq(t, `{}`, string(updated.CustomFields))
+
+		_, err = svc.UpdateContact(ctx, uuid.New(), &models.Contact{Email: "x@example.com"})
+		assert.ErrorIs(t, err, apperr.ErrContactNotFound)
+	})
+
+	t.Run("update to a taken email", func(t *testing.T) {
+		other, err := svc.CreateContact(ctx, &models.Contact{Email: "john@example.com"})
+		require.NoError(t, err)
+
+		_, err = svc.UpdateContact(ctx, other.ID, &models.Contact{Email: "JANE@example.com"})
+		assert.ErrorIs(t, err, apperr.ErrEmailTaken)
+	})
+
+	t.Run("list pages newest first", func(t *testing.T) {
+		first, err := svc.ListContacts(ctx, ListContactsParams{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, first.Contacts, 1)
+		assert.Equal(t, "john@example.com", first.Contacts[0].Email)
+		require.NotNil(t, first.NextCursor)
+
+		second, err := svc.ListContacts(ctx, ListContactsParams{Cursor: first.NextCursor, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, second.Contacts, 1)
+		assert.Equal(t, "jane@example.com", second.Contacts[0].Email)
+		assert.Nil(t, second.NextCursor)
+	})
+
+	t.Run("list filters by query", func(t *testing.T) {
+		page, err := svc.ListContacts(ctx, ListContactsParams{Query: pointer.To("JOHN"), Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, page.Contacts, 1)
+		assert.Equal(t, "john@example.com", page.Contacts[0].Email)
+
+		_, err = svc.ListContacts(ctx, ListContactsParams{Cursor: pointer.To("garbage"), Limit: 10})
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, svc.DeleteContact(ctx, created.ID))
+		assert.ErrorIs(t, svc.DeleteContact(ctx, created.ID), apperr.ErrContactNotFound)
+	})
+}
@@ -0,0 +1,67 @@
+// Package dbtest starts a throwaway Postgres instance for tests that need a
+// real database. It requires a running docker daemon.
+package dbtest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-testfixtures/testfixtures/v3"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/postgres"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// New starts Postgres, runs the migrations and loads the fixtures found in
+// fixturesDir, if set. The database is torn down when the test finishes.
+func New(t *testing.T, fixturesDir string) *pgxpool.Pool {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := postgres.Run(ctx,
+		"postgres:17.5",
+		postgres.WithDatabase("testdb"),
+		postgres.WithUsername("test"),
+		postgres.WithPassword("test"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(5*time.Second),
+		),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, container.Terminate(context.Background()))
+	})
+
+	connString, err := container.ConnectionString(ctx, "sslmode=disable")
+	require.NoError(t, err)
+
+	require.NoError(t, db.MigrateUp(connString))
+
+	if fixturesDir != "" {
+		sqlDB, err := sql.Open("postgres", connString)
+		require.NoError(t, err)
+		defer sqlDB.Close()
+
+		fixtures, err := testfixtures.New(
+			testfixtures.Database(sqlDB),
+			testfixtures.Dialect("postgres"),
+			testfixtures.Directory(fixturesDir),
+		)
+		require.NoError(t, err)
+		require.NoError(t, fixtures.Load())
+	}
+
+	pool, err := pgxpool.New(ctx, connString)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
@@ -0,0 +1,11 @@
+package db
+
+import "strings"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes the LIKE wildcards so that a substring filter matches
+// the given text literally.
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
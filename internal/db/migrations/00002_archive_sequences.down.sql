@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS sequence_steps_sequence_id_idx;
+
+ALTER TABLE sequence_steps
+    DROP CONSTRAINT sequence_steps_sequence_id_fkey,
+    ADD CONSTRAINT sequence_steps_sequence_id_fkey
+        FOREIGN KEY (sequence_id) REFERENCES sequences(id);
+
+ALTER TABLE sequences DROP COLUMN IF EXISTS archived_at;
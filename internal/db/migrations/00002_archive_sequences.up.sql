@@ -0,0 +1,8 @@
+ALTER TABLE sequences ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE sequence_steps
+    DROP CONSTRAINT sequence_steps_sequence_id_fkey,
+    ADD CONSTRAINT sequence_steps_sequence_id_fkey
+        FOREIGN KEY (sequence_id) REFERENCES sequences(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS sequence_steps_sequence_id_idx ON sequence_steps (sequence_id);
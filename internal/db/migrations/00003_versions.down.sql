@@ -0,0 +1,2 @@
+ALTER TABLE sequence_steps DROP COLUMN IF EXISTS version;
+ALTER TABLE sequences DROP COLUMN IF EXISTS version;
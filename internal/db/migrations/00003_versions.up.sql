@@ -0,0 +1,2 @@
+ALTER TABLE sequences ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE sequence_steps ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS enrollments;
+DROP TYPE IF EXISTS enrollment_state;
@@ -0,0 +1,18 @@
+CREATE TYPE enrollment_state AS ENUM ('active', 'paused', 'completed', 'stopped', 'failed');
+
+-- current_step_id is the next step to send and next_send_at is when it is due.
+-- Both are NULL once there is nothing left to send.
+CREATE TABLE IF NOT EXISTS enrollments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    sequence_id UUID NOT NULL REFERENCES sequences(id) ON DELETE CASCADE,
+    contact_id UUID NOT NULL REFERENCES contacts(id) ON DELETE CASCADE,
+    state enrollment_state NOT NULL DEFAULT 'active',
+    current_step_id UUID REFERENCES sequence_steps(id) ON DELETE SET NULL,
+    next_send_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS enrollments_sequence_contact_key ON enrollments (sequence_id, contact_id);
+CREATE INDEX IF NOT EXISTS enrollments_contact_id_idx ON enrollments (contact_id);
+CREATE INDEX IF NOT EXISTS enrollments_due_idx ON enrollments (next_send_at) WHERE state = 'active';
@@ -0,0 +1,14 @@
+-- A send job is one step of an enrollment that has fallen due. The subject and
+-- content are copied from the step so that later edits of the sequence do not
+-- change emails that are already on their way.
+CREATE TABLE IF NOT EXISTS send_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    enrollment_id UUID NOT NULL REFERENCES enrollments(id) ON DELETE CASCADE,
+    step_id UUID REFERENCES sequence_steps(id) ON DELETE SET NULL,
+    email_subject VARCHAR(255) NOT NULL,
+    email_content TEXT NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS send_jobs_enrollment_step_key ON send_jobs (enrollment_id, step_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS send_jobs_unpublished_idx;
+ALTER TABLE send_jobs DROP COLUMN IF EXISTS enqueued_at;
+DROP TABLE IF EXISTS queue_messages;
@@ -0,0 +1,20 @@
+-- Messages are hidden from receivers until visible_at. Receiving a message
+-- pushes visible_at out by the visibility timeout and hands out a new receipt,
+-- which is required to ack, nack or extend it.
+CREATE TABLE IF NOT EXISTS queue_messages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    queue VARCHAR(255) NOT NULL,
+    body BYTEA NOT NULL,
+    visible_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    receipt UUID,
+    attempts INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS queue_messages_visible_idx ON queue_messages (queue, visible_at);
+
+-- Send jobs are published to the queue after the transaction creating them
+-- has committed; enqueued_at stays NULL until then.
+ALTER TABLE send_jobs ADD COLUMN IF NOT EXISTS enqueued_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS send_jobs_unpublished_idx ON send_jobs (created_at) WHERE enqueued_at IS NULL;
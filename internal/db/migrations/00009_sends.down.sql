@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS sends;
+DROP TYPE IF EXISTS send_status;
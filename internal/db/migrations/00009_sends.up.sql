@@ -0,0 +1,14 @@
+CREATE TYPE send_status AS ENUM ('sent', 'failed');
+
+-- Every attempt to deliver a send job is recorded, successful or not.
+CREATE TABLE IF NOT EXISTS sends (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    send_job_id UUID NOT NULL REFERENCES send_jobs(id) ON DELETE CASCADE,
+    attempt INT NOT NULL,
+    status send_status NOT NULL,
+    error TEXT,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS sends_send_job_id_idx ON sends (send_job_id);
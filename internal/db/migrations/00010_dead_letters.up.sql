@@ -0,0 +1,12 @@
+-- A dead letter is a send job the worker gave up on, either because it failed
+-- permanently or because it ran out of attempts. It stays here until it is
+-- requeued or its job is deleted.
+CREATE TABLE IF NOT EXISTS dead_letters (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    send_job_id UUID NOT NULL UNIQUE REFERENCES send_jobs(id) ON DELETE CASCADE,
+    attempts INT NOT NULL,
+    error TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS dead_letters_created_at_idx ON dead_letters (created_at DESC, id DESC);
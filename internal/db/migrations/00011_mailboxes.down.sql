@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS send_jobs_mailbox_id_created_at_idx;
+ALTER TABLE send_jobs DROP COLUMN IF EXISTS mailbox_id;
+DROP TABLE IF EXISTS sequence_mailboxes;
+ALTER TABLE sequences DROP COLUMN IF EXISTS mailbox_rotation;
+DROP TYPE IF EXISTS mailbox_rotation;
+DROP TABLE IF EXISTS mailboxes;
@@ -0,0 +1,33 @@
+-- A mailbox is an account emails are sent from. The SMTP password is
+-- encrypted by the application before it is stored.
+CREATE TABLE IF NOT EXISTS mailboxes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    from_name VARCHAR(255) NOT NULL DEFAULT '',
+    from_address VARCHAR(320) NOT NULL,
+    smtp_host VARCHAR(255) NOT NULL,
+    smtp_port INT NOT NULL,
+    smtp_username VARCHAR(255) NOT NULL DEFAULT '',
+    smtp_password BYTEA,
+    daily_limit INT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TYPE mailbox_rotation AS ENUM ('round_robin', 'least_used');
+
+ALTER TABLE sequences ADD COLUMN mailbox_rotation mailbox_rotation NOT NULL DEFAULT 'round_robin';
+
+-- The mailboxes a sequence sends from. last_used_at drives the round-robin
+-- rotation.
+CREATE TABLE IF NOT EXISTS sequence_mailboxes (
+    sequence_id UUID NOT NULL REFERENCES sequences(id) ON DELETE CASCADE,
+    mailbox_id UUID NOT NULL REFERENCES mailboxes(id) ON DELETE CASCADE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (sequence_id, mailbox_id)
+);
+
+CREATE INDEX IF NOT EXISTS sequence_mailboxes_mailbox_id_idx ON sequence_mailboxes (mailbox_id);
+
+ALTER TABLE send_jobs ADD COLUMN mailbox_id UUID REFERENCES mailboxes(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS send_jobs_mailbox_id_created_at_idx ON send_jobs (mailbox_id, created_at);
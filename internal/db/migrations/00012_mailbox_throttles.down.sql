@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS mailbox_throttles;
+ALTER TABLE mailboxes DROP COLUMN IF EXISTS send_burst, DROP COLUMN IF EXISTS send_interval_seconds;
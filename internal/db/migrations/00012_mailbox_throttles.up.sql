@@ -0,0 +1,14 @@
+-- Sends of a mailbox are spaced out by a token bucket earning a token every
+-- send_interval_seconds and holding at most send_burst of them.
+ALTER TABLE mailboxes
+    ADD COLUMN send_interval_seconds INT NOT NULL DEFAULT 60,
+    ADD COLUMN send_burst INT NOT NULL DEFAULT 1;
+
+-- The throttling state of a mailbox, created when it first sends.
+CREATE TABLE IF NOT EXISTS mailbox_throttles (
+    mailbox_id UUID PRIMARY KEY REFERENCES mailboxes(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    sent INT NOT NULL,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,9 @@
+ALTER TABLE contacts DROP COLUMN IF EXISTS time_zone;
+ALTER TABLE sequences
+    DROP CONSTRAINT IF EXISTS sequences_send_hours_check,
+    DROP COLUMN IF EXISTS use_contact_time_zone,
+    DROP COLUMN IF EXISTS send_holidays,
+    DROP COLUMN IF EXISTS send_end_hour,
+    DROP COLUMN IF EXISTS send_start_hour,
+    DROP COLUMN IF EXISTS send_weekdays,
+    DROP COLUMN IF EXISTS send_time_zone;
@@ -0,0 +1,14 @@
+-- The sending schedule of a sequence. Weekdays count from Sunday as 0, hours
+-- are on the wall clock of send_time_zone, or of the time zone of the contact
+-- when use_contact_time_zone is set and the contact has one.
+ALTER TABLE sequences
+    ADD COLUMN send_time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    ADD COLUMN send_weekdays INT[] NOT NULL DEFAULT '{0,1,2,3,4,5,6}',
+    ADD COLUMN send_start_hour INT NOT NULL DEFAULT 0,
+    ADD COLUMN send_end_hour INT NOT NULL DEFAULT 24,
+    ADD COLUMN send_holidays DATE[] NOT NULL DEFAULT '{}',
+    ADD COLUMN use_contact_time_zone BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD CONSTRAINT sequences_send_hours_check
+        CHECK (send_start_hour >= 0 AND send_start_hour < send_end_hour AND send_end_hour <= 24);
+
+ALTER TABLE contacts ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT '';
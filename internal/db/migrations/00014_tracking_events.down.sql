@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS tracking_events;
+DROP TYPE IF EXISTS tracking_event_type;
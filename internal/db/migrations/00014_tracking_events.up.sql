@@ -0,0 +1,16 @@
+CREATE TYPE tracking_event_type AS ENUM ('open');
+
+-- Opens of sent emails, reported by the tracking pixel.
+CREATE TABLE IF NOT EXISTS tracking_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    send_job_id UUID NOT NULL REFERENCES send_jobs(id) ON DELETE CASCADE,
+    type tracking_event_type NOT NULL,
+    user_agent TEXT NOT NULL DEFAULT '',
+    ip_address INET,
+    -- Why the event looks like it was caused by software rather than by the
+    -- contact, such as 'apple_mpp' or 'bot'. Empty for events that do not.
+    automated VARCHAR(32) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS tracking_events_send_job_id_idx ON tracking_events (send_job_id, type);
@@ -0,0 +1,8 @@
+DELETE FROM tracking_events WHERE type = 'click';
+ALTER TABLE tracking_events DROP COLUMN IF EXISTS url;
+
+-- Enum values cannot be dropped, so the type is replaced by one without it.
+ALTER TYPE tracking_event_type RENAME TO tracking_event_type_old;
+CREATE TYPE tracking_event_type AS ENUM ('open');
+ALTER TABLE tracking_events ALTER COLUMN type TYPE tracking_event_type USING type::text::tracking_event_type;
+DROP TYPE tracking_event_type_old;
@@ -0,0 +1,4 @@
+ALTER TYPE tracking_event_type ADD VALUE IF NOT EXISTS 'click';
+
+-- url is the link that was clicked, it is only set for clicks.
+ALTER TABLE tracking_events ADD COLUMN IF NOT EXISTS url TEXT;
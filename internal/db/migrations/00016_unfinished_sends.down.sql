@@ -0,0 +1,9 @@
+DELETE FROM sends WHERE status = 'sending';
+ALTER TABLE sends ALTER COLUMN finished_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE sends ALTER COLUMN finished_at SET NOT NULL;
+
+-- Enum values cannot be dropped, so the type is replaced by one without it.
+ALTER TYPE send_status RENAME TO send_status_old;
+CREATE TYPE send_status AS ENUM ('sent', 'failed');
+ALTER TABLE sends ALTER COLUMN status TYPE send_status USING status::text::send_status;
+DROP TYPE send_status_old;
@@ -0,0 +1,6 @@
+ALTER TYPE send_status ADD VALUE IF NOT EXISTS 'sending';
+
+-- Attempts are recorded as 'sending' before the email is handed to the mail
+-- server and finished afterwards, so finished_at is NULL until then.
+ALTER TABLE sends ALTER COLUMN finished_at DROP DEFAULT;
+ALTER TABLE sends ALTER COLUMN finished_at DROP NOT NULL;
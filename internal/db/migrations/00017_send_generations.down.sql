@@ -0,0 +1,2 @@
+ALTER TABLE sends DROP COLUMN IF EXISTS generation;
+ALTER TABLE send_jobs DROP COLUMN IF EXISTS generation;
@@ -0,0 +1,13 @@
+-- Requeuing a dead letter starts a new generation of its send job, and only
+-- the sends of the current generation count as attempts. Counting them by
+-- time instead depends on the clocks of the workers and on when the job is
+-- marked as published.
+ALTER TABLE send_jobs ADD COLUMN IF NOT EXISTS generation INT NOT NULL DEFAULT 0;
+ALTER TABLE sends ADD COLUMN IF NOT EXISTS generation INT NOT NULL DEFAULT 0;
+
+-- Jobs requeued so far have sends from before they were last published.
+UPDATE send_jobs j SET generation = 1
+WHERE EXISTS (SELECT 1 FROM sends s WHERE s.send_job_id = j.id AND s.started_at < j.enqueued_at);
+UPDATE sends s SET generation = 1
+FROM send_jobs j
+WHERE j.id = s.send_job_id AND j.generation = 1 AND s.started_at >= j.enqueued_at;
@@ -5,10 +5,283 @@
 package models
 
 import (
+	"database/sql/driver"
+	"fmt"
+	"net/netip"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type EnrollmentState string
+
+const (
+	EnrollmentStateActive    EnrollmentState = "active"
+	EnrollmentStatePaused    EnrollmentState = "paused"
+	EnrollmentStateCompleted EnrollmentState = "completed"
+	EnrollmentStateStopped   EnrollmentState = "stopped"
+	EnrollmentStateFailed    EnrollmentState = "failed"
+)
+
+func (e *EnrollmentState) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EnrollmentState(s)
+	case string:
+		*e = EnrollmentState(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EnrollmentState: %T", src)
+	}
+	return nil
+}
+
+type NullEnrollmentState struct {
+	EnrollmentState EnrollmentState
+	Valid           bool // Valid is true if EnrollmentState is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEnrollmentState) Scan(value interface{}) error {
+	if value == nil {
+		ns.EnrollmentState, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EnrollmentState.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEnrollmentState) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EnrollmentState), nil
+}
+
+type MailboxRotation string
+
+const (
+	MailboxRotationRoundRobin MailboxRotation = "round_robin"
+	MailboxRotationLeastUsed  MailboxRotation = "least_used"
+)
+
+func (e *MailboxRotation) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = MailboxRotation(s)
+	case string:
+		*e = MailboxRotation(s)
+	default:
+		return fmt.Errorf("unsupported scan type for MailboxRotation: %T", src)
+	}
+	return nil
+}
+
+type NullMailboxRotation struct {
+	MailboxRotation MailboxRotation
+	Valid           bool // Valid is true if MailboxRotation is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullMailboxRotation) Scan(value interface{}) error {
+	if value == nil {
+		ns.MailboxRotation, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.MailboxRotation.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullMailboxRotation) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.MailboxRotation), nil
+}
+
+type SendStatus string
+
+const (
+	SendStatusSent    SendStatus = "sent"
+	SendStatusFailed  SendStatus = "failed"
+	SendStatusSending SendStatus = "sending"
+)
+
+func (e *SendStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SendStatus(s)
+	case string:
+		*e = SendStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SendStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSendStatus struct {
+	SendStatus SendStatus
+	Valid      bool // Valid is true if SendStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSendStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SendStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SendStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSendStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SendStatus), nil
+}
+
+type TrackingEventType string
+
+const (
+	TrackingEventTypeOpen  TrackingEventType = "open"
+	TrackingEventTypeClick TrackingEventType = "click"
+)
+
+func (e *TrackingEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TrackingEventType(s)
+	case string:
+		*e = TrackingEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TrackingEventType: %T", src)
+	}
+	return nil
+}
+
+type NullTrackingEventType struct {
+	TrackingEventType TrackingEventType
+	Valid             bool // Valid is true if TrackingEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTrackingEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.TrackingEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TrackingEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTrackingEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TrackingEventType), nil
+}
+
+type Contact struct {
+	ID           uuid.UUID          `db:"id"`
+	Email        string             `db:"email"`
+	FirstName    string             `db:"first_name"`
+	LastName     string             `db:"last_name"`
+	CustomFields []byte             `db:"custom_fields"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `db:"updated_at"`
+	TimeZone     string             `db:"time_zone"`
+}
+
+type DeadLetter struct {
+	ID        uuid.UUID          `db:"id"`
+	SendJobID uuid.UUID          `db:"send_job_id"`
+	Attempts  int32              `db:"attempts"`
+	Error     string             `db:"error"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+type Enrollment struct {
+	ID            uuid.UUID          `db:"id"`
+	SequenceID    uuid.UUID          `db:"sequence_id"`
+	ContactID     uuid.UUID          `db:"contact_id"`
+	State         EnrollmentState    `db:"state"`
+	CurrentStepID pgtype.UUID        `db:"current_step_id"`
+	NextSendAt    pgtype.Timestamptz `db:"next_send_at"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at"`
+}
+
+type IdempotencyKey struct {
+	Key         string             `db:"key"`
+	RequestHash []byte             `db:"request_hash"`
+	StatusCode  pgtype.Int4        `db:"status_code"`
+	Headers     []byte             `db:"headers"`
+	Body        []byte             `db:"body"`
+	CreatedAt   pgtype.Timestamptz `db:"created_at"`
+	ExpiresAt   pgtype.Timestamptz `db:"expires_at"`
+}
+
+type Mailbox struct {
+	ID                  uuid.UUID          `db:"id"`
+	FromName            string             `db:"from_name"`
+	FromAddress         string             `db:"from_address"`
+	SmtpHost            string             `db:"smtp_host"`
+	SmtpPort            int32              `db:"smtp_port"`
+	SmtpUsername        string             `db:"smtp_username"`
+	SmtpPassword        []byte             `db:"smtp_password"`
+	DailyLimit          int32              `db:"daily_limit"`
+	CreatedAt           pgtype.Timestamptz `db:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `db:"updated_at"`
+	SendIntervalSeconds int32              `db:"send_interval_seconds"`
+	SendBurst           int32              `db:"send_burst"`
+}
+
+type MailboxThrottle struct {
+	MailboxID uuid.UUID          `db:"mailbox_id"`
+	Day       pgtype.Date        `db:"day"`
+	Sent      int32              `db:"sent"`
+	Tokens    float64            `db:"tokens"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at"`
+}
+
+type QueueMessage struct {
+	ID        uuid.UUID          `db:"id"`
+	Queue     string             `db:"queue"`
+	Body      []byte             `db:"body"`
+	VisibleAt pgtype.Timestamptz `db:"visible_at"`
+	Receipt   pgtype.UUID        `db:"receipt"`
+	Attempts  int32              `db:"attempts"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+type Send struct {
+	ID         uuid.UUID          `db:"id"`
+	SendJobID  uuid.UUID          `db:"send_job_id"`
+	Attempt    int32              `db:"attempt"`
+	Status     SendStatus         `db:"status"`
+	Error      pgtype.Text        `db:"error"`
+	StartedAt  pgtype.Timestamptz `db:"started_at"`
+	FinishedAt pgtype.Timestamptz `db:"finished_at"`
+	Generation int32              `db:"generation"`
+}
+
+type SendJob struct {
+	ID           uuid.UUID          `db:"id"`
+	EnrollmentID uuid.UUID          `db:"enrollment_id"`
+	StepID       pgtype.UUID        `db:"step_id"`
+	EmailSubject string             `db:"email_subject"`
+	EmailContent string             `db:"email_content"`
+	ScheduledAt  pgtype.Timestamptz `db:"scheduled_at"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+	EnqueuedAt   pgtype.Timestamptz `db:"enqueued_at"`
+	MailboxID    pgtype.UUID        `db:"mailbox_id"`
+	Generation   int32              `db:"generation"`
+}
+
 type Sequence struct {
 	ID                   uuid.UUID          `db:"id"`
 	Name                 string             `db:"name"`
@@ -16,6 +289,21 @@ type Sequence struct {
 	ClickTrackingEnabled bool               `db:"click_tracking_enabled"`
 	CreatedAt            pgtype.Timestamptz `db:"created_at"`
 	UpdatedAt            pgtype.Timestamptz `db:"updated_at"`
+	ArchivedAt           pgtype.Timestamptz `db:"archived_at"`
+	Version              int32              `db:"version"`
+	MailboxRotation      MailboxRotation    `db:"mailbox_rotation"`
+	SendTimeZone         string             `db:"send_time_zone"`
+	SendWeekdays         []int32            `db:"send_weekdays"`
+	SendStartHour        int32              `db:"send_start_hour"`
+	SendEndHour          int32              `db:"send_end_hour"`
+	SendHolidays         []pgtype.Date      `db:"send_holidays"`
+	UseContactTimeZone   bool               `db:"use_contact_time_zone"`
+}
+
+type SequenceMailbox struct {
+	SequenceID uuid.UUID          `db:"sequence_id"`
+	MailboxID  uuid.UUID          `db:"mailbox_id"`
+	LastUsedAt pgtype.Timestamptz `db:"last_used_at"`
 }
 
 type SequenceStep struct {
@@ -27,4 +315,16 @@ type SequenceStep struct {
 	Ordering              float32            `db:"ordering"`
 	CreatedAt             pgtype.Timestamptz `db:"created_at"`
 	UpdatedAt             pgtype.Timestamptz `db:"updated_at"`
+	Version               int32              `db:"version"`
+}
+
+type TrackingEvent struct {
+	ID        uuid.UUID          `db:"id"`
+	SendJobID uuid.UUID          `db:"send_job_id"`
+	Type      TrackingEventType  `db:"type"`
+	UserAgent string             `db:"user_agent"`
+	IpAddress *netip.Addr        `db:"ip_address"`
+	Automated string             `db:"automated"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	Url       pgtype.Text        `db:"url"`
 }
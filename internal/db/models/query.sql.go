@@ -7,10 +7,314 @@ package models
 
 import (
 	"context"
+	"net/netip"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addSequenceMailboxes = `-- name: AddSequenceMailboxes :exec
+INSERT INTO sequence_mailboxes (sequence_id, mailbox_id)
+SELECT $1, unnest($2::uuid[])
+ON CONFLICT DO NOTHING
+`
+
+type AddSequenceMailboxesParams struct {
+	SequenceID uuid.UUID   `db:"sequence_id"`
+	MailboxIds []uuid.UUID `db:"mailbox_ids"`
+}
+
+func (q *Queries) AddSequenceMailboxes(ctx context.Context, arg *AddSequenceMailboxesParams) error {
+	_, err := q.db.Exec(ctx, addSequenceMailboxes, arg.SequenceID, arg.MailboxIds)
+	return err
+}
+
+const advanceEnrollment = `-- name: AdvanceEnrollment :exec
+UPDATE enrollments
+SET current_step_id = $2, next_send_at = $3, updated_at = NOW()
+WHERE id = $1
+`
+
+type AdvanceEnrollmentParams struct {
+	ID            uuid.UUID          `db:"id"`
+	CurrentStepID pgtype.UUID        `db:"current_step_id"`
+	NextSendAt    pgtype.Timestamptz `db:"next_send_at"`
+}
+
+func (q *Queries) AdvanceEnrollment(ctx context.Context, arg *AdvanceEnrollmentParams) error {
+	_, err := q.db.Exec(ctx, advanceEnrollment, arg.ID, arg.CurrentStepID, arg.NextSendAt)
+	return err
+}
+
+const advanceEnrollmentsPastStep = `-- name: AdvanceEnrollmentsPastStep :exec
+UPDATE enrollments e
+SET current_step_id = next_step.id,
+    state = CASE
+      WHEN next_step.id IS NULL AND e.state IN ('active', 'paused') THEN 'completed'
+      ELSE e.state
+    END,
+    next_send_at = CASE WHEN next_step.id IS NULL THEN NULL ELSE e.next_send_at END,
+    updated_at = NOW()
+FROM sequence_steps s
+LEFT JOIN LATERAL (
+  SELECT n.id FROM sequence_steps n
+  WHERE n.sequence_id = s.sequence_id AND n.id <> s.id AND n.ordering > s.ordering
+  ORDER BY n.ordering ASC
+  LIMIT 1
+) next_step ON TRUE
+WHERE s.id = $1 AND e.current_step_id = s.id
+`
+
+// Moves the enrollments waiting for a step that is about to be deleted on to
+// the step after it, completing them when it was the last one.
+func (q *Queries) AdvanceEnrollmentsPastStep(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, advanceEnrollmentsPastStep, id)
+	return err
+}
+
+const archiveSequence = `-- name: ArchiveSequence :execrows
+UPDATE sequences SET archived_at = COALESCE(archived_at, NOW()), version = version + 1, updated_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) ArchiveSequence(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveSequence, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const completeEnrollment = `-- name: CompleteEnrollment :exec
+UPDATE enrollments
+SET state = 'completed', current_step_id = NULL, next_send_at = NULL, updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) CompleteEnrollment(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, completeEnrollment, id)
+	return err
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys SET status_code = $2, headers = $3, body = $4, expires_at = $5 WHERE key = $1
+`
+
+type CompleteIdempotencyKeyParams struct {
+	Key        string             `db:"key"`
+	StatusCode pgtype.Int4        `db:"status_code"`
+	Headers    []byte             `db:"headers"`
+	Body       []byte             `db:"body"`
+	ExpiresAt  pgtype.Timestamptz `db:"expires_at"`
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg *CompleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, completeIdempotencyKey,
+		arg.Key,
+		arg.StatusCode,
+		arg.Headers,
+		arg.Body,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createContact = `-- name: CreateContact :one
+INSERT INTO contacts (
+  email, first_name, last_name, custom_fields, time_zone
+) VALUES ($1, $2, $3, $4, $5) RETURNING id
+`
+
+type CreateContactParams struct {
+	Email        string `db:"email"`
+	FirstName    string `db:"first_name"`
+	LastName     string `db:"last_name"`
+	CustomFields []byte `db:"custom_fields"`
+	TimeZone     string `db:"time_zone"`
+}
+
+func (q *Queries) CreateContact(ctx context.Context, arg *CreateContactParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createContact,
+		arg.Email,
+		arg.FirstName,
+		arg.LastName,
+		arg.CustomFields,
+		arg.TimeZone,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createDeadLetter = `-- name: CreateDeadLetter :exec
+INSERT INTO dead_letters (
+  send_job_id, attempts, error
+) VALUES ($1, $2, $3)
+ON CONFLICT (send_job_id) DO NOTHING
+`
+
+type CreateDeadLetterParams struct {
+	SendJobID uuid.UUID `db:"send_job_id"`
+	Attempts  int32     `db:"attempts"`
+	Error     string    `db:"error"`
+}
+
+func (q *Queries) CreateDeadLetter(ctx context.Context, arg *CreateDeadLetterParams) error {
+	_, err := q.db.Exec(ctx, createDeadLetter, arg.SendJobID, arg.Attempts, arg.Error)
+	return err
+}
+
+const createEnrollment = `-- name: CreateEnrollment :one
+INSERT INTO enrollments (
+  sequence_id, contact_id, current_step_id, next_send_at
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (sequence_id, contact_id) DO NOTHING
+RETURNING id, sequence_id, contact_id, state, current_step_id, next_send_at, created_at, updated_at
+`
+
+type CreateEnrollmentParams struct {
+	SequenceID    uuid.UUID          `db:"sequence_id"`
+	ContactID     uuid.UUID          `db:"contact_id"`
+	CurrentStepID pgtype.UUID        `db:"current_step_id"`
+	NextSendAt    pgtype.Timestamptz `db:"next_send_at"`
+}
+
+func (q *Queries) CreateEnrollment(ctx context.Context, arg *CreateEnrollmentParams) (*Enrollment, error) {
+	row := q.db.QueryRow(ctx, createEnrollment,
+		arg.SequenceID,
+		arg.ContactID,
+		arg.CurrentStepID,
+		arg.NextSendAt,
+	)
+	var i Enrollment
+	err := row.Scan(
+		&i.ID,
+		&i.SequenceID,
+		&i.ContactID,
+		&i.State,
+		&i.CurrentStepID,
+		&i.NextSendAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const createMailbox = `-- name: CreateMailbox :one
+INSERT INTO mailboxes (
+  from_name, from_address, smtp_host, smtp_port, smtp_username,
+  daily_limit, send_interval_seconds, send_burst
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id
+`
+
+type CreateMailboxParams struct {
+	FromName            string `db:"from_name"`
+	FromAddress         string `db:"from_address"`
+	SmtpHost            string `db:"smtp_host"`
+	SmtpPort            int32  `db:"smtp_port"`
+	SmtpUsername        string `db:"smtp_username"`
+	DailyLimit          int32  `db:"daily_limit"`
+	SendIntervalSeconds int32  `db:"send_interval_seconds"`
+	SendBurst           int32  `db:"send_burst"`
+}
+
+func (q *Queries) CreateMailbox(ctx context.Context, arg *CreateMailboxParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createMailbox,
+		arg.FromName,
+		arg.FromAddress,
+		arg.SmtpHost,
+		arg.SmtpPort,
+		arg.SmtpUsername,
+		arg.DailyLimit,
+		arg.SendIntervalSeconds,
+		arg.SendBurst,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createMailboxThrottle = `-- name: CreateMailboxThrottle :exec
+INSERT INTO mailbox_throttles (mailbox_id, day, sent, tokens, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (mailbox_id) DO NOTHING
+`
+
+type CreateMailboxThrottleParams struct {
+	MailboxID uuid.UUID          `db:"mailbox_id"`
+	Day       pgtype.Date        `db:"day"`
+	Sent      int32              `db:"sent"`
+	Tokens    float64            `db:"tokens"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at"`
+}
+
+func (q *Queries) CreateMailboxThrottle(ctx context.Context, arg *CreateMailboxThrottleParams) error {
+	_, err := q.db.Exec(ctx, createMailboxThrottle,
+		arg.MailboxID,
+		arg.Day,
+		arg.Sent,
+		arg.Tokens,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const createSend = `-- name: CreateSend :one
+INSERT INTO sends (
+  send_job_id, generation, attempt, status, started_at
+) VALUES ($1, $2, $3, 'sending', $4)
+RETURNING id
+`
+
+type CreateSendParams struct {
+	SendJobID  uuid.UUID          `db:"send_job_id"`
+	Generation int32              `db:"generation"`
+	Attempt    int32              `db:"attempt"`
+	StartedAt  pgtype.Timestamptz `db:"started_at"`
+}
+
+func (q *Queries) CreateSend(ctx context.Context, arg *CreateSendParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createSend,
+		arg.SendJobID,
+		arg.Generation,
+		arg.Attempt,
+		arg.StartedAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createSendJob = `-- name: CreateSendJob :execrows
+INSERT INTO send_jobs (
+  enrollment_id, step_id, mailbox_id, email_subject, email_content, scheduled_at
+) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (enrollment_id, step_id) DO NOTHING
+`
+
+type CreateSendJobParams struct {
+	EnrollmentID uuid.UUID          `db:"enrollment_id"`
+	StepID       pgtype.UUID        `db:"step_id"`
+	MailboxID    pgtype.UUID        `db:"mailbox_id"`
+	EmailSubject string             `db:"email_subject"`
+	EmailContent string             `db:"email_content"`
+	ScheduledAt  pgtype.Timestamptz `db:"scheduled_at"`
+}
+
+func (q *Queries) CreateSendJob(ctx context.Context, arg *CreateSendJobParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createSendJob,
+		arg.EnrollmentID,
+		arg.StepID,
+		arg.MailboxID,
+		arg.EmailSubject,
+		arg.EmailContent,
+		arg.ScheduledAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const createSequence = `-- name: CreateSequence :one
 INSERT INTO sequences (
   name, open_tracking_enabled, click_tracking_enabled
@@ -57,79 +361,284 @@ func (q *Queries) CreateSequenceStep(ctx context.Context, arg *CreateSequenceSte
 	return id, err
 }
 
-const deleteSequenceStep = `-- name: DeleteSequenceStep :exec
-DELETE FROM sequence_steps WHERE id = $1
+const createTrackingEvent = `-- name: CreateTrackingEvent :execrows
+INSERT INTO tracking_events (send_job_id, type, user_agent, ip_address, automated, url)
+SELECT j.id, $1, $2, $3, $4, $5
+FROM send_jobs j WHERE j.id = $6
+`
+
+type CreateTrackingEventParams struct {
+	Type      TrackingEventType `db:"type"`
+	UserAgent string            `db:"user_agent"`
+	IpAddress *netip.Addr       `db:"ip_address"`
+	Automated string            `db:"automated"`
+	Url       pgtype.Text       `db:"url"`
+	SendJobID uuid.UUID         `db:"send_job_id"`
+}
+
+// Events of send jobs that have been deleted since are dropped.
+func (q *Queries) CreateTrackingEvent(ctx context.Context, arg *CreateTrackingEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, createTrackingEvent,
+		arg.Type,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.Automated,
+		arg.Url,
+		arg.SendJobID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteContact = `-- name: DeleteContact :execrows
+DELETE FROM contacts WHERE id = $1
+`
+
+func (q *Queries) DeleteContact(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteContact, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteDeadLetter = `-- name: DeleteDeadLetter :one
+DELETE FROM dead_letters WHERE id = $1 RETURNING send_job_id
+`
+
+func (q *Queries) DeleteDeadLetter(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, deleteDeadLetter, id)
+	var send_job_id uuid.UUID
+	err := row.Scan(&send_job_id)
+	return send_job_id, err
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at <= NOW()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteExpiredIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteMailbox = `-- name: DeleteMailbox :execrows
+DELETE FROM mailboxes WHERE id = $1
+`
+
+func (q *Queries) DeleteMailbox(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMailbox, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteMessage = `-- name: DeleteMessage :execrows
+DELETE FROM queue_messages
+WHERE id = $1 AND receipt = $2 AND visible_at > NOW()
+`
+
+type DeleteMessageParams struct {
+	ID      uuid.UUID   `db:"id"`
+	Receipt pgtype.UUID `db:"receipt"`
+}
+
+func (q *Queries) DeleteMessage(ctx context.Context, arg *DeleteMessageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteMessage, arg.ID, arg.Receipt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSequence = `-- name: DeleteSequence :execrows
+DELETE FROM sequences WHERE id = $1
+`
+
+func (q *Queries) DeleteSequence(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSequence, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSequenceMailboxes = `-- name: DeleteSequenceMailboxes :exec
+DELETE FROM sequence_mailboxes
+WHERE sequence_id = $1 AND NOT mailbox_id = ANY(COALESCE($2::uuid[], '{}'))
+`
+
+type DeleteSequenceMailboxesParams struct {
+	SequenceID uuid.UUID   `db:"sequence_id"`
+	Keep       []uuid.UUID `db:"keep"`
+}
+
+func (q *Queries) DeleteSequenceMailboxes(ctx context.Context, arg *DeleteSequenceMailboxesParams) error {
+	_, err := q.db.Exec(ctx, deleteSequenceMailboxes, arg.SequenceID, arg.Keep)
+	return err
+}
+
+const deleteSequenceStep = `-- name: DeleteSequenceStep :execrows
+DELETE FROM sequence_steps WHERE id = $1 AND sequence_id = $2
+`
+
+type DeleteSequenceStepParams struct {
+	ID         uuid.UUID `db:"id"`
+	SequenceID uuid.UUID `db:"sequence_id"`
+}
+
+func (q *Queries) DeleteSequenceStep(ctx context.Context, arg *DeleteSequenceStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSequenceStep, arg.ID, arg.SequenceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSequenceStepsBySequenceID = `-- name: DeleteSequenceStepsBySequenceID :exec
+DELETE FROM sequence_steps WHERE sequence_id = $1
+`
+
+func (q *Queries) DeleteSequenceStepsBySequenceID(ctx context.Context, sequenceID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSequenceStepsBySequenceID, sequenceID)
+	return err
+}
+
+const enqueueMessage = `-- name: EnqueueMessage :one
+INSERT INTO queue_messages (queue, body, visible_at)
+VALUES ($1, $2, NOW() + make_interval(secs => $3::float8))
+RETURNING id
+`
+
+type EnqueueMessageParams struct {
+	Queue        string  `db:"queue"`
+	Body         []byte  `db:"body"`
+	DelaySeconds float64 `db:"delay_seconds"`
+}
+
+func (q *Queries) EnqueueMessage(ctx context.Context, arg *EnqueueMessageParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, enqueueMessage, arg.Queue, arg.Body, arg.DelaySeconds)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const extendMessage = `-- name: ExtendMessage :execrows
+UPDATE queue_messages
+SET visible_at = NOW() + make_interval(secs => $1::float8)
+WHERE id = $2 AND receipt = $3 AND visible_at > NOW()
+`
+
+type ExtendMessageParams struct {
+	VisibilitySeconds float64     `db:"visibility_seconds"`
+	ID                uuid.UUID   `db:"id"`
+	Receipt           pgtype.UUID `db:"receipt"`
+}
+
+func (q *Queries) ExtendMessage(ctx context.Context, arg *ExtendMessageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, extendMessage, arg.VisibilitySeconds, arg.ID, arg.Receipt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const finishSend = `-- name: FinishSend :exec
+UPDATE sends SET status = $2, error = $3, finished_at = NOW()
+WHERE id = $1
 `
 
-func (q *Queries) DeleteSequenceStep(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteSequenceStep, id)
+type FinishSendParams struct {
+	ID     uuid.UUID   `db:"id"`
+	Status SendStatus  `db:"status"`
+	Error  pgtype.Text `db:"error"`
+}
+
+func (q *Queries) FinishSend(ctx context.Context, arg *FinishSendParams) error {
+	_, err := q.db.Exec(ctx, finishSend, arg.ID, arg.Status, arg.Error)
 	return err
 }
 
-const getSequenceByID = `-- name: GetSequenceByID :one
-SELECT id, name, open_tracking_enabled, click_tracking_enabled, created_at, updated_at FROM sequences WHERE id = $1 LIMIT 1
+const getContactByID = `-- name: GetContactByID :one
+SELECT id, email, first_name, last_name, custom_fields, created_at, updated_at, time_zone FROM contacts WHERE id = $1 LIMIT 1
 `
 
-func (q *Queries) GetSequenceByID(ctx context.Context, id uuid.UUID) (*Sequence, error) {
-	row := q.db.QueryRow(ctx, getSequenceByID, id)
-	var i Sequence
+func (q *Queries) GetContactByID(ctx context.Context, id uuid.UUID) (*Contact, error) {
+	row := q.db.QueryRow(ctx, getContactByID, id)
+	var i Contact
 	err := row.Scan(
 		&i.ID,
-		&i.Name,
-		&i.OpenTrackingEnabled,
-		&i.ClickTrackingEnabled,
+		&i.Email,
+		&i.FirstName,
+		&i.LastName,
+		&i.CustomFields,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TimeZone,
 	)
 	return &i, err
 }
 
-const getSequenceStepByID = `-- name: GetSequenceStepByID :one
-SELECT id, sequence_id, days_after_previous_step, email_subject, email_content, ordering, created_at, updated_at FROM sequence_steps WHERE id = $1 LIMIT 1
+const getDeadLetterByID = `-- name: GetDeadLetterByID :one
+SELECT id, send_job_id, attempts, error, created_at FROM dead_letters WHERE id = $1 LIMIT 1
 `
 
-func (q *Queries) GetSequenceStepByID(ctx context.Context, id uuid.UUID) (*SequenceStep, error) {
-	row := q.db.QueryRow(ctx, getSequenceStepByID, id)
-	var i SequenceStep
+func (q *Queries) GetDeadLetterByID(ctx context.Context, id uuid.UUID) (*DeadLetter, error) {
+	row := q.db.QueryRow(ctx, getDeadLetterByID, id)
+	var i DeadLetter
+	err := row.Scan(
+		&i.ID,
+		&i.SendJobID,
+		&i.Attempts,
+		&i.Error,
+		&i.CreatedAt,
+	)
+	return &i, err
+}
+
+const getEnrollmentByID = `-- name: GetEnrollmentByID :one
+SELECT id, sequence_id, contact_id, state, current_step_id, next_send_at, created_at, updated_at FROM enrollments WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetEnrollmentByID(ctx context.Context, id uuid.UUID) (*Enrollment, error) {
+	row := q.db.QueryRow(ctx, getEnrollmentByID, id)
+	var i Enrollment
 	err := row.Scan(
 		&i.ID,
 		&i.SequenceID,
-		&i.DaysAfterPreviousStep,
-		&i.EmailSubject,
-		&i.EmailContent,
-		&i.Ordering,
+		&i.ContactID,
+		&i.State,
+		&i.CurrentStepID,
+		&i.NextSendAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return &i, err
 }
 
-const getSequenceStepsBySequenceID = `-- name: GetSequenceStepsBySequenceID :many
-SELECT id, sequence_id, days_after_previous_step, email_subject, email_content, ordering, created_at, updated_at FROM sequence_steps WHERE sequence_id = $1 ORDER BY ordering ASC
+const getExistingContactIDs = `-- name: GetExistingContactIDs :many
+SELECT id FROM contacts WHERE id = ANY($1::uuid[])
 `
 
-func (q *Queries) GetSequenceStepsBySequenceID(ctx context.Context, sequenceID uuid.UUID) ([]*SequenceStep, error) {
-	rows, err := q.db.Query(ctx, getSequenceStepsBySequenceID, sequenceID)
+func (q *Queries) GetExistingContactIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getExistingContactIDs, ids)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*SequenceStep
+	var items []uuid.UUID
 	for rows.Next() {
-		var i SequenceStep
-		if err := rows.Scan(
-			&i.ID,
-			&i.SequenceID,
-			&i.DaysAfterPreviousStep,
-			&i.EmailSubject,
-			&i.EmailContent,
-			&i.Ordering,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, &i)
+		items = append(items, id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -137,32 +646,1431 @@ func (q *Queries) GetSequenceStepsBySequenceID(ctx context.Context, sequenceID u
 	return items, nil
 }
 
-const updateSequence = `-- name: UpdateSequence :exec
-UPDATE sequences SET open_tracking_enabled = $1, click_tracking_enabled = $2, updated_at = NOW() WHERE id = $3
+const getExistingMailboxIDs = `-- name: GetExistingMailboxIDs :many
+SELECT id FROM mailboxes WHERE id = ANY($1::uuid[])
 `
 
-type UpdateSequenceParams struct {
-	OpenTrackingEnabled  bool      `db:"open_tracking_enabled"`
-	ClickTrackingEnabled bool      `db:"click_tracking_enabled"`
-	ID                   uuid.UUID `db:"id"`
-}
-
-func (q *Queries) UpdateSequence(ctx context.Context, arg *UpdateSequenceParams) error {
-	_, err := q.db.Exec(ctx, updateSequence, arg.OpenTrackingEnabled, arg.ClickTrackingEnabled, arg.ID)
-	return err
+func (q *Queries) GetExistingMailboxIDs(ctx context.Context, ids []uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getExistingMailboxIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const updateSequenceStep = `-- name: UpdateSequenceStep :exec
-UPDATE sequence_steps SET email_subject = $1, email_content = $2, updated_at = NOW() WHERE id = $3
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT key, request_hash, status_code, headers, body, created_at, expires_at FROM idempotency_keys WHERE key = $1
 `
 
-type UpdateSequenceStepParams struct {
-	EmailSubject string    `db:"email_subject"`
-	EmailContent string    `db:"email_content"`
-	ID           uuid.UUID `db:"id"`
+func (q *Queries) GetIdempotencyKey(ctx context.Context, key string) (*IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Key,
+		&i.RequestHash,
+		&i.StatusCode,
+		&i.Headers,
+		&i.Body,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return &i, err
+}
+
+const getMailboxByID = `-- name: GetMailboxByID :one
+SELECT id, from_name, from_address, smtp_host, smtp_port, smtp_username, smtp_password, daily_limit, created_at, updated_at, send_interval_seconds, send_burst FROM mailboxes WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetMailboxByID(ctx context.Context, id uuid.UUID) (*Mailbox, error) {
+	row := q.db.QueryRow(ctx, getMailboxByID, id)
+	var i Mailbox
+	err := row.Scan(
+		&i.ID,
+		&i.FromName,
+		&i.FromAddress,
+		&i.SmtpHost,
+		&i.SmtpPort,
+		&i.SmtpUsername,
+		&i.SmtpPassword,
+		&i.DailyLimit,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SendIntervalSeconds,
+		&i.SendBurst,
+	)
+	return &i, err
+}
+
+const getMailboxLimits = `-- name: GetMailboxLimits :one
+SELECT daily_limit, send_interval_seconds, send_burst FROM mailboxes WHERE id = $1
+`
+
+type GetMailboxLimitsRow struct {
+	DailyLimit          int32 `db:"daily_limit"`
+	SendIntervalSeconds int32 `db:"send_interval_seconds"`
+	SendBurst           int32 `db:"send_burst"`
+}
+
+func (q *Queries) GetMailboxLimits(ctx context.Context, id uuid.UUID) (*GetMailboxLimitsRow, error) {
+	row := q.db.QueryRow(ctx, getMailboxLimits, id)
+	var i GetMailboxLimitsRow
+	err := row.Scan(&i.DailyLimit, &i.SendIntervalSeconds, &i.SendBurst)
+	return &i, err
+}
+
+const getNextSequenceStep = `-- name: GetNextSequenceStep :one
+SELECT id, sequence_id, days_after_previous_step, email_subject, email_content, ordering, created_at, updated_at, version FROM sequence_steps
+WHERE sequence_id = $1 AND ordering > $2
+ORDER BY ordering ASC
+LIMIT 1
+`
+
+type GetNextSequenceStepParams struct {
+	SequenceID uuid.UUID `db:"sequence_id"`
+	Ordering   float32   `db:"ordering"`
+}
+
+func (q *Queries) GetNextSequenceStep(ctx context.Context, arg *GetNextSequenceStepParams) (*SequenceStep, error) {
+	row := q.db.QueryRow(ctx, getNextSequenceStep, arg.SequenceID, arg.Ordering)
+	var i SequenceStep
+	err := row.Scan(
+		&i.ID,
+		&i.SequenceID,
+		&i.DaysAfterPreviousStep,
+		&i.EmailSubject,
+		&i.EmailContent,
+		&i.Ordering,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+	)
+	return &i, err
+}
+
+const getSendJobByID = `-- name: GetSendJobByID :one
+SELECT id, enrollment_id, step_id, email_subject, email_content, scheduled_at, created_at, enqueued_at, mailbox_id, generation FROM send_jobs WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetSendJobByID(ctx context.Context, id uuid.UUID) (*SendJob, error) {
+	row := q.db.QueryRow(ctx, getSendJobByID, id)
+	var i SendJob
+	err := row.Scan(
+		&i.ID,
+		&i.EnrollmentID,
+		&i.StepID,
+		&i.EmailSubject,
+		&i.EmailContent,
+		&i.ScheduledAt,
+		&i.CreatedAt,
+		&i.EnqueuedAt,
+		&i.MailboxID,
+		&i.Generation,
+	)
+	return &i, err
+}
+
+const getSendJobForDelivery = `-- name: GetSendJobForDelivery :one
+SELECT
+  j.id, j.generation, j.email_subject, j.email_content,
+  e.state AS enrollment_state,
+  c.email AS contact_email, c.first_name AS contact_first_name, c.last_name AS contact_last_name,
+  c.custom_fields AS contact_custom_fields, c.time_zone AS contact_time_zone,
+  s.name AS sequence_name, s.open_tracking_enabled, s.click_tracking_enabled,
+  EXISTS (SELECT 1 FROM dead_letters d WHERE d.send_job_id = j.id) AS dead_lettered,
+  j.mailbox_id, m.from_name, m.from_address, m.smtp_host, m.smtp_port, m.smtp_username, m.smtp_password,
+  -- Attempts of earlier generations do not count, so that a requeued dead
+  -- letter gets a fresh set of them.
+  (
+    SELECT COUNT(*) FROM sends s
+    WHERE s.send_job_id = j.id AND s.generation = j.generation AND s.status = 'failed'
+  ) AS failed_attempts,
+  -- An attempt that never finished may have sent the email.
+  (
+    SELECT MAX(s.started_at) FROM sends s
+    WHERE s.send_job_id = j.id AND s.generation = j.generation AND s.status = 'sending'
+  )::timestamptz AS unfinished_attempt_at
+FROM send_jobs j
+JOIN enrollments e ON e.id = j.enrollment_id
+JOIN contacts c ON c.id = e.contact_id
+JOIN sequences s ON s.id = e.sequence_id
+LEFT JOIN mailboxes m ON m.id = j.mailbox_id
+WHERE j.id = $1
+`
+
+type GetSendJobForDeliveryRow struct {
+	ID                   uuid.UUID          `db:"id"`
+	Generation           int32              `db:"generation"`
+	EmailSubject         string             `db:"email_subject"`
+	EmailContent         string             `db:"email_content"`
+	EnrollmentState      EnrollmentState    `db:"enrollment_state"`
+	ContactEmail         string             `db:"contact_email"`
+	ContactFirstName     string             `db:"contact_first_name"`
+	ContactLastName      string             `db:"contact_last_name"`
+	ContactCustomFields  []byte             `db:"contact_custom_fields"`
+	ContactTimeZone      string             `db:"contact_time_zone"`
+	SequenceName         string             `db:"sequence_name"`
+	OpenTrackingEnabled  bool               `db:"open_tracking_enabled"`
+	ClickTrackingEnabled bool               `db:"click_tracking_enabled"`
+	DeadLettered         bool               `db:"dead_lettered"`
+	MailboxID            pgtype.UUID        `db:"mailbox_id"`
+	FromName             pgtype.Text        `db:"from_name"`
+	FromAddress          pgtype.Text        `db:"from_address"`
+	SmtpHost             pgtype.Text        `db:"smtp_host"`
+	SmtpPort             pgtype.Int4        `db:"smtp_port"`
+	SmtpUsername         pgtype.Text        `db:"smtp_username"`
+	SmtpPassword         []byte             `db:"smtp_password"`
+	FailedAttempts       int64              `db:"failed_attempts"`
+	UnfinishedAttemptAt  pgtype.Timestamptz `db:"unfinished_attempt_at"`
+}
+
+func (q *Queries) GetSendJobForDelivery(ctx context.Context, id uuid.UUID) (*GetSendJobForDeliveryRow, error) {
+	row := q.db.QueryRow(ctx, getSendJobForDelivery, id)
+	var i GetSendJobForDeliveryRow
+	err := row.Scan(
+		&i.ID,
+		&i.Generation,
+		&i.EmailSubject,
+		&i.EmailContent,
+		&i.EnrollmentState,
+		&i.ContactEmail,
+		&i.ContactFirstName,
+		&i.ContactLastName,
+		&i.ContactCustomFields,
+		&i.ContactTimeZone,
+		&i.SequenceName,
+		&i.OpenTrackingEnabled,
+		&i.ClickTrackingEnabled,
+		&i.DeadLettered,
+		&i.MailboxID,
+		&i.FromName,
+		&i.FromAddress,
+		&i.SmtpHost,
+		&i.SmtpPort,
+		&i.SmtpUsername,
+		&i.SmtpPassword,
+		&i.FailedAttempts,
+		&i.UnfinishedAttemptAt,
+	)
+	return &i, err
+}
+
+const getSendSchedule = `-- name: GetSendSchedule :one
+SELECT s.send_time_zone, s.send_weekdays, s.send_start_hour, s.send_end_hour,
+       s.send_holidays, s.use_contact_time_zone, c.time_zone AS contact_time_zone
+FROM sequences s, contacts c
+WHERE s.id = $1 AND c.id = $2
+`
+
+type GetSendScheduleParams struct {
+	SequenceID uuid.UUID `db:"sequence_id"`
+	ContactID  uuid.UUID `db:"contact_id"`
+}
+
+type GetSendScheduleRow struct {
+	SendTimeZone       string        `db:"send_time_zone"`
+	SendWeekdays       []int32       `db:"send_weekdays"`
+	SendStartHour      int32         `db:"send_start_hour"`
+	SendEndHour        int32         `db:"send_end_hour"`
+	SendHolidays       []pgtype.Date `db:"send_holidays"`
+	UseContactTimeZone bool          `db:"use_contact_time_zone"`
+	ContactTimeZone    string        `db:"contact_time_zone"`
+}
+
+// Returns the sending schedule of a sequence together with the time zone of
+// the contact it is sent to.
+func (q *Queries) GetSendSchedule(ctx context.Context, arg *GetSendScheduleParams) (*GetSendScheduleRow, error) {
+	row := q.db.QueryRow(ctx, getSendSchedule, arg.SequenceID, arg.ContactID)
+	var i GetSendScheduleRow
+	err := row.Scan(
+		&i.SendTimeZone,
+		&i.SendWeekdays,
+		&i.SendStartHour,
+		&i.SendEndHour,
+		&i.SendHolidays,
+		&i.UseContactTimeZone,
+		&i.ContactTimeZone,
+	)
+	return &i, err
+}
+
+const getSendsBySendJobID = `-- name: GetSendsBySendJobID :many
+SELECT id, send_job_id, attempt, status, error, started_at, finished_at, generation FROM sends WHERE send_job_id = $1 ORDER BY started_at ASC
+`
+
+func (q *Queries) GetSendsBySendJobID(ctx context.Context, sendJobID uuid.UUID) ([]*Send, error) {
+	rows, err := q.db.Query(ctx, getSendsBySendJobID, sendJobID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Send
+	for rows.Next() {
+		var i Send
+		if err := rows.Scan(
+			&i.ID,
+			&i.SendJobID,
+			&i.Attempt,
+			&i.Status,
+			&i.Error,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.Generation,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSequenceByID = `-- name: GetSequenceByID :one
+SELECT id, name, open_tracking_enabled, click_tracking_enabled, created_at, updated_at, archived_at, version, mailbox_rotation, send_time_zone, send_weekdays, send_start_hour, send_end_hour, send_holidays, use_contact_time_zone FROM sequences WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetSequenceByID(ctx context.Context, id uuid.UUID) (*Sequence, error) {
+	row := q.db.QueryRow(ctx, getSequenceByID, id)
+	var i Sequence
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.OpenTrackingEnabled,
+		&i.ClickTrackingEnabled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ArchivedAt,
+		&i.Version,
+		&i.MailboxRotation,
+		&i.SendTimeZone,
+		&i.SendWeekdays,
+		&i.SendStartHour,
+		&i.SendEndHour,
+		&i.SendHolidays,
+		&i.UseContactTimeZone,
+	)
+	return &i, err
+}
+
+const getSequenceMailboxIDs = `-- name: GetSequenceMailboxIDs :many
+SELECT mailbox_id FROM sequence_mailboxes WHERE sequence_id = $1 ORDER BY mailbox_id
+`
+
+func (q *Queries) GetSequenceMailboxIDs(ctx context.Context, sequenceID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, getSequenceMailboxIDs, sequenceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var mailbox_id uuid.UUID
+		if err := rows.Scan(&mailbox_id); err != nil {
+			return nil, err
+		}
+		items = append(items, mailbox_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSequenceStats = `-- name: GetSequenceStats :many
+WITH jobs AS (
+  SELECT
+    j.id, j.step_id,
+    (SELECT MIN(s.started_at) FROM sends s WHERE s.send_job_id = j.id) AS first_attempt_at,
+    (SELECT MIN(s.finished_at) FROM sends s WHERE s.send_job_id = j.id AND s.status = 'sent') AS delivered_at,
+    d.created_at AS dead_lettered_at
+  FROM send_jobs j
+  JOIN enrollments e ON e.id = j.enrollment_id
+  LEFT JOIN dead_letters d ON d.send_job_id = j.id
+  WHERE e.sequence_id = $3
+),
+events AS (
+  SELECT t.send_job_id, t.type, COUNT(*) AS total
+  FROM tracking_events t
+  JOIN jobs ON jobs.id = t.send_job_id
+  WHERE ($1::timestamptz IS NULL OR t.created_at >= $1)
+    AND ($2::timestamptz IS NULL OR t.created_at < $2)
+    AND ($4::boolean OR t.automated = '')
+  GROUP BY t.send_job_id, t.type
+)
+SELECT
+  jobs.step_id,
+  COUNT(*) FILTER (
+    WHERE jobs.first_attempt_at IS NOT NULL
+      AND ($1::timestamptz IS NULL OR jobs.first_attempt_at >= $1)
+      AND ($2::timestamptz IS NULL OR jobs.first_attempt_at < $2)
+  ) AS sent,
+  COUNT(*) FILTER (
+    WHERE jobs.delivered_at IS NOT NULL
+      AND ($1::timestamptz IS NULL OR jobs.delivered_at >= $1)
+      AND ($2::timestamptz IS NULL OR jobs.delivered_at < $2)
+  ) AS delivered,
+  COUNT(*) FILTER (
+    WHERE jobs.dead_lettered_at IS NOT NULL
+      AND ($1::timestamptz IS NULL OR jobs.dead_lettered_at >= $1)
+      AND ($2::timestamptz IS NULL OR jobs.dead_lettered_at < $2)
+  ) AS bounced,
+  COUNT(opens.send_job_id) AS unique_opens,
+  COALESCE(SUM(opens.total), 0)::bigint AS opens,
+  COUNT(clicks.send_job_id) AS unique_clicks,
+  COALESCE(SUM(clicks.total), 0)::bigint AS clicks,
+  -- Replies and unsubscribes are not recorded yet.
+  0::bigint AS replied,
+  0::bigint AS unsubscribed
+FROM jobs
+LEFT JOIN events opens ON opens.send_job_id = jobs.id AND opens.type = 'open'
+LEFT JOIN events clicks ON clicks.send_job_id = jobs.id AND clicks.type = 'click'
+GROUP BY jobs.step_id
+`
+
+type GetSequenceStatsParams struct {
+	From             pgtype.Timestamptz `db:"from"`
+	To               pgtype.Timestamptz `db:"to"`
+	SequenceID       uuid.UUID          `db:"sequence_id"`
+	IncludeAutomated bool               `db:"include_automated"`
+}
+
+type GetSequenceStatsRow struct {
+	StepID       pgtype.UUID `db:"step_id"`
+	Sent         int64       `db:"sent"`
+	Delivered    int64       `db:"delivered"`
+	Bounced      int64       `db:"bounced"`
+	UniqueOpens  int64       `db:"unique_opens"`
+	Opens        int64       `db:"opens"`
+	UniqueClicks int64       `db:"unique_clicks"`
+	Clicks       int64       `db:"clicks"`
+	Replied      int64       `db:"replied"`
+	Unsubscribed int64       `db:"unsubscribed"`
+}
+
+// Counts what happened to the send jobs of a sequence within a time range,
+// per step. Jobs count as sent when their first attempt started in the range,
+// as delivered or bounced when they were delivered or dead lettered in it, and
+// opens and clicks when they were tracked in it. Jobs of deleted steps are
+// grouped under a NULL step_id.
+func (q *Queries) GetSequenceStats(ctx context.Context, arg *GetSequenceStatsParams) ([]*GetSequenceStatsRow, error) {
+	rows, err := q.db.Query(ctx, getSequenceStats,
+		arg.From,
+		arg.To,
+		arg.SequenceID,
+		arg.IncludeAutomated,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetSequenceStatsRow
+	for rows.Next() {
+		var i GetSequenceStatsRow
+		if err := rows.Scan(
+			&i.StepID,
+			&i.Sent,
+			&i.Delivered,
+			&i.Bounced,
+			&i.UniqueOpens,
+			&i.Opens,
+			&i.UniqueClicks,
+			&i.Clicks,
+			&i.Replied,
+			&i.Unsubscribed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSequenceStepByID = `-- name: GetSequenceStepByID :one
+SELECT id, sequence_id, days_after_previous_step, email_subject, email_content, ordering, created_at, updated_at, version FROM sequence_steps WHERE id = $1 AND sequence_id = $2 LIMIT 1
+`
+
+type GetSequenceStepByIDParams struct {
+	ID         uuid.UUID `db:"id"`
+	SequenceID uuid.UUID `db:"sequence_id"`
+}
+
+func (q *Queries) GetSequenceStepByID(ctx context.Context, arg *GetSequenceStepByIDParams) (*SequenceStep, error) {
+	row := q.db.QueryRow(ctx, getSequenceStepByID, arg.ID, arg.SequenceID)
+	var i SequenceStep
+	err := row.Scan(
+		&i.ID,
+		&i.SequenceID,
+		&i.DaysAfterPreviousStep,
+		&i.EmailSubject,
+		&i.EmailContent,
+		&i.Ordering,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Version,
+	)
+	return &i, err
+}
+
+const getSequenceStepsBySequenceID = `-- name: GetSequenceStepsBySequenceID :many
+SELECT id, sequence_id, days_after_previous_step, email_subject, email_content, ordering, created_at, updated_at, version FROM sequence_steps WHERE sequence_id = $1 ORDER BY ordering ASC
+`
+
+func (q *Queries) GetSequenceStepsBySequenceID(ctx context.Context, sequenceID uuid.UUID) ([]*SequenceStep, error) {
+	rows, err := q.db.Query(ctx, getSequenceStepsBySequenceID, sequenceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SequenceStep
+	for rows.Next() {
+		var i SequenceStep
+		if err := rows.Scan(
+			&i.ID,
+			&i.SequenceID,
+			&i.DaysAfterPreviousStep,
+			&i.EmailSubject,
+			&i.EmailContent,
+			&i.Ordering,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSequenceStepsBySequenceIDs = `-- name: GetSequenceStepsBySequenceIDs :many
+SELECT id, sequence_id, days_after_previous_step, email_subject, email_content, ordering, created_at, updated_at, version FROM sequence_steps WHERE sequence_id = ANY($1::uuid[]) ORDER BY sequence_id, ordering ASC
+`
+
+func (q *Queries) GetSequenceStepsBySequenceIDs(ctx context.Context, sequenceIds []uuid.UUID) ([]*SequenceStep, error) {
+	rows, err := q.db.Query(ctx, getSequenceStepsBySequenceIDs, sequenceIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SequenceStep
+	for rows.Next() {
+		var i SequenceStep
+		if err := rows.Scan(
+			&i.ID,
+			&i.SequenceID,
+			&i.DaysAfterPreviousStep,
+			&i.EmailSubject,
+			&i.EmailContent,
+			&i.Ordering,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const incrementSequenceVersion = `-- name: IncrementSequenceVersion :exec
+UPDATE sequences SET version = version + 1 WHERE id = $1
+`
+
+func (q *Queries) IncrementSequenceVersion(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, incrementSequenceVersion, id)
+	return err
+}
+
+const listContacts = `-- name: ListContacts :many
+SELECT id, email, first_name, last_name, custom_fields, created_at, updated_at, time_zone FROM contacts
+WHERE (
+    $1::text IS NULL
+    OR email ILIKE '%' || $1::text || '%'
+    OR first_name ILIKE '%' || $1::text || '%'
+    OR last_name ILIKE '%' || $1::text || '%'
+  )
+  AND (
+    $2::uuid IS NULL
+    OR (created_at, id) < ($3::timestamptz, $2::uuid)
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT $4
+`
+
+type ListContactsParams struct {
+	Query           pgtype.Text        `db:"query"`
+	CursorID        pgtype.UUID        `db:"cursor_id"`
+	CursorCreatedAt pgtype.Timestamptz `db:"cursor_created_at"`
+	Limit           int32              `db:"limit"`
+}
+
+func (q *Queries) ListContacts(ctx context.Context, arg *ListContactsParams) ([]*Contact, error) {
+	rows, err := q.db.Query(ctx, listContacts,
+		arg.Query,
+		arg.CursorID,
+		arg.CursorCreatedAt,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Contact
+	for rows.Next() {
+		var i Contact
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.FirstName,
+			&i.LastName,
+			&i.CustomFields,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.TimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDeadLetters = `-- name: ListDeadLetters :many
+SELECT id, send_job_id, attempts, error, created_at FROM dead_letters
+WHERE $1::uuid IS NULL
+  OR (created_at, id) < ($2::timestamptz, $1::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT $3
+`
+
+type ListDeadLettersParams struct {
+	CursorID        pgtype.UUID        `db:"cursor_id"`
+	CursorCreatedAt pgtype.Timestamptz `db:"cursor_created_at"`
+	Limit           int32              `db:"limit"`
+}
+
+func (q *Queries) ListDeadLetters(ctx context.Context, arg *ListDeadLettersParams) ([]*DeadLetter, error) {
+	rows, err := q.db.Query(ctx, listDeadLetters, arg.CursorID, arg.CursorCreatedAt, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DeadLetter
+	for rows.Next() {
+		var i DeadLetter
+		if err := rows.Scan(
+			&i.ID,
+			&i.SendJobID,
+			&i.Attempts,
+			&i.Error,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listMailboxes = `-- name: ListMailboxes :many
+SELECT id, from_name, from_address, smtp_host, smtp_port, smtp_username, smtp_password, daily_limit, created_at, updated_at, send_interval_seconds, send_burst FROM mailboxes ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) ListMailboxes(ctx context.Context) ([]*Mailbox, error) {
+	rows, err := q.db.Query(ctx, listMailboxes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Mailbox
+	for rows.Next() {
+		var i Mailbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromName,
+			&i.FromAddress,
+			&i.SmtpHost,
+			&i.SmtpPort,
+			&i.SmtpUsername,
+			&i.SmtpPassword,
+			&i.DailyLimit,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SendIntervalSeconds,
+			&i.SendBurst,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSequencesByCreatedAt = `-- name: ListSequencesByCreatedAt :many
+SELECT id, name, open_tracking_enabled, click_tracking_enabled, created_at, updated_at, archived_at, version, mailbox_rotation, send_time_zone, send_weekdays, send_start_hour, send_end_hour, send_holidays, use_contact_time_zone FROM sequences
+WHERE archived_at IS NULL
+  AND ($1::text IS NULL OR name ILIKE '%' || $1::text || '%')
+  AND (
+    $2::uuid IS NULL
+    OR ($3::bool AND (created_at, id) < ($4::timestamptz, $2::uuid))
+    OR (NOT $3::bool AND (created_at, id) > ($4::timestamptz, $2::uuid))
+  )
+ORDER BY
+  CASE WHEN $3::bool THEN created_at END DESC,
+  CASE WHEN $3::bool THEN id END DESC,
+  created_at ASC,
+  id ASC
+LIMIT $5
+`
+
+type ListSequencesByCreatedAtParams struct {
+	Name            pgtype.Text        `db:"name"`
+	CursorID        pgtype.UUID        `db:"cursor_id"`
+	Descending      bool               `db:"descending"`
+	CursorCreatedAt pgtype.Timestamptz `db:"cursor_created_at"`
+	Limit           int32              `db:"limit"`
+}
+
+func (q *Queries) ListSequencesByCreatedAt(ctx context.Context, arg *ListSequencesByCreatedAtParams) ([]*Sequence, error) {
+	rows, err := q.db.Query(ctx, listSequencesByCreatedAt,
+		arg.Name,
+		arg.CursorID,
+		arg.Descending,
+		arg.CursorCreatedAt,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Sequence
+	for rows.Next() {
+		var i Sequence
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OpenTrackingEnabled,
+			&i.ClickTrackingEnabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ArchivedAt,
+			&i.Version,
+			&i.MailboxRotation,
+			&i.SendTimeZone,
+			&i.SendWeekdays,
+			&i.SendStartHour,
+			&i.SendEndHour,
+			&i.SendHolidays,
+			&i.UseContactTimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSequencesByName = `-- name: ListSequencesByName :many
+SELECT id, name, open_tracking_enabled, click_tracking_enabled, created_at, updated_at, archived_at, version, mailbox_rotation, send_time_zone, send_weekdays, send_start_hour, send_end_hour, send_holidays, use_contact_time_zone FROM sequences
+WHERE archived_at IS NULL
+  AND ($1::text IS NULL OR name ILIKE '%' || $1::text || '%')
+  AND (
+    $2::uuid IS NULL
+    OR ($3::bool AND (name, id) < ($4::text, $2::uuid))
+    OR (NOT $3::bool AND (name, id) > ($4::text, $2::uuid))
+  )
+ORDER BY
+  CASE WHEN $3::bool THEN name END DESC,
+  CASE WHEN $3::bool THEN id END DESC,
+  name ASC,
+  id ASC
+LIMIT $5
+`
+
+type ListSequencesByNameParams struct {
+	Name       pgtype.Text `db:"name"`
+	CursorID   pgtype.UUID `db:"cursor_id"`
+	Descending bool        `db:"descending"`
+	CursorName pgtype.Text `db:"cursor_name"`
+	Limit      int32       `db:"limit"`
+}
+
+func (q *Queries) ListSequencesByName(ctx context.Context, arg *ListSequencesByNameParams) ([]*Sequence, error) {
+	rows, err := q.db.Query(ctx, listSequencesByName,
+		arg.Name,
+		arg.CursorID,
+		arg.Descending,
+		arg.CursorName,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Sequence
+	for rows.Next() {
+		var i Sequence
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.OpenTrackingEnabled,
+			&i.ClickTrackingEnabled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ArchivedAt,
+			&i.Version,
+			&i.MailboxRotation,
+			&i.SendTimeZone,
+			&i.SendWeekdays,
+			&i.SendStartHour,
+			&i.SendEndHour,
+			&i.SendHolidays,
+			&i.UseContactTimeZone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockDueEnrollments = `-- name: LockDueEnrollments :many
+SELECT e.id, e.sequence_id, e.contact_id, e.state, e.current_step_id, e.next_send_at, e.created_at, e.updated_at FROM enrollments e
+JOIN sequences s ON s.id = e.sequence_id
+WHERE e.state = 'active'
+  AND e.next_send_at <= $1
+  AND s.archived_at IS NULL
+ORDER BY e.next_send_at ASC
+LIMIT $2
+FOR UPDATE OF e SKIP LOCKED
+`
+
+type LockDueEnrollmentsParams struct {
+	Now   pgtype.Timestamptz `db:"now"`
+	Limit int32              `db:"limit"`
+}
+
+// Skips enrollments locked by other scheduler replicas, so each due step is
+// picked up by exactly one of them.
+func (q *Queries) LockDueEnrollments(ctx context.Context, arg *LockDueEnrollmentsParams) ([]*Enrollment, error) {
+	rows, err := q.db.Query(ctx, lockDueEnrollments, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Enrollment
+	for rows.Next() {
+		var i Enrollment
+		if err := rows.Scan(
+			&i.ID,
+			&i.SequenceID,
+			&i.ContactID,
+			&i.State,
+			&i.CurrentStepID,
+			&i.NextSendAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockMailbox = `-- name: LockMailbox :one
+SELECT id FROM mailboxes WHERE id = $1 FOR UPDATE
+`
+
+// Keeps send jobs from being assigned to the mailbox until the transaction ends.
+func (q *Queries) LockMailbox(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, lockMailbox, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const lockMailboxThrottle = `-- name: LockMailboxThrottle :one
+SELECT t.day, t.sent, t.tokens, t.updated_at, m.daily_limit, m.send_interval_seconds, m.send_burst
+FROM mailbox_throttles t
+JOIN mailboxes m ON m.id = t.mailbox_id
+WHERE t.mailbox_id = $1
+FOR UPDATE OF t
+`
+
+type LockMailboxThrottleRow struct {
+	Day                 pgtype.Date        `db:"day"`
+	Sent                int32              `db:"sent"`
+	Tokens              float64            `db:"tokens"`
+	UpdatedAt           pgtype.Timestamptz `db:"updated_at"`
+	DailyLimit          int32              `db:"daily_limit"`
+	SendIntervalSeconds int32              `db:"send_interval_seconds"`
+	SendBurst           int32              `db:"send_burst"`
+}
+
+func (q *Queries) LockMailboxThrottle(ctx context.Context, mailboxID uuid.UUID) (*LockMailboxThrottleRow, error) {
+	row := q.db.QueryRow(ctx, lockMailboxThrottle, mailboxID)
+	var i LockMailboxThrottleRow
+	err := row.Scan(
+		&i.Day,
+		&i.Sent,
+		&i.Tokens,
+		&i.UpdatedAt,
+		&i.DailyLimit,
+		&i.SendIntervalSeconds,
+		&i.SendBurst,
+	)
+	return &i, err
+}
+
+const lockSequence = `-- name: LockSequence :one
+SELECT id, archived_at FROM sequences WHERE id = $1 FOR UPDATE
+`
+
+type LockSequenceRow struct {
+	ID         uuid.UUID          `db:"id"`
+	ArchivedAt pgtype.Timestamptz `db:"archived_at"`
+}
+
+func (q *Queries) LockSequence(ctx context.Context, id uuid.UUID) (*LockSequenceRow, error) {
+	row := q.db.QueryRow(ctx, lockSequence, id)
+	var i LockSequenceRow
+	err := row.Scan(&i.ID, &i.ArchivedAt)
+	return &i, err
+}
+
+const lockUnpublishedSendJobs = `-- name: LockUnpublishedSendJobs :many
+SELECT id FROM send_jobs
+WHERE enqueued_at IS NULL
+ORDER BY created_at ASC
+LIMIT $1
+FOR UPDATE SKIP LOCKED
+`
+
+func (q *Queries) LockUnpublishedSendJobs(ctx context.Context, limit int32) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, lockUnpublishedSendJobs, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const mailboxHasUnsentJobs = `-- name: MailboxHasUnsentJobs :one
+SELECT EXISTS (
+  SELECT 1 FROM send_jobs j
+  JOIN enrollments e ON e.id = j.enrollment_id
+  WHERE j.mailbox_id = $1::uuid
+    AND e.state NOT IN ('stopped', 'failed')
+    AND NOT EXISTS (SELECT 1 FROM sends s WHERE s.send_job_id = j.id AND s.status = 'sent')
+    AND NOT EXISTS (SELECT 1 FROM dead_letters d WHERE d.send_job_id = j.id)
+)
+`
+
+// Send jobs are unsent until they are delivered or dead lettered, unless their
+// enrollment was stopped or failed.
+func (q *Queries) MailboxHasUnsentJobs(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, mailboxHasUnsentJobs, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const markSendJobsEnqueued = `-- name: MarkSendJobsEnqueued :exec
+UPDATE send_jobs SET enqueued_at = NOW() WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) MarkSendJobsEnqueued(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markSendJobsEnqueued, ids)
+	return err
+}
+
+const pickSequenceMailbox = `-- name: PickSequenceMailbox :one
+UPDATE sequence_mailboxes
+SET last_used_at = clock_timestamp()
+WHERE (sequence_id, mailbox_id) = (
+  SELECT sm.sequence_id, sm.mailbox_id FROM sequence_mailboxes sm
+  JOIN sequences s ON s.id = sm.sequence_id
+  WHERE sm.sequence_id = $1
+  ORDER BY
+    CASE WHEN s.mailbox_rotation = 'least_used' THEN (
+      SELECT COUNT(*) FROM send_jobs j
+      WHERE j.mailbox_id = sm.mailbox_id AND j.created_at >= $2::timestamptz
+    ) END ASC,
+    sm.last_used_at ASC NULLS FIRST,
+    sm.mailbox_id ASC
+  LIMIT 1
+  FOR UPDATE OF sm SKIP LOCKED
+)
+RETURNING mailbox_id
+`
+
+type PickSequenceMailboxParams struct {
+	SequenceID uuid.UUID          `db:"sequence_id"`
+	Since      pgtype.Timestamptz `db:"since"`
+}
+
+// Picks the mailbox for the next send job of a sequence and marks it used.
+// Round-robin takes the mailbox used longest ago, least-used the one with the
+// fewest send jobs since the given time. Mailboxes locked by another
+// scheduler replica are skipped instead of waited for.
+func (q *Queries) PickSequenceMailbox(ctx context.Context, arg *PickSequenceMailboxParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, pickSequenceMailbox, arg.SequenceID, arg.Since)
+	var mailbox_id uuid.UUID
+	err := row.Scan(&mailbox_id)
+	return mailbox_id, err
+}
+
+const receiveMessages = `-- name: ReceiveMessages :many
+UPDATE queue_messages
+SET receipt = gen_random_uuid(),
+    visible_at = NOW() + make_interval(secs => $1::float8),
+    attempts = attempts + 1
+WHERE id IN (
+  SELECT m.id FROM queue_messages m
+  WHERE m.queue = $2 AND m.visible_at <= NOW()
+  ORDER BY m.visible_at ASC
+  LIMIT $3
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, body, receipt, attempts
+`
+
+type ReceiveMessagesParams struct {
+	VisibilitySeconds float64 `db:"visibility_seconds"`
+	QueueName         string  `db:"queue_name"`
+	Limit             int32   `db:"limit"`
+}
+
+type ReceiveMessagesRow struct {
+	ID       uuid.UUID   `db:"id"`
+	Body     []byte      `db:"body"`
+	Receipt  pgtype.UUID `db:"receipt"`
+	Attempts int32       `db:"attempts"`
+}
+
+func (q *Queries) ReceiveMessages(ctx context.Context, arg *ReceiveMessagesParams) ([]*ReceiveMessagesRow, error) {
+	rows, err := q.db.Query(ctx, receiveMessages, arg.VisibilitySeconds, arg.QueueName, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ReceiveMessagesRow
+	for rows.Next() {
+		var i ReceiveMessagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Body,
+			&i.Receipt,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseIdempotencyKey = `-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE key = $1 AND status_code IS NULL
+`
+
+func (q *Queries) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	_, err := q.db.Exec(ctx, releaseIdempotencyKey, key)
+	return err
+}
+
+const releaseMessage = `-- name: ReleaseMessage :execrows
+UPDATE queue_messages
+SET receipt = NULL,
+    visible_at = NOW() + make_interval(secs => $1::float8)
+WHERE id = $2 AND receipt = $3 AND visible_at > NOW()
+`
+
+type ReleaseMessageParams struct {
+	DelaySeconds float64     `db:"delay_seconds"`
+	ID           uuid.UUID   `db:"id"`
+	Receipt      pgtype.UUID `db:"receipt"`
+}
+
+func (q *Queries) ReleaseMessage(ctx context.Context, arg *ReleaseMessageParams) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseMessage, arg.DelaySeconds, arg.ID, arg.Receipt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const requeueSendJob = `-- name: RequeueSendJob :exec
+UPDATE send_jobs SET enqueued_at = NULL, generation = generation + 1 WHERE id = $1
+`
+
+// Makes the scheduler publish the job again with a fresh set of attempts.
+func (q *Queries) RequeueSendJob(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, requeueSendJob, id)
+	return err
+}
+
+const reserveIdempotencyKey = `-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, request_hash, expires_at) VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    headers = NULL,
+    body = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW()
+`
+
+type ReserveIdempotencyKeyParams struct {
+	Key         string             `db:"key"`
+	RequestHash []byte             `db:"request_hash"`
+	ExpiresAt   pgtype.Timestamptz `db:"expires_at"`
+}
+
+func (q *Queries) ReserveIdempotencyKey(ctx context.Context, arg *ReserveIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reserveIdempotencyKey, arg.Key, arg.RequestHash, arg.ExpiresAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreSequence = `-- name: RestoreSequence :execrows
+UPDATE sequences SET archived_at = NULL, version = version + 1, updated_at = NOW() WHERE id = $1
+`
+
+func (q *Queries) RestoreSequence(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreSequence, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const sendJobDelivered = `-- name: SendJobDelivered :one
+SELECT EXISTS (
+  SELECT 1 FROM sends WHERE send_job_id = $1 AND status = 'sent'
+)
+`
+
+func (q *Queries) SendJobDelivered(ctx context.Context, sendJobID uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, sendJobDelivered, sendJobID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const setMailboxPassword = `-- name: SetMailboxPassword :exec
+UPDATE mailboxes SET smtp_password = $2 WHERE id = $1
+`
+
+type SetMailboxPasswordParams struct {
+	ID           uuid.UUID `db:"id"`
+	SmtpPassword []byte    `db:"smtp_password"`
+}
+
+func (q *Queries) SetMailboxPassword(ctx context.Context, arg *SetMailboxPasswordParams) error {
+	_, err := q.db.Exec(ctx, setMailboxPassword, arg.ID, arg.SmtpPassword)
+	return err
+}
+
+const setMailboxRotation = `-- name: SetMailboxRotation :exec
+UPDATE sequences SET mailbox_rotation = $2, version = version + 1, updated_at = NOW() WHERE id = $1
+`
+
+type SetMailboxRotationParams struct {
+	ID              uuid.UUID       `db:"id"`
+	MailboxRotation MailboxRotation `db:"mailbox_rotation"`
+}
+
+func (q *Queries) SetMailboxRotation(ctx context.Context, arg *SetMailboxRotationParams) error {
+	_, err := q.db.Exec(ctx, setMailboxRotation, arg.ID, arg.MailboxRotation)
+	return err
+}
+
+const setSendSchedule = `-- name: SetSendSchedule :exec
+UPDATE sequences
+SET send_time_zone = $2, send_weekdays = $3, send_start_hour = $4, send_end_hour = $5,
+    send_holidays = $6, use_contact_time_zone = $7, version = version + 1, updated_at = NOW()
+WHERE id = $1
+`
+
+type SetSendScheduleParams struct {
+	ID                 uuid.UUID     `db:"id"`
+	SendTimeZone       string        `db:"send_time_zone"`
+	SendWeekdays       []int32       `db:"send_weekdays"`
+	SendStartHour      int32         `db:"send_start_hour"`
+	SendEndHour        int32         `db:"send_end_hour"`
+	SendHolidays       []pgtype.Date `db:"send_holidays"`
+	UseContactTimeZone bool          `db:"use_contact_time_zone"`
+}
+
+func (q *Queries) SetSendSchedule(ctx context.Context, arg *SetSendScheduleParams) error {
+	_, err := q.db.Exec(ctx, setSendSchedule,
+		arg.ID,
+		arg.SendTimeZone,
+		arg.SendWeekdays,
+		arg.SendStartHour,
+		arg.SendEndHour,
+		arg.SendHolidays,
+		arg.UseContactTimeZone,
+	)
+	return err
+}
+
+const transitionEnrollment = `-- name: TransitionEnrollment :one
+UPDATE enrollments
+SET state = $1, updated_at = NOW()
+WHERE id = $2 AND state::text = ANY($3::text[])
+RETURNING id, sequence_id, contact_id, state, current_step_id, next_send_at, created_at, updated_at
+`
+
+type TransitionEnrollmentParams struct {
+	State      EnrollmentState `db:"state"`
+	ID         uuid.UUID       `db:"id"`
+	FromStates []string        `db:"from_states"`
+}
+
+func (q *Queries) TransitionEnrollment(ctx context.Context, arg *TransitionEnrollmentParams) (*Enrollment, error) {
+	row := q.db.QueryRow(ctx, transitionEnrollment, arg.State, arg.ID, arg.FromStates)
+	var i Enrollment
+	err := row.Scan(
+		&i.ID,
+		&i.SequenceID,
+		&i.ContactID,
+		&i.State,
+		&i.CurrentStepID,
+		&i.NextSendAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const updateContact = `-- name: UpdateContact :execrows
+UPDATE contacts
+SET email = $1, first_name = $2, last_name = $3, custom_fields = $4, time_zone = $5, updated_at = NOW()
+WHERE id = $6
+`
+
+type UpdateContactParams struct {
+	Email        string    `db:"email"`
+	FirstName    string    `db:"first_name"`
+	LastName     string    `db:"last_name"`
+	CustomFields []byte    `db:"custom_fields"`
+	TimeZone     string    `db:"time_zone"`
+	ID           uuid.UUID `db:"id"`
+}
+
+func (q *Queries) UpdateContact(ctx context.Context, arg *UpdateContactParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateContact,
+		arg.Email,
+		arg.FirstName,
+		arg.LastName,
+		arg.CustomFields,
+		arg.TimeZone,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateMailbox = `-- name: UpdateMailbox :execrows
+UPDATE mailboxes
+SET from_name = $1,
+    from_address = $2,
+    smtp_host = $3,
+    smtp_port = $4,
+    smtp_username = $5,
+    smtp_password = CASE WHEN $6::bool THEN smtp_password ELSE $7::bytea END,
+    daily_limit = $8,
+    send_interval_seconds = $9,
+    send_burst = $10,
+    updated_at = NOW()
+WHERE id = $11
+`
+
+type UpdateMailboxParams struct {
+	FromName            string    `db:"from_name"`
+	FromAddress         string    `db:"from_address"`
+	SmtpHost            string    `db:"smtp_host"`
+	SmtpPort            int32     `db:"smtp_port"`
+	SmtpUsername        string    `db:"smtp_username"`
+	KeepPassword        bool      `db:"keep_password"`
+	SmtpPassword        []byte    `db:"smtp_password"`
+	DailyLimit          int32     `db:"daily_limit"`
+	SendIntervalSeconds int32     `db:"send_interval_seconds"`
+	SendBurst           int32     `db:"send_burst"`
+	ID                  uuid.UUID `db:"id"`
+}
+
+func (q *Queries) UpdateMailbox(ctx context.Context, arg *UpdateMailboxParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateMailbox,
+		arg.FromName,
+		arg.FromAddress,
+		arg.SmtpHost,
+		arg.SmtpPort,
+		arg.SmtpUsername,
+		arg.KeepPassword,
+		arg.SmtpPassword,
+		arg.DailyLimit,
+		arg.SendIntervalSeconds,
+		arg.SendBurst,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateMailboxThrottle = `-- name: UpdateMailboxThrottle :exec
+UPDATE mailbox_throttles
+SET day = $2, sent = $3, tokens = $4, updated_at = $5
+WHERE mailbox_id = $1
+`
+
+type UpdateMailboxThrottleParams struct {
+	MailboxID uuid.UUID          `db:"mailbox_id"`
+	Day       pgtype.Date        `db:"day"`
+	Sent      int32              `db:"sent"`
+	Tokens    float64            `db:"tokens"`
+	UpdatedAt pgtype.Timestamptz `db:"updated_at"`
+}
+
+func (q *Queries) UpdateMailboxThrottle(ctx context.Context, arg *UpdateMailboxThrottleParams) error {
+	_, err := q.db.Exec(ctx, updateMailboxThrottle,
+		arg.MailboxID,
+		arg.Day,
+		arg.Sent,
+		arg.Tokens,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const updateSequence = `-- name: UpdateSequence :exec
+UPDATE sequences
+SET name = $1, open_tracking_enabled = $2, click_tracking_enabled = $3, version = version + 1, updated_at = NOW()
+WHERE id = $4
+`
+
+type UpdateSequenceParams struct {
+	Name                 string    `db:"name"`
+	OpenTrackingEnabled  bool      `db:"open_tracking_enabled"`
+	ClickTrackingEnabled bool      `db:"click_tracking_enabled"`
+	ID                   uuid.UUID `db:"id"`
+}
+
+func (q *Queries) UpdateSequence(ctx context.Context, arg *UpdateSequenceParams) error {
+	_, err := q.db.Exec(ctx, updateSequence,
+		arg.Name,
+		arg.OpenTrackingEnabled,
+		arg.ClickTrackingEnabled,
+		arg.ID,
+	)
+	return err
+}
+
+const updateSequenceStep = `-- name: UpdateSequenceStep :execrows
+UPDATE sequence_steps
+SET email_subject = $1, email_content = $2, days_after_previous_step = $3, ordering = $4, version = version + 1, updated_at = NOW()
+WHERE id = $5 AND sequence_id = $6
+`
+
+type UpdateSequenceStepParams struct {
+	EmailSubject          string    `db:"email_subject"`
+	EmailContent          string    `db:"email_content"`
+	DaysAfterPreviousStep int32     `db:"days_after_previous_step"`
+	Ordering              float32   `db:"ordering"`
+	ID                    uuid.UUID `db:"id"`
+	SequenceID            uuid.UUID `db:"sequence_id"`
+}
+
+func (q *Queries) UpdateSequenceStep(ctx context.Context, arg *UpdateSequenceStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateSequenceStep,
+		arg.EmailSubject,
+		arg.EmailContent,
+		arg.DaysAfterPreviousStep,
+		arg.Ordering,
+		arg.ID,
+		arg.SequenceID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateSequenceStepOrdering = `-- name: UpdateSequenceStepOrdering :exec
+UPDATE sequence_steps SET ordering = $1, version = version + 1, updated_at = NOW() WHERE id = $2 AND sequence_id = $3
+`
+
+type UpdateSequenceStepOrderingParams struct {
+	Ordering   float32   `db:"ordering"`
+	ID         uuid.UUID `db:"id"`
+	SequenceID uuid.UUID `db:"sequence_id"`
 }
 
-func (q *Queries) UpdateSequenceStep(ctx context.Context, arg *UpdateSequenceStepParams) error {
-	_, err := q.db.Exec(ctx, updateSequenceStep, arg.EmailSubject, arg.EmailContent, arg.ID)
+func (q *Queries) UpdateSequenceStepOrdering(ctx context.Context, arg *UpdateSequenceStepOrderingParams) error {
+	_, err := q.db.Exec(ctx, updateSequenceStepOrdering, arg.Ordering, arg.ID, arg.SequenceID)
 	return err
 }
@@ -0,0 +1,16 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// NotFound returns target in place of pgx.ErrNoRows, so that looking up a
+// missing row fails with the not-found error of its domain.
+func NotFound(err, target error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return target
+	}
+	return err
+}
@@ -4,24 +4,510 @@ INSERT INTO sequences (
 ) VALUES ($1, $2, $3) RETURNING id;
 
 -- name: UpdateSequence :exec
-UPDATE sequences SET open_tracking_enabled = $1, click_tracking_enabled = $2, updated_at = NOW() WHERE id = $3;
+UPDATE sequences
+SET name = $1, open_tracking_enabled = $2, click_tracking_enabled = $3, version = version + 1, updated_at = NOW()
+WHERE id = $4;
+
+-- name: IncrementSequenceVersion :exec
+UPDATE sequences SET version = version + 1 WHERE id = $1;
 
 -- name: GetSequenceByID :one
 SELECT * FROM sequences WHERE id = $1 LIMIT 1;
 
+-- name: LockSequence :one
+SELECT id, archived_at FROM sequences WHERE id = $1 FOR UPDATE;
+
+-- name: ArchiveSequence :execrows
+UPDATE sequences SET archived_at = COALESCE(archived_at, NOW()), version = version + 1, updated_at = NOW() WHERE id = $1;
+
+-- name: RestoreSequence :execrows
+UPDATE sequences SET archived_at = NULL, version = version + 1, updated_at = NOW() WHERE id = $1;
+
+-- name: DeleteSequence :execrows
+DELETE FROM sequences WHERE id = $1;
+
 -- name: CreateSequenceStep :one
 INSERT INTO sequence_steps (
   sequence_id, days_after_previous_step, email_subject, email_content, ordering
 ) VALUES ($1, $2, $3, $4, $5) RETURNING id;
 
--- name: UpdateSequenceStep :exec
-UPDATE sequence_steps SET email_subject = $1, email_content = $2, updated_at = NOW() WHERE id = $3;
+-- name: UpdateSequenceStep :execrows
+UPDATE sequence_steps
+SET email_subject = $1, email_content = $2, days_after_previous_step = $3, ordering = $4, version = version + 1, updated_at = NOW()
+WHERE id = $5 AND sequence_id = $6;
+
+-- name: UpdateSequenceStepOrdering :exec
+UPDATE sequence_steps SET ordering = $1, version = version + 1, updated_at = NOW() WHERE id = $2 AND sequence_id = $3;
 
 -- name: GetSequenceStepByID :one
-SELECT * FROM sequence_steps WHERE id = $1 LIMIT 1;
+SELECT * FROM sequence_steps WHERE id = $1 AND sequence_id = $2 LIMIT 1;
 
 -- name: GetSequenceStepsBySequenceID :many
 SELECT * FROM sequence_steps WHERE sequence_id = $1 ORDER BY ordering ASC;
 
--- name: DeleteSequenceStep :exec
-DELETE FROM sequence_steps WHERE id = $1;
+-- name: DeleteSequenceStep :execrows
+DELETE FROM sequence_steps WHERE id = $1 AND sequence_id = $2;
+
+-- name: DeleteSequenceStepsBySequenceID :exec
+DELETE FROM sequence_steps WHERE sequence_id = $1;
+
+-- name: ListSequencesByCreatedAt :many
+SELECT * FROM sequences
+WHERE archived_at IS NULL
+  AND (sqlc.narg('name')::text IS NULL OR name ILIKE '%' || sqlc.narg('name')::text || '%')
+  AND (
+    sqlc.narg('cursor_id')::uuid IS NULL
+    OR (sqlc.arg('descending')::bool AND (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+    OR (NOT sqlc.arg('descending')::bool AND (created_at, id) > (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid))
+  )
+ORDER BY
+  CASE WHEN sqlc.arg('descending')::bool THEN created_at END DESC,
+  CASE WHEN sqlc.arg('descending')::bool THEN id END DESC,
+  created_at ASC,
+  id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListSequencesByName :many
+SELECT * FROM sequences
+WHERE archived_at IS NULL
+  AND (sqlc.narg('name')::text IS NULL OR name ILIKE '%' || sqlc.narg('name')::text || '%')
+  AND (
+    sqlc.narg('cursor_id')::uuid IS NULL
+    OR (sqlc.arg('descending')::bool AND (name, id) < (sqlc.narg('cursor_name')::text, sqlc.narg('cursor_id')::uuid))
+    OR (NOT sqlc.arg('descending')::bool AND (name, id) > (sqlc.narg('cursor_name')::text, sqlc.narg('cursor_id')::uuid))
+  )
+ORDER BY
+  CASE WHEN sqlc.arg('descending')::bool THEN name END DESC,
+  CASE WHEN sqlc.arg('descending')::bool THEN id END DESC,
+  name ASC,
+  id ASC
+LIMIT sqlc.arg('limit');
+
+-- name: GetSequenceStepsBySequenceIDs :many
+SELECT * FROM sequence_steps WHERE sequence_id = ANY(sqlc.arg('sequence_ids')::uuid[]) ORDER BY sequence_id, ordering ASC;
+
+-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (key, request_hash, expires_at) VALUES ($1, $2, $3)
+ON CONFLICT (key) DO UPDATE
+SET request_hash = EXCLUDED.request_hash,
+    status_code = NULL,
+    headers = NULL,
+    body = NULL,
+    created_at = NOW(),
+    expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at <= NOW();
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys WHERE key = $1;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys SET status_code = $2, headers = $3, body = $4, expires_at = $5 WHERE key = $1;
+
+-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE key = $1 AND status_code IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at <= NOW();
+
+-- name: CreateContact :one
+INSERT INTO contacts (
+  email, first_name, last_name, custom_fields, time_zone
+) VALUES ($1, $2, $3, $4, $5) RETURNING id;
+
+-- name: GetContactByID :one
+SELECT * FROM contacts WHERE id = $1 LIMIT 1;
+
+-- name: UpdateContact :execrows
+UPDATE contacts
+SET email = $1, first_name = $2, last_name = $3, custom_fields = $4, time_zone = $5, updated_at = NOW()
+WHERE id = $6;
+
+-- name: DeleteContact :execrows
+DELETE FROM contacts WHERE id = $1;
+
+-- name: ListContacts :many
+SELECT * FROM contacts
+WHERE (
+    sqlc.narg('query')::text IS NULL
+    OR email ILIKE '%' || sqlc.narg('query')::text || '%'
+    OR first_name ILIKE '%' || sqlc.narg('query')::text || '%'
+    OR last_name ILIKE '%' || sqlc.narg('query')::text || '%'
+  )
+  AND (
+    sqlc.narg('cursor_id')::uuid IS NULL
+    OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid)
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CreateEnrollment :one
+INSERT INTO enrollments (
+  sequence_id, contact_id, current_step_id, next_send_at
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (sequence_id, contact_id) DO NOTHING
+RETURNING *;
+
+-- name: GetEnrollmentByID :one
+SELECT * FROM enrollments WHERE id = $1 LIMIT 1;
+
+-- name: TransitionEnrollment :one
+UPDATE enrollments
+SET state = sqlc.arg('state'), updated_at = NOW()
+WHERE id = sqlc.arg('id') AND state::text = ANY(sqlc.arg('from_states')::text[])
+RETURNING *;
+
+-- name: GetExistingContactIDs :many
+SELECT id FROM contacts WHERE id = ANY(sqlc.arg('ids')::uuid[]);
+
+-- name: AdvanceEnrollmentsPastStep :exec
+-- Moves the enrollments waiting for a step that is about to be deleted on to
+-- the step after it, completing them when it was the last one.
+UPDATE enrollments e
+SET current_step_id = next_step.id,
+    state = CASE
+      WHEN next_step.id IS NULL AND e.state IN ('active', 'paused') THEN 'completed'
+      ELSE e.state
+    END,
+    next_send_at = CASE WHEN next_step.id IS NULL THEN NULL ELSE e.next_send_at END,
+    updated_at = NOW()
+FROM sequence_steps s
+LEFT JOIN LATERAL (
+  SELECT n.id FROM sequence_steps n
+  WHERE n.sequence_id = s.sequence_id AND n.id <> s.id AND n.ordering > s.ordering
+  ORDER BY n.ordering ASC
+  LIMIT 1
+) next_step ON TRUE
+WHERE s.id = $1 AND e.current_step_id = s.id;
+
+-- name: LockDueEnrollments :many
+-- Skips enrollments locked by other scheduler replicas, so each due step is
+-- picked up by exactly one of them.
+SELECT e.* FROM enrollments e
+JOIN sequences s ON s.id = e.sequence_id
+WHERE e.state = 'active'
+  AND e.next_send_at <= sqlc.arg('now')
+  AND s.archived_at IS NULL
+ORDER BY e.next_send_at ASC
+LIMIT sqlc.arg('limit')
+FOR UPDATE OF e SKIP LOCKED;
+
+-- name: GetNextSequenceStep :one
+SELECT * FROM sequence_steps
+WHERE sequence_id = $1 AND ordering > $2
+ORDER BY ordering ASC
+LIMIT 1;
+
+-- name: CreateSendJob :execrows
+INSERT INTO send_jobs (
+  enrollment_id, step_id, mailbox_id, email_subject, email_content, scheduled_at
+) VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (enrollment_id, step_id) DO NOTHING;
+
+-- name: AdvanceEnrollment :exec
+UPDATE enrollments
+SET current_step_id = $2, next_send_at = $3, updated_at = NOW()
+WHERE id = $1;
+
+-- name: CompleteEnrollment :exec
+UPDATE enrollments
+SET state = 'completed', current_step_id = NULL, next_send_at = NULL, updated_at = NOW()
+WHERE id = $1;
+
+-- name: EnqueueMessage :one
+INSERT INTO queue_messages (queue, body, visible_at)
+VALUES ($1, $2, NOW() + make_interval(secs => sqlc.arg('delay_seconds')::float8))
+RETURNING id;
+
+-- name: ReceiveMessages :many
+UPDATE queue_messages
+SET receipt = gen_random_uuid(),
+    visible_at = NOW() + make_interval(secs => sqlc.arg('visibility_seconds')::float8),
+    attempts = attempts + 1
+WHERE id IN (
+  SELECT m.id FROM queue_messages m
+  WHERE m.queue = sqlc.arg('queue_name') AND m.visible_at <= NOW()
+  ORDER BY m.visible_at ASC
+  LIMIT sqlc.arg('limit')
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING id, body, receipt, attempts;
+
+-- name: ExtendMessage :execrows
+UPDATE queue_messages
+SET visible_at = NOW() + make_interval(secs => sqlc.arg('visibility_seconds')::float8)
+WHERE id = sqlc.arg('id') AND receipt = sqlc.arg('receipt') AND visible_at > NOW();
+
+-- name: DeleteMessage :execrows
+DELETE FROM queue_messages
+WHERE id = sqlc.arg('id') AND receipt = sqlc.arg('receipt') AND visible_at > NOW();
+
+-- name: ReleaseMessage :execrows
+UPDATE queue_messages
+SET receipt = NULL,
+    visible_at = NOW() + make_interval(secs => sqlc.arg('delay_seconds')::float8)
+WHERE id = sqlc.arg('id') AND receipt = sqlc.arg('receipt') AND visible_at > NOW();
+
+-- name: LockUnpublishedSendJobs :many
+SELECT id FROM send_jobs
+WHERE enqueued_at IS NULL
+ORDER BY created_at ASC
+LIMIT $1
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkSendJobsEnqueued :exec
+UPDATE send_jobs SET enqueued_at = NOW() WHERE id = ANY(sqlc.arg('ids')::uuid[]);
+
+-- name: GetSendJobForDelivery :one
+SELECT
+  j.id, j.generation, j.email_subject, j.email_content,
+  e.state AS enrollment_state,
+  c.email AS contact_email, c.first_name AS contact_first_name, c.last_name AS contact_last_name,
+  c.custom_fields AS contact_custom_fields, c.time_zone AS contact_time_zone,
+  s.name AS sequence_name, s.open_tracking_enabled, s.click_tracking_enabled,
+  EXISTS (SELECT 1 FROM dead_letters d WHERE d.send_job_id = j.id) AS dead_lettered,
+  j.mailbox_id, m.from_name, m.from_address, m.smtp_host, m.smtp_port, m.smtp_username, m.smtp_password,
+  -- Attempts of earlier generations do not count, so that a requeued dead
+  -- letter gets a fresh set of them.
+  (
+    SELECT COUNT(*) FROM sends s
+    WHERE s.send_job_id = j.id AND s.generation = j.generation AND s.status = 'failed'
+  ) AS failed_attempts,
+  -- An attempt that never finished may have sent the email.
+  (
+    SELECT MAX(s.started_at) FROM sends s
+    WHERE s.send_job_id = j.id AND s.generation = j.generation AND s.status = 'sending'
+  )::timestamptz AS unfinished_attempt_at
+FROM send_jobs j
+JOIN enrollments e ON e.id = j.enrollment_id
+JOIN contacts c ON c.id = e.contact_id
+JOIN sequences s ON s.id = e.sequence_id
+LEFT JOIN mailboxes m ON m.id = j.mailbox_id
+WHERE j.id = $1;
+
+-- name: SendJobDelivered :one
+SELECT EXISTS (
+  SELECT 1 FROM sends WHERE send_job_id = $1 AND status = 'sent'
+);
+
+-- name: CreateSend :one
+INSERT INTO sends (
+  send_job_id, generation, attempt, status, started_at
+) VALUES ($1, $2, $3, 'sending', $4)
+RETURNING id;
+
+-- name: FinishSend :exec
+UPDATE sends SET status = $2, error = $3, finished_at = NOW()
+WHERE id = $1;
+
+-- name: CreateDeadLetter :exec
+INSERT INTO dead_letters (
+  send_job_id, attempts, error
+) VALUES ($1, $2, $3)
+ON CONFLICT (send_job_id) DO NOTHING;
+
+-- name: GetDeadLetterByID :one
+SELECT * FROM dead_letters WHERE id = $1 LIMIT 1;
+
+-- name: ListDeadLetters :many
+SELECT * FROM dead_letters
+WHERE sqlc.narg('cursor_id')::uuid IS NULL
+  OR (created_at, id) < (sqlc.narg('cursor_created_at')::timestamptz, sqlc.narg('cursor_id')::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: DeleteDeadLetter :one
+DELETE FROM dead_letters WHERE id = $1 RETURNING send_job_id;
+
+-- name: GetSendJobByID :one
+SELECT * FROM send_jobs WHERE id = $1 LIMIT 1;
+
+-- name: GetSendsBySendJobID :many
+SELECT * FROM sends WHERE send_job_id = $1 ORDER BY started_at ASC;
+
+-- name: RequeueSendJob :exec
+-- Makes the scheduler publish the job again with a fresh set of attempts.
+UPDATE send_jobs SET enqueued_at = NULL, generation = generation + 1 WHERE id = $1;
+
+-- name: CreateMailbox :one
+INSERT INTO mailboxes (
+  from_name, from_address, smtp_host, smtp_port, smtp_username,
+  daily_limit, send_interval_seconds, send_burst
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id;
+
+-- name: SetMailboxPassword :exec
+UPDATE mailboxes SET smtp_password = $2 WHERE id = $1;
+
+-- name: GetMailboxByID :one
+SELECT * FROM mailboxes WHERE id = $1 LIMIT 1;
+
+-- name: ListMailboxes :many
+SELECT * FROM mailboxes ORDER BY created_at ASC, id ASC;
+
+-- name: UpdateMailbox :execrows
+UPDATE mailboxes
+SET from_name = sqlc.arg('from_name'),
+    from_address = sqlc.arg('from_address'),
+    smtp_host = sqlc.arg('smtp_host'),
+    smtp_port = sqlc.arg('smtp_port'),
+    smtp_username = sqlc.arg('smtp_username'),
+    smtp_password = CASE WHEN sqlc.arg('keep_password')::bool THEN smtp_password ELSE sqlc.narg('smtp_password')::bytea END,
+    daily_limit = sqlc.arg('daily_limit'),
+    send_interval_seconds = sqlc.arg('send_interval_seconds'),
+    send_burst = sqlc.arg('send_burst'),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id');
+
+-- name: LockMailbox :one
+-- Keeps send jobs from being assigned to the mailbox until the transaction ends.
+SELECT id FROM mailboxes WHERE id = $1 FOR UPDATE;
+
+-- name: MailboxHasUnsentJobs :one
+-- Send jobs are unsent until they are delivered or dead lettered, unless their
+-- enrollment was stopped or failed.
+SELECT EXISTS (
+  SELECT 1 FROM send_jobs j
+  JOIN enrollments e ON e.id = j.enrollment_id
+  WHERE j.mailbox_id = sqlc.arg('id')::uuid
+    AND e.state NOT IN ('stopped', 'failed')
+    AND NOT EXISTS (SELECT 1 FROM sends s WHERE s.send_job_id = j.id AND s.status = 'sent')
+    AND NOT EXISTS (SELECT 1 FROM dead_letters d WHERE d.send_job_id = j.id)
+);
+
+-- name: DeleteMailbox :execrows
+DELETE FROM mailboxes WHERE id = $1;
+
+-- name: GetExistingMailboxIDs :many
+SELECT id FROM mailboxes WHERE id = ANY(sqlc.arg('ids')::uuid[]);
+
+-- name: GetSequenceMailboxIDs :many
+SELECT mailbox_id FROM sequence_mailboxes WHERE sequence_id = $1 ORDER BY mailbox_id;
+
+-- name: DeleteSequenceMailboxes :exec
+DELETE FROM sequence_mailboxes
+WHERE sequence_id = sqlc.arg('sequence_id') AND NOT mailbox_id = ANY(COALESCE(sqlc.arg('keep')::uuid[], '{}'));
+
+-- name: AddSequenceMailboxes :exec
+INSERT INTO sequence_mailboxes (sequence_id, mailbox_id)
+SELECT sqlc.arg('sequence_id'), unnest(sqlc.arg('mailbox_ids')::uuid[])
+ON CONFLICT DO NOTHING;
+
+-- name: SetMailboxRotation :exec
+UPDATE sequences SET mailbox_rotation = $2, version = version + 1, updated_at = NOW() WHERE id = $1;
+
+-- name: SetSendSchedule :exec
+UPDATE sequences
+SET send_time_zone = $2, send_weekdays = $3, send_start_hour = $4, send_end_hour = $5,
+    send_holidays = $6, use_contact_time_zone = $7, version = version + 1, updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetSendSchedule :one
+-- Returns the sending schedule of a sequence together with the time zone of
+-- the contact it is sent to.
+SELECT s.send_time_zone, s.send_weekdays, s.send_start_hour, s.send_end_hour,
+       s.send_holidays, s.use_contact_time_zone, c.time_zone AS contact_time_zone
+FROM sequences s, contacts c
+WHERE s.id = sqlc.arg('sequence_id') AND c.id = sqlc.arg('contact_id');
+
+-- name: PickSequenceMailbox :one
+-- Picks the mailbox for the next send job of a sequence and marks it used.
+-- Round-robin takes the mailbox used longest ago, least-used the one with the
+-- fewest send jobs since the given time. Mailboxes locked by another
+-- scheduler replica are skipped instead of waited for.
+UPDATE sequence_mailboxes
+SET last_used_at = clock_timestamp()
+WHERE (sequence_id, mailbox_id) = (
+  SELECT sm.sequence_id, sm.mailbox_id FROM sequence_mailboxes sm
+  JOIN sequences s ON s.id = sm.sequence_id
+  WHERE sm.sequence_id = sqlc.arg('sequence_id')
+  ORDER BY
+    CASE WHEN s.mailbox_rotation = 'least_used' THEN (
+      SELECT COUNT(*) FROM send_jobs j
+      WHERE j.mailbox_id = sm.mailbox_id AND j.created_at >= sqlc.arg('since')::timestamptz
+    ) END ASC,
+    sm.last_used_at ASC NULLS FIRST,
+    sm.mailbox_id ASC
+  LIMIT 1
+  FOR UPDATE OF sm SKIP LOCKED
+)
+RETURNING mailbox_id;
+
+-- name: CreateMailboxThrottle :exec
+INSERT INTO mailbox_throttles (mailbox_id, day, sent, tokens, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (mailbox_id) DO NOTHING;
+
+-- name: LockMailboxThrottle :one
+SELECT t.day, t.sent, t.tokens, t.updated_at, m.daily_limit, m.send_interval_seconds, m.send_burst
+FROM mailbox_throttles t
+JOIN mailboxes m ON m.id = t.mailbox_id
+WHERE t.mailbox_id = $1
+FOR UPDATE OF t;
+
+-- name: GetMailboxLimits :one
+SELECT daily_limit, send_interval_seconds, send_burst FROM mailboxes WHERE id = $1;
+
+-- name: UpdateMailboxThrottle :exec
+UPDATE mailbox_throttles
+SET day = $2, sent = $3, tokens = $4, updated_at = $5
+WHERE mailbox_id = $1;
+
+-- name: CreateTrackingEvent :execrows
+-- Events of send jobs that have been deleted since are dropped.
+INSERT INTO tracking_events (send_job_id, type, user_agent, ip_address, automated, url)
+SELECT j.id, sqlc.arg('type'), sqlc.arg('user_agent'), sqlc.arg('ip_address'), sqlc.arg('automated'), sqlc.arg('url')
+FROM send_jobs j WHERE j.id = sqlc.arg('send_job_id');
+
+-- name: GetSequenceStats :many
+-- Counts what happened to the send jobs of a sequence within a time range,
+-- per step. Jobs count as sent when their first attempt started in the range,
+-- as delivered or bounced when they were delivered or dead lettered in it, and
+-- opens and clicks when they were tracked in it. Jobs of deleted steps are
+-- grouped under a NULL step_id.
+WITH jobs AS (
+  SELECT
+    j.id, j.step_id,
+    (SELECT MIN(s.started_at) FROM sends s WHERE s.send_job_id = j.id) AS first_attempt_at,
+    (SELECT MIN(s.finished_at) FROM sends s WHERE s.send_job_id = j.id AND s.status = 'sent') AS delivered_at,
+    d.created_at AS dead_lettered_at
+  FROM send_jobs j
+  JOIN enrollments e ON e.id = j.enrollment_id
+  LEFT JOIN dead_letters d ON d.send_job_id = j.id
+  WHERE e.sequence_id = sqlc.arg('sequence_id')
+),
+events AS (
+  SELECT t.send_job_id, t.type, COUNT(*) AS total
+  FROM tracking_events t
+  JOIN jobs ON jobs.id = t.send_job_id
+  WHERE (sqlc.narg('from')::timestamptz IS NULL OR t.created_at >= sqlc.narg('from'))
+    AND (sqlc.narg('to')::timestamptz IS NULL OR t.created_at < sqlc.narg('to'))
+    AND (sqlc.arg('include_automated')::boolean OR t.automated = '')
+  GROUP BY t.send_job_id, t.type
+)
+SELECT
+  jobs.step_id,
+  COUNT(*) FILTER (
+    WHERE jobs.first_attempt_at IS NOT NULL
+      AND (sqlc.narg('from')::timestamptz IS NULL OR jobs.first_attempt_at >= sqlc.narg('from'))
+      AND (sqlc.narg('to')::timestamptz IS NULL OR jobs.first_attempt_at < sqlc.narg('to'))
+  ) AS sent,
+  COUNT(*) FILTER (
+    WHERE jobs.delivered_at IS NOT NULL
+      AND (sqlc.narg('from')::timestamptz IS NULL OR jobs.delivered_at >= sqlc.narg('from'))
+      AND (sqlc.narg('to')::timestamptz IS NULL OR jobs.delivered_at < sqlc.narg('to'))
+  ) AS delivered,
+  COUNT(*) FILTER (
+    WHERE jobs.dead_lettered_at IS NOT NULL
+      AND (sqlc.narg('from')::timestamptz IS NULL OR jobs.dead_lettered_at >= sqlc.narg('from'))
+      AND (sqlc.narg('to')::timestamptz IS NULL OR jobs.dead_lettered_at < sqlc.narg('to'))
+  ) AS bounced,
+  COUNT(opens.send_job_id) AS unique_opens,
+  COALESCE(SUM(opens.total), 0)::bigint AS opens,
+  COUNT(clicks.send_job_id) AS unique_clicks,
+  COALESCE(SUM(clicks.total), 0)::bigint AS clicks,
+  -- Replies and unsubscribes are not recorded yet.
+  0::bigint AS replied,
+  0::bigint AS unsubscribed
+FROM jobs
+LEFT JOIN events opens ON opens.send_job_id = jobs.id AND opens.type = 'open'
+LEFT JOIN events clicks ON clicks.send_job_id = jobs.id AND clicks.type = 'click'
+GROUP BY jobs.step_id;
@@ -0,0 +1,17 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db/models"
+)
+
+// InTx runs fn in a single transaction. The transaction is committed when fn
+// returns nil and rolled back otherwise.
+func InTx(ctx context.Context, pool *pgxpool.Pool, fn func(q *models.Queries) error) error {
+	return pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		return fn(models.New(tx))
+	})
+}
@@ -0,0 +1,114 @@
+// Package deadletter lets operators inspect and requeue the send jobs the
+// worker gave up on.
+package deadletter
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/paging"
+)
+
+type Service struct {
+	db *pgxpool.Pool
+}
+
+type ListDeadLettersParams struct {
+	Cursor *string
+	Limit  int
+}
+
+type DeadLetterPage struct {
+	DeadLetters []*models.DeadLetter
+	NextCursor  *string
+}
+
+// Details is a dead letter together with its send job and every attempt
+// made to deliver it.
+type Details struct {
+	DeadLetter *models.DeadLetter
+	Job        *models.SendJob
+	Sends      []*models.Send
+}
+
+func NewService(db *pgxpool.Pool) *Service {
+	return &Service{db: db}
+}
+
+func (s *Service) ListDeadLetters(ctx context.Context, params ListDeadLettersParams) (*DeadLetterPage, error) {
+	p := models.ListDeadLettersParams{
+		// One extra row tells us whether there is a next page.
+		Limit: int32(params.Limit) + 1,
+	}
+	if params.Cursor != nil {
+		after, err := paging.DecodeNewest(*params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		p.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+		p.CursorCreatedAt = pgtype.Timestamptz{Time: after.CreatedAt, Valid: true}
+	}
+
+	page := &DeadLetterPage{}
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		letters, err := q.ListDeadLetters(ctx, &p)
+		if err != nil {
+			return err
+		}
+
+		if len(letters) > params.Limit {
+			letters = letters[:params.Limit]
+			last := letters[len(letters)-1]
+			next := paging.Newest{ID: last.ID, CreatedAt: last.CreatedAt.Time}.Encode()
+			page.NextCursor = &next
+		}
+		page.DeadLetters = letters
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (s *Service) GetDeadLetter(ctx context.Context, id uuid.UUID) (*Details, error) {
+	details := &Details{}
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		var err error
+		details.DeadLetter, err = q.GetDeadLetterByID(ctx, id)
+		if err != nil {
+			return db.NotFound(err, apperr.ErrDeadLetterNotFound)
+		}
+
+		details.Job, err = q.GetSendJobByID(ctx, details.DeadLetter.SendJobID)
+		if err != nil {
+			return err
+		}
+
+		details.Sends, err = q.GetSendsBySendJobID(ctx, details.DeadLetter.SendJobID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// Requeue removes the dead letter and has the scheduler publish its send job
+// again, which then gets a fresh set of attempts.
+func (s *Service) Requeue(ctx context.Context, id uuid.UUID) error {
+	return db.InTx(ctx, s.db, func(q *models.Queries) error {
+		jobID, err := q.DeleteDeadLetter(ctx, id)
+		if err != nil {
+			return db.NotFound(err, apperr.ErrDeadLetterNotFound)
+		}
+		return q.RequeueSendJob(ctx, jobID)
+	})
+}
@@ -0,0 +1,109 @@
+package deadletter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+	"github.com/pirellik/sequence-api/internal/enrollment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sequenceID  = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	firstStepID = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	janeID      = uuid.MustParse("00000000-0000-0000-0000-000000000010")
+	johnID      = uuid.MustParse("00000000-0000-0000-0000-000000000011")
+)
+
+// deadLetter creates a published send job of the first step for contactID
+// that failed for good, and returns the ID of its dead letter.
+func deadLetter(t *testing.T, pool *pgxpool.Pool, contactID uuid.UUID) uuid.UUID {
+	t.Helper()
+	ctx := context.Background()
+
+	enrolled, err := enrollment.NewService(pool).Enroll(ctx, sequenceID, contactID)
+	require.NoError(t, err)
+
+	var jobID uuid.UUID
+	err = pool.QueryRow(ctx, `
+		INSERT INTO send_jobs (enrollment_id, step_id, email_subject, email_content, scheduled_at, enqueued_at)
+		VALUES ($1, $2, 'Hello', 'Hi there', NOW(), NOW())
+		RETURNING id`, enrolled.ID, firstStepID).Scan(&jobID)
+	require.NoError(t, err)
+
+	_, err = pool.Exec(ctx, `
+		INSERT INTO sends (send_job_id, attempt, status, error, started_at, finished_at)
+		VALUES ($1, 1, 'failed', '550 no such user', NOW(), NOW())`, jobID)
+	require.NoError(t, err)
+
+	var id uuid.UUID
+	err = pool.QueryRow(ctx, `
+		INSERT INTO dead_letters (send_job_id, attempts, error)
+		VALUES ($1, 1, '550 no such user')
+		RETURNING id`, jobID).Scan(&id)
+	require.NoError(t, err)
+	return id
+}
+
+func TestService(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	svc := NewService(pool)
+	ctx := context.Background()
+
+	first := deadLetter(t, pool, janeID)
+	second := deadLetter(t, pool, johnID)
+
+	t.Run("lists newest first", func(t *testing.T) {
+		page, err := svc.ListDeadLetters(ctx, ListDeadLettersParams{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, page.DeadLetters, 1)
+		assert.Equal(t, second, page.DeadLetters[0].ID)
+		require.NotNil(t, page.NextCursor)
+
+		page, err = svc.ListDeadLetters(ctx, ListDeadLettersParams{Cursor: page.NextCursor, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, page.DeadLetters, 1)
+		assert.Equal(t, first, page.DeadLetters[0].ID)
+		assert.Nil(t, page.NextCursor)
+
+		invalid := "invalid"
+		_, err = svc.ListDeadLetters(ctx, ListDeadLettersParams{Cursor: &invalid, Limit: 1})
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+	})
+
+	t.Run("returns details", func(t *testing.T) {
+		details, err := svc.GetDeadLetter(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, "Hello", details.Job.EmailSubject)
+		require.Len(t, details.Sends, 1)
+		assert.Equal(t, "550 no such user", details.Sends[0].Error.String)
+
+		_, err = svc.GetDeadLetter(ctx, uuid.New())
+		assert.ErrorIs(t, err, apperr.ErrDeadLetterNotFound)
+	})
+
+	t.Run("requeue unpublishes the job", func(t *testing.T) {
+		details, err := svc.GetDeadLetter(ctx, first)
+		require.NoError(t, err)
+
+		require.NoError(t, svc.Requeue(ctx, first))
+
+		var (
+			unpublished bool
+			generation  int
+		)
+		err = pool.QueryRow(ctx, "SELECT enqueued_at IS NULL, generation FROM send_jobs WHERE id = $1", details.Job.ID).Scan(&unpublished, &generation)
+		require.NoError(t, err)
+		assert.True(t, unpublished)
+		assert.Equal(t, 1, generation, "earlier attempts no longer count")
+
+		_, err = svc.GetDeadLetter(ctx, first)
+		assert.ErrorIs(t, err, apperr.ErrDeadLetterNotFound)
+		assert.ErrorIs(t, svc.Requeue(ctx, first), apperr.ErrDeadLetterNotFound)
+	})
+}
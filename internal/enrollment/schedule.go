@@ -0,0 +1,59 @@
+package enrollment
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/schedule"
+)
+
+// SendSchedule returns the schedule the steps of a sequence are sent to a
+// contact on.
+func SendSchedule(ctx context.Context, q *models.Queries, sequenceID, contactID uuid.UUID) (schedule.Schedule, error) {
+	row, err := q.GetSendSchedule(ctx, &models.GetSendScheduleParams{
+		SequenceID: sequenceID,
+		ContactID:  contactID,
+	})
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	return scheduleFromDB(row), nil
+}
+
+func scheduleFromDB(row *models.GetSendScheduleRow) schedule.Schedule {
+	location := loadLocation(row.SendTimeZone, time.UTC)
+	if row.UseContactTimeZone && row.ContactTimeZone != "" {
+		location = loadLocation(row.ContactTimeZone, location)
+	}
+
+	weekdays := make([]time.Weekday, len(row.SendWeekdays))
+	for i, day := range row.SendWeekdays {
+		weekdays[i] = time.Weekday(day)
+	}
+	holidays := make([]schedule.Date, len(row.SendHolidays))
+	for i, day := range row.SendHolidays {
+		holidays[i] = schedule.DateOf(day.Time)
+	}
+
+	return schedule.Schedule{
+		Location:  location,
+		Weekdays:  weekdays,
+		StartHour: int(row.SendStartHour),
+		EndHour:   int(row.SendEndHour),
+		Holidays:  holidays,
+	}
+}
+
+// loadLocation returns the named time zone, or fallback if it is unknown.
+// Time zones are checked when they are set, so that only happens when one is
+// dropped from the time zone database; failing would hold up the scheduler.
+func loadLocation(name string, fallback *time.Location) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return fallback
+	}
+	return location
+}
@@ -0,0 +1,224 @@
+// Package enrollment starts sequences for contacts and tracks where each
+// contact is in the sequence.
+package enrollment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+)
+
+type Service struct {
+	db *pgxpool.Pool
+}
+
+type BulkResult struct {
+	Enrolled []*models.Enrollment
+	// Skipped holds the contacts that were enrolled in the sequence already.
+	Skipped []uuid.UUID
+}
+
+func NewService(db *pgxpool.Pool) *Service {
+	return &Service{db: db}
+}
+
+// Enroll starts the sequence for a contact. The first step is due right away
+// unless it is configured with a delay.
+func (s *Service) Enroll(ctx context.Context, sequenceID, contactID uuid.UUID) (*models.Enrollment, error) {
+	var enrolled *models.Enrollment
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		first, err := firstStep(ctx, q, sequenceID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := q.GetContactByID(ctx, contactID); err != nil {
+			return db.NotFound(err, apperr.ErrContactNotFound)
+		}
+
+		enrolled, err = createEnrollment(ctx, q, sequenceID, contactID, first, time.Now())
+		return db.NotFound(err, apperr.ErrAlreadyEnrolled)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return enrolled, nil
+}
+
+// EnrollMany starts the sequence for several contacts at once. Contacts that
+// are enrolled already are skipped rather than failing the whole batch.
+func (s *Service) EnrollMany(ctx context.Context, sequenceID uuid.UUID, contactIDs []uuid.UUID) (*BulkResult, error) {
+	result := &BulkResult{}
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		first, err := firstStep(ctx, q, sequenceID)
+		if err != nil {
+			return err
+		}
+
+		err = checkContacts(ctx, q, contactIDs, func(i int) string {
+			return fmt.Sprintf("contactIds[%d]", i)
+		})
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, contactID := range contactIDs {
+			enrolled, err := createEnrollment(ctx, q, sequenceID, contactID, first, now)
+			switch {
+			case errors.Is(err, pgx.ErrNoRows):
+				result.Skipped = append(result.Skipped, contactID)
+			case err != nil:
+				return err
+			default:
+				result.Enrolled = append(result.Enrolled, enrolled)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// firstStep locks the sequence, so that its steps do not change while
+// contacts are being enrolled, and returns its first step.
+func firstStep(ctx context.Context, q *models.Queries, sequenceID uuid.UUID) (*models.SequenceStep, error) {
+	if _, err := q.LockSequence(ctx, sequenceID); err != nil {
+		return nil, db.NotFound(err, apperr.ErrSequenceNotFound)
+	}
+
+	sequence, err := q.GetSequenceByID(ctx, sequenceID)
+	if err != nil {
+		return nil, err
+	}
+	if sequence.ArchivedAt.Valid {
+		return nil, apperr.ErrSequenceArchived
+	}
+
+	steps, err := q.GetSequenceStepsBySequenceID(ctx, sequenceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("sequence has no steps: %w", apperr.ErrConflict)
+	}
+
+	return steps[0], nil
+}
+
+// checkContacts returns a validation error naming every contact ID that does
+// not exist. field gives the name of the input field holding the i-th ID.
+func checkContacts(ctx context.Context, q *models.Queries, contactIDs []uuid.UUID, field func(i int) string) error {
+	existing, err := q.GetExistingContactIDs(ctx, contactIDs)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[uuid.UUID]bool, len(existing))
+	for _, id := range existing {
+		found[id] = true
+	}
+
+	var fields []apperr.FieldError
+	for i, id := range contactIDs {
+		if !found[id] {
+			fields = append(fields, apperr.FieldError{Field: field(i), Message: "must reference an existing contact"})
+		}
+	}
+	if len(fields) > 0 {
+		return apperr.Validation(fields...)
+	}
+	return nil
+}
+
+// createEnrollment returns pgx.ErrNoRows when the contact is enrolled in the
+// sequence already.
+func createEnrollment(
+	ctx context.Context,
+	q *models.Queries,
+	sequenceID, contactID uuid.UUID,
+	first *models.SequenceStep,
+	now time.Time,
+) (*models.Enrollment, error) {
+	sendSchedule, err := SendSchedule(ctx, q, sequenceID, contactID)
+	if err != nil {
+		return nil, fmt.Errorf("getting send schedule: %w", err)
+	}
+
+	return q.CreateEnrollment(ctx, &models.CreateEnrollmentParams{
+		SequenceID:    sequenceID,
+		ContactID:     contactID,
+		CurrentStepID: pgtype.UUID{Bytes: first.ID, Valid: true},
+		NextSendAt:    pgtype.Timestamptz{Time: NextSendAt(now, first, sendSchedule), Valid: true},
+	})
+}
+
+func (s *Service) GetEnrollment(ctx context.Context, id uuid.UUID) (*models.Enrollment, error) {
+	var enrollment *models.Enrollment
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		var err error
+		enrollment, err = q.GetEnrollmentByID(ctx, id)
+		return db.NotFound(err, apperr.ErrEnrollmentNotFound)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return enrollment, nil
+}
+
+// Pause stops sending to an active enrollment until it is resumed.
+func (s *Service) Pause(ctx context.Context, id uuid.UUID) (*models.Enrollment, error) {
+	return s.transition(ctx, id, models.EnrollmentStatePaused)
+}
+
+// Resume continues a paused enrollment. A step that fell due while the
+// enrollment was paused is sent right away.
+func (s *Service) Resume(ctx context.Context, id uuid.UUID) (*models.Enrollment, error) {
+	return s.transition(ctx, id, models.EnrollmentStateActive)
+}
+
+// Stop ends an enrollment for good.
+func (s *Service) Stop(ctx context.Context, id uuid.UUID) (*models.Enrollment, error) {
+	return s.transition(ctx, id, models.EnrollmentStateStopped)
+}
+
+// transition moves the enrollment to state to. The allowed source states are
+// checked in the update itself, so concurrent transitions cannot both win.
+func (s *Service) transition(ctx context.Context, id uuid.UUID, to models.EnrollmentState) (*models.Enrollment, error) {
+	var updated *models.Enrollment
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		var err error
+		updated, err = q.TransitionEnrollment(ctx, &models.TransitionEnrollmentParams{
+			ID:         id,
+			State:      to,
+			FromStates: sourceStates(to),
+		})
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		if _, err := q.GetEnrollmentByID(ctx, id); err != nil {
+			return db.NotFound(err, apperr.ErrEnrollmentNotFound)
+		}
+		return apperr.ErrInvalidTransition
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,124 @@
+package enrollment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pirellik/sequence-api/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sequenceID      = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	emptySequenceID = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	firstStepID     = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	secondStepID    = uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	janeID          = uuid.MustParse("00000000-0000-0000-0000-000000000010")
+	johnID          = uuid.MustParse("00000000-0000-0000-0000-000000000011")
+)
+
+func TestService(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	svc := NewService(pool)
+	ctx := context.Background()
+
+	before := time.Now()
+	enrolled, err := svc.Enroll(ctx, sequenceID, janeID)
+	require.NoError(t, err)
+	assert.Equal(t, models.EnrollmentStateActive, enrolled.State)
+	assert.Equal(t, firstStepID, uuid.UUID(enrolled.CurrentStepID.Bytes))
+	// The first step is due right away.
+	assert.WithinRange(t, enrolled.NextSendAt.Time, before, time.Now())
+
+	t.Run("enrolling twice", func(t *testing.T) {
+		_, err := svc.Enroll(ctx, sequenceID, janeID)
+		assert.ErrorIs(t, err, apperr.ErrAlreadyEnrolled)
+	})
+
+	t.Run("enroll errors", func(t *testing.T) {
+		_, err := svc.Enroll(ctx, uuid.New(), janeID)
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+
+		_, err = svc.Enroll(ctx, sequenceID, uuid.New())
+		assert.ErrorIs(t, err, apperr.ErrContactNotFound)
+
+		_, err = svc.Enroll(ctx, emptySequenceID, janeID)
+		assert.ErrorIs(t, err, apperr.ErrConflict)
+	})
+
+	t.Run("bulk skips enrolled contacts", func(t *testing.T) {
+		result, err := svc.EnrollMany(ctx, sequenceID, []uuid.UUID{janeID, johnID})
+		require.NoError(t, err)
+		require.Len(t, result.Enrolled, 1)
+		assert.Equal(t, johnID, result.Enrolled[0].ContactID)
+		assert.Equal(t, []uuid.UUID{janeID}, result.Skipped)
+
+		missing := uuid.New()
+		_, err = svc.EnrollMany(ctx, sequenceID, []uuid.UUID{johnID, missing})
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "contactIds[1]", validationErr.Fields[0].Field)
+	})
+
+	t.Run("state transitions", func(t *testing.T) {
+		paused, err := svc.Pause(ctx, enrolled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.EnrollmentStatePaused, paused.State)
+
+		_, err = svc.Pause(ctx, enrolled.ID)
+		assert.ErrorIs(t, err, apperr.ErrInvalidTransition)
+
+		resumed, err := svc.Resume(ctx, enrolled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.EnrollmentStateActive, resumed.State)
+
+		stopped, err := svc.Stop(ctx, enrolled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.EnrollmentStateStopped, stopped.State)
+
+		_, err = svc.Resume(ctx, enrolled.ID)
+		assert.ErrorIs(t, err, apperr.ErrInvalidTransition)
+
+		_, err = svc.Stop(ctx, uuid.New())
+		assert.ErrorIs(t, err, apperr.ErrEnrollmentNotFound)
+	})
+
+	t.Run("deleting steps advances enrollments", func(t *testing.T) {
+		sequences := sequence.NewService(pool, nil)
+		// The second step becomes the first, so it must not be delayed.
+		_, err := sequences.UpdateSequenceStep(ctx, sequenceID, secondStepID, sequence.StepUpdate{DaysAfterPreviousStep: pointer.To(int32(0))})
+		require.NoError(t, err)
+		require.NoError(t, sequences.DeleteSequenceStep(ctx, sequenceID, firstStepID))
+
+		// John was enrolled by the bulk test and still waits for the first step.
+		var enrollmentID uuid.UUID
+		err = pool.QueryRow(ctx, "SELECT id FROM enrollments WHERE contact_id = $1", johnID).Scan(&enrollmentID)
+		require.NoError(t, err)
+
+		found, err := svc.GetEnrollment(ctx, enrollmentID)
+		require.NoError(t, err)
+		assert.Equal(t, secondStepID, uuid.UUID(found.CurrentStepID.Bytes))
+		assert.Equal(t, models.EnrollmentStateActive, found.State)
+
+		// A sequence keeps at least one step, so the last step John waits for
+		// gets a new one in front of it before it is deleted.
+		_, err = sequences.CreateSequenceStep(ctx, sequenceID, &models.SequenceStep{
+			EmailSubject: "New First",
+			EmailContent: "Content",
+		}, sequence.Position{BeforeStepID: &secondStepID})
+		require.NoError(t, err)
+		require.NoError(t, sequences.DeleteSequenceStep(ctx, sequenceID, secondStepID))
+		found, err = svc.GetEnrollment(ctx, enrollmentID)
+		require.NoError(t, err)
+		assert.False(t, found.CurrentStepID.Valid)
+		assert.False(t, found.NextSendAt.Valid)
+		assert.Equal(t, models.EnrollmentStateCompleted, found.State)
+	})
+}
@@ -0,0 +1,49 @@
+package enrollment
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/schedule"
+)
+
+// transitions lists the states an enrollment can move to from each state.
+// Completed, stopped and failed enrollments are final.
+var transitions = map[models.EnrollmentState][]models.EnrollmentState{
+	models.EnrollmentStateActive: {
+		models.EnrollmentStatePaused,
+		models.EnrollmentStateStopped,
+		models.EnrollmentStateCompleted,
+		models.EnrollmentStateFailed,
+	},
+	models.EnrollmentStatePaused: {
+		models.EnrollmentStateActive,
+		models.EnrollmentStateStopped,
+	},
+}
+
+// CanTransition reports whether an enrollment in state from can move to state
+// to.
+func CanTransition(from, to models.EnrollmentState) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// sourceStates returns the states from which an enrollment can move to state
+// to, sorted so that queries built from them are stable.
+func sourceStates(to models.EnrollmentState) []string {
+	var states []string
+	for from, targets := range transitions {
+		if slices.Contains(targets, to) {
+			states = append(states, string(from))
+		}
+	}
+	slices.Sort(states)
+	return states
+}
+
+// NextSendAt returns when step is due if the previous step was sent at
+// previous. The delay counts the days sendSchedule sends on.
+func NextSendAt(previous time.Time, step *models.SequenceStep, sendSchedule schedule.Schedule) time.Time {
+	return sendSchedule.After(previous, int(step.DaysAfterPreviousStep))
+}
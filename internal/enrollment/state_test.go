@@ -0,0 +1,94 @@
+package enrollment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/schedule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to models.EnrollmentState
+		want     bool
+	}{
+		{models.EnrollmentStateActive, models.EnrollmentStatePaused, true},
+		{models.EnrollmentStateActive, models.EnrollmentStateStopped, true},
+		{models.EnrollmentStateActive, models.EnrollmentStateCompleted, true},
+		{models.EnrollmentStateActive, models.EnrollmentStateFailed, true},
+		{models.EnrollmentStatePaused, models.EnrollmentStateActive, true},
+		{models.EnrollmentStatePaused, models.EnrollmentStateStopped, true},
+		{models.EnrollmentStatePaused, models.EnrollmentStateCompleted, false},
+		{models.EnrollmentStateActive, models.EnrollmentStateActive, false},
+		{models.EnrollmentStateStopped, models.EnrollmentStateActive, false},
+		{models.EnrollmentStateCompleted, models.EnrollmentStatePaused, false},
+		{models.EnrollmentStateFailed, models.EnrollmentStateActive, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			assert.Equal(t, tt.want, CanTransition(tt.from, tt.to))
+		})
+	}
+}
+
+func TestSourceStates(t *testing.T) {
+	assert.Equal(t, []string{"paused"}, sourceStates(models.EnrollmentStateActive))
+	assert.Equal(t, []string{"active"}, sourceStates(models.EnrollmentStatePaused))
+	assert.Equal(t, []string{"active", "paused"}, sourceStates(models.EnrollmentStateStopped))
+}
+
+func TestNextSendAt(t *testing.T) {
+	sent := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, sent, NextSendAt(sent, &models.SequenceStep{DaysAfterPreviousStep: 0}, schedule.Anytime))
+	assert.Equal(t, time.Date(2025, 3, 4, 9, 30, 0, 0, time.UTC), NextSendAt(sent, &models.SequenceStep{DaysAfterPreviousStep: 3}, schedule.Anytime))
+
+	// 1 March 2025 is a Saturday.
+	workdays := schedule.Schedule{
+		Location:  time.UTC,
+		Weekdays:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		StartHour: 10,
+		EndHour:   18,
+	}
+	assert.Equal(t, time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC), NextSendAt(sent, &models.SequenceStep{DaysAfterPreviousStep: 0}, workdays))
+	assert.Equal(t, time.Date(2025, 3, 5, 10, 0, 0, 0, time.UTC), NextSendAt(sent, &models.SequenceStep{DaysAfterPreviousStep: 3}, workdays))
+}
+
+func TestScheduleFromDB(t *testing.T) {
+	row := &models.GetSendScheduleRow{
+		SendTimeZone:  "Europe/Berlin",
+		SendWeekdays:  []int32{1, 2},
+		SendStartHour: 9,
+		SendEndHour:   17,
+		SendHolidays:  []pgtype.Date{{Time: time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), Valid: true}},
+	}
+
+	s := scheduleFromDB(row)
+	assert.Equal(t, "Europe/Berlin", s.Location.String())
+	assert.Equal(t, []time.Weekday{time.Monday, time.Tuesday}, s.Weekdays)
+	assert.Equal(t, 9, s.StartHour)
+	assert.Equal(t, 17, s.EndHour)
+	assert.Equal(t, []schedule.Date{{Year: 2025, Month: time.December, Day: 25}}, s.Holidays)
+
+	t.Run("contact time zone", func(t *testing.T) {
+		row := *row
+		row.ContactTimeZone = "America/New_York"
+		assert.Equal(t, "Europe/Berlin", scheduleFromDB(&row).Location.String(), "only when enabled")
+
+		row.UseContactTimeZone = true
+		assert.Equal(t, "America/New_York", scheduleFromDB(&row).Location.String())
+
+		row.ContactTimeZone = ""
+		assert.Equal(t, "Europe/Berlin", scheduleFromDB(&row).Location.String(), "contacts without one get the sequence's")
+	})
+
+	t.Run("unknown time zone", func(t *testing.T) {
+		row := *row
+		row.SendTimeZone = "Mars/Olympus_Mons"
+		assert.Equal(t, time.UTC, scheduleFromDB(&row).Location)
+	})
+}
@@ -0,0 +1,142 @@
+// Package idempotency stores the responses replayed by the Idempotency-Key
+// middleware in Postgres.
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/pkg/middleware"
+)
+
+var _ middleware.IdempotencyStore = (*Store)(nil)
+
+type Store struct {
+	db *pgxpool.Pool
+}
+
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+// maxReserveAttempts bounds how often Reserve tries again when the key it
+// found taken is released or purged before it can be read.
+const maxReserveAttempts = 3
+
+func (s *Store) Reserve(
+	ctx context.Context,
+	key string,
+	requestHash []byte,
+	leaseUntil time.Time,
+) (*middleware.IdempotencyRecord, error) {
+	q := models.New(s.db)
+
+	for attempt := 1; ; attempt++ {
+		// An expired key, or one whose lease has run out before its request
+		// finished, is taken over as if it had never been used.
+		rows, err := q.ReserveIdempotencyKey(ctx, &models.ReserveIdempotencyKeyParams{
+			Key:         key,
+			RequestHash: requestHash,
+			ExpiresAt:   pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reserving idempotency key: %w", err)
+		}
+		if rows > 0 {
+			return nil, nil
+		}
+
+		stored, err := q.GetIdempotencyKey(ctx, key)
+		if errors.Is(err, pgx.ErrNoRows) && attempt < maxReserveAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting idempotency key: %w", err)
+		}
+		return recordFrom(stored)
+	}
+}
+
+// recordFrom decodes a stored idempotency key.
+func recordFrom(stored *models.IdempotencyKey) (*middleware.IdempotencyRecord, error) {
+	record := &middleware.IdempotencyRecord{RequestHash: stored.RequestHash}
+	if stored.StatusCode.Valid {
+		var header http.Header
+		if err := json.Unmarshal(stored.Headers, &header); err != nil {
+			return nil, fmt.Errorf("decoding stored headers: %w", err)
+		}
+		record.Response = &middleware.IdempotentResponse{
+			StatusCode: int(stored.StatusCode.Int32),
+			Header:     header,
+			Body:       stored.Body,
+		}
+	}
+
+	return record, nil
+}
+
+func (s *Store) Complete(ctx context.Context, key string, response *middleware.IdempotentResponse, expiresAt time.Time) error {
+	header, err := json.Marshal(response.Header)
+	if err != nil {
+		return fmt.Errorf("encoding headers: %w", err)
+	}
+
+	err = models.New(s.db).CompleteIdempotencyKey(ctx, &models.CompleteIdempotencyKeyParams{
+		Key:        key,
+		StatusCode: pgtype.Int4{Int32: int32(response.StatusCode), Valid: true},
+		Headers:    header,
+		Body:       response.Body,
+		ExpiresAt:  pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("completing idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Release(ctx context.Context, key string) error {
+	if err := models.New(s.db).ReleaseIdempotencyKey(ctx, key); err != nil {
+		return fmt.Errorf("releasing idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes the keys whose TTL has passed and returns how many
+// were removed.
+func (s *Store) DeleteExpired(ctx context.Context) (int64, error) {
+	rows, err := models.New(s.db).DeleteExpiredIdempotencyKeys(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("deleting expired idempotency keys: %w", err)
+	}
+	return rows, nil
+}
+
+// PurgeExpired calls DeleteExpired every interval until ctx is done.
+func (s *Store) PurgeExpired(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rows, err := s.DeleteExpired(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "purging idempotency keys", "err", err)
+				continue
+			}
+			slog.DebugContext(ctx, "purged idempotency keys", "count", rows)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+	"github.com/pirellik/sequence-api/pkg/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	store := NewStore(dbtest.New(t, ""))
+	ctx := context.Background()
+	hash := []byte("hash")
+
+	t.Run("reserve, complete and replay", func(t *testing.T) {
+		record, err := store.Reserve(ctx, "key-1", hash, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Nil(t, record)
+
+		record, err = store.Reserve(ctx, "key-1", hash, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.NotNil(t, record)
+		assert.Equal(t, hash, record.RequestHash)
+		assert.Nil(t, record.Response)
+
+		response := &middleware.IdempotentResponse{
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{"Content-Type": {"application/json"}},
+			Body:       []byte(`{"id":"1"}`),
+		}
+		require.NoError(t, store.Complete(ctx, "key-1", response, time.Now().Add(time.Hour)))
+
+		record, err = store.Reserve(ctx, "key-1", []byte("other"), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.NotNil(t, record)
+		assert.Equal(t, hash, record.RequestHash)
+		assert.Equal(t, response, record.Response)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		_, err := store.Reserve(ctx, "key-2", hash, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, store.Release(ctx, "key-2"))
+
+		record, err := store.Reserve(ctx, "key-2", hash, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+
+	t.Run("expired keys and leases are taken over and purged", func(t *testing.T) {
+		_, err := store.Reserve(ctx, "key-3", hash, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		record, err := store.Reserve(ctx, "key-3", []byte("new"), time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Nil(t, record)
+
+		deleted, err := store.DeleteExpired(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+	})
+}
@@ -0,0 +1,267 @@
+// Package mailbox manages the accounts emails are sent from and which of them
+// each sequence uses.
+package mailbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/secret"
+)
+
+type Service struct {
+	db  *pgxpool.Pool
+	box *secret.Box
+}
+
+// SequenceMailboxes are the mailboxes a sequence sends from and how they
+// take turns.
+type SequenceMailboxes struct {
+	Rotation   models.MailboxRotation
+	MailboxIDs []uuid.UUID
+}
+
+func NewService(db *pgxpool.Pool, box *secret.Box) *Service {
+	return &Service{db: db, box: box}
+}
+
+// seal encrypts the password of mailbox id, leaving an empty password unset.
+// The ciphertext is bound to id so that it only decrypts for that mailbox.
+func (s *Service) seal(id uuid.UUID, password string) ([]byte, error) {
+	if password == "" {
+		return nil, nil
+	}
+	sealed, err := s.box.Seal([]byte(password), id[:])
+	if err != nil {
+		return nil, fmt.Errorf("encrypting password: %w", err)
+	}
+	return sealed, nil
+}
+
+// CreateMailbox stores mailbox with password encrypted. The SmtpPassword of
+// mailbox is ignored.
+func (s *Service) CreateMailbox(ctx context.Context, mailbox *models.Mailbox, password string) (*models.Mailbox, error) {
+	var created *models.Mailbox
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		id, err := q.CreateMailbox(ctx, &models.CreateMailboxParams{
+			FromName:            mailbox.FromName,
+			FromAddress:         mailbox.FromAddress,
+			SmtpHost:            mailbox.SmtpHost,
+			SmtpPort:            mailbox.SmtpPort,
+			SmtpUsername:        mailbox.SmtpUsername,
+			DailyLimit:          mailbox.DailyLimit,
+			SendIntervalSeconds: mailbox.SendIntervalSeconds,
+			SendBurst:           mailbox.SendBurst,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The password is sealed once the mailbox has an ID to bind it to.
+		sealed, err := s.seal(id, password)
+		if err != nil {
+			return err
+		}
+		if err := q.SetMailboxPassword(ctx, &models.SetMailboxPasswordParams{ID: id, SmtpPassword: sealed}); err != nil {
+			return err
+		}
+
+		created, err = q.GetMailboxByID(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *Service) GetMailbox(ctx context.Context, id uuid.UUID) (*models.Mailbox, error) {
+	var mailbox *models.Mailbox
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		var err error
+		mailbox, err = q.GetMailboxByID(ctx, id)
+		return db.NotFound(err, apperr.ErrMailboxNotFound)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mailbox, nil
+}
+
+func (s *Service) ListMailboxes(ctx context.Context) ([]*models.Mailbox, error) {
+	var mailboxes []*models.Mailbox
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		var err error
+		mailboxes, err = q.ListMailboxes(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mailboxes, nil
+}
+
+// UpdateMailbox replaces all fields of the mailbox. The stored password is
+// kept when password is nil, since clients cannot read it back.
+func (s *Service) UpdateMailbox(ctx context.Context, id uuid.UUID, mailbox *models.Mailbox, password *string) (*models.Mailbox, error) {
+	params := &models.UpdateMailboxParams{
+		ID:                  id,
+		FromName:            mailbox.FromName,
+		FromAddress:         mailbox.FromAddress,
+		SmtpHost:            mailbox.SmtpHost,
+		SmtpPort:            mailbox.SmtpPort,
+		SmtpUsername:        mailbox.SmtpUsername,
+		KeepPassword:        password == nil,
+		DailyLimit:          mailbox.DailyLimit,
+		SendIntervalSeconds: mailbox.SendIntervalSeconds,
+		SendBurst:           mailbox.SendBurst,
+	}
+	if password != nil {
+		var err error
+		if params.SmtpPassword, err = s.seal(id, *password); err != nil {
+			return nil, err
+		}
+	}
+
+	var updated *models.Mailbox
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		rows, err := q.UpdateMailbox(ctx, params)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return apperr.ErrMailboxNotFound
+		}
+
+		updated, err = q.GetMailboxByID(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteMailbox removes the mailbox from every sequence using it. Mailboxes
+// with send jobs that are still to be sent cannot be deleted, as the worker
+// would send those through its default sender instead.
+func (s *Service) DeleteMailbox(ctx context.Context, id uuid.UUID) error {
+	return db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if _, err := q.LockMailbox(ctx, id); err != nil {
+			return db.NotFound(err, apperr.ErrMailboxNotFound)
+		}
+
+		inUse, err := q.MailboxHasUnsentJobs(ctx, id)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return apperr.ErrMailboxInUse
+		}
+
+		_, err = q.DeleteMailbox(ctx, id)
+		return err
+	})
+}
+
+func (s *Service) GetSequenceMailboxes(ctx context.Context, sequenceID uuid.UUID) (*SequenceMailboxes, error) {
+	var result *SequenceMailboxes
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		sequence, err := q.GetSequenceByID(ctx, sequenceID)
+		if err != nil {
+			return db.NotFound(err, apperr.ErrSequenceNotFound)
+		}
+
+		ids, err := q.GetSequenceMailboxIDs(ctx, sequenceID)
+		if err != nil {
+			return err
+		}
+
+		result = &SequenceMailboxes{Rotation: sequence.MailboxRotation, MailboxIDs: ids}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// SetSequenceMailboxes replaces the mailboxes of a sequence. Mailboxes that
+// stay assigned keep their place in the rotation.
+func (s *Service) SetSequenceMailboxes(ctx context.Context, sequenceID uuid.UUID, mailboxes SequenceMailboxes) (*SequenceMailboxes, error) {
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		sequence, err := q.LockSequence(ctx, sequenceID)
+		if err != nil {
+			return db.NotFound(err, apperr.ErrSequenceNotFound)
+		}
+		if sequence.ArchivedAt.Valid {
+			return apperr.ErrSequenceArchived
+		}
+
+		if err := checkMailboxes(ctx, q, mailboxes.MailboxIDs); err != nil {
+			return err
+		}
+
+		err = q.SetMailboxRotation(ctx, &models.SetMailboxRotationParams{
+			ID:              sequenceID,
+			MailboxRotation: mailboxes.Rotation,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteSequenceMailboxes(ctx, &models.DeleteSequenceMailboxesParams{
+			SequenceID: sequenceID,
+			Keep:       mailboxes.MailboxIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		return q.AddSequenceMailboxes(ctx, &models.AddSequenceMailboxesParams{
+			SequenceID: sequenceID,
+			MailboxIds: mailboxes.MailboxIDs,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSequenceMailboxes(ctx, sequenceID)
+}
+
+func checkMailboxes(ctx context.Context, q *models.Queries, mailboxIDs []uuid.UUID) error {
+	existing, err := q.GetExistingMailboxIDs(ctx, mailboxIDs)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[uuid.UUID]bool, len(existing))
+	for _, id := range existing {
+		found[id] = true
+	}
+
+	var fields []apperr.FieldError
+	for i, id := range mailboxIDs {
+		if !found[id] {
+			fields = append(fields, apperr.FieldError{
+				Field:   fmt.Sprintf("mailboxIds[%d]", i),
+				Message: "must reference an existing mailbox",
+			})
+		}
+	}
+	if len(fields) > 0 {
+		return apperr.Validation(fields...)
+	}
+	return nil
+}
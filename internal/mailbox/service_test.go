@@ -0,0 +1,155 @@
+package mailbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/secret"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sequenceID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	salesID    = uuid.MustParse("00000000-0000-0000-0000-000000000020")
+	supportID  = uuid.MustParse("00000000-0000-0000-0000-000000000021")
+)
+
+func newBox(t *testing.T) *secret.Box {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	box, err := secret.NewBox(base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+	return box
+}
+
+func TestService(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	box := newBox(t)
+	svc := NewService(pool, box)
+	ctx := context.Background()
+
+	created, err := svc.CreateMailbox(ctx, &models.Mailbox{
+		FromName:            "Outreach",
+		FromAddress:         "outreach@example.com",
+		SmtpHost:            "smtp.example.com",
+		SmtpPort:            465,
+		SmtpUsername:        "outreach",
+		DailyLimit:          50,
+		SendIntervalSeconds: 60,
+		SendBurst:           1,
+	}, "hunter2")
+	require.NoError(t, err)
+
+	t.Run("encrypts the password", func(t *testing.T) {
+		assert.NotContains(t, string(created.SmtpPassword), "hunter2")
+		password, err := box.Open(created.SmtpPassword, created.ID[:])
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", string(password))
+
+		_, err = box.Open(created.SmtpPassword, salesID[:])
+		assert.ErrorIs(t, err, secret.ErrMalformed, "the password is bound to its mailbox")
+	})
+
+	t.Run("lists oldest first", func(t *testing.T) {
+		mailboxes, err := svc.ListMailboxes(ctx)
+		require.NoError(t, err)
+		require.Len(t, mailboxes, 3)
+		assert.Equal(t, salesID, mailboxes[0].ID)
+		assert.Equal(t, created.ID, mailboxes[2].ID)
+	})
+
+	t.Run("update keeps the password unless given", func(t *testing.T) {
+		update := *created
+		update.DailyLimit = 75
+
+		updated, err := svc.UpdateMailbox(ctx, created.ID, &update, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(75), updated.DailyLimit)
+		assert.Equal(t, created.SmtpPassword, updated.SmtpPassword)
+
+		empty := ""
+		updated, err = svc.UpdateMailbox(ctx, created.ID, &update, &empty)
+		require.NoError(t, err)
+		assert.Empty(t, updated.SmtpPassword)
+
+		_, err = svc.UpdateMailbox(ctx, uuid.New(), &update, nil)
+		assert.ErrorIs(t, err, apperr.ErrMailboxNotFound)
+	})
+
+	t.Run("sequence mailboxes", func(t *testing.T) {
+		assigned, err := svc.GetSequenceMailboxes(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, models.MailboxRotationRoundRobin, assigned.Rotation)
+		assert.Empty(t, assigned.MailboxIDs)
+
+		assigned, err = svc.SetSequenceMailboxes(ctx, sequenceID, SequenceMailboxes{
+			Rotation:   models.MailboxRotationLeastUsed,
+			MailboxIDs: []uuid.UUID{supportID, salesID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.MailboxRotationLeastUsed, assigned.Rotation)
+		assert.ElementsMatch(t, []uuid.UUID{salesID, supportID}, assigned.MailboxIDs)
+
+		assigned, err = svc.SetSequenceMailboxes(ctx, sequenceID, SequenceMailboxes{
+			Rotation:   models.MailboxRotationRoundRobin,
+			MailboxIDs: []uuid.UUID{salesID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{salesID}, assigned.MailboxIDs)
+
+		sequence, err := models.New(pool).GetSequenceByID(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), sequence.Version, "assigning mailboxes bumps the sequence version")
+
+		_, err = svc.SetSequenceMailboxes(ctx, sequenceID, SequenceMailboxes{
+			Rotation:   models.MailboxRotationRoundRobin,
+			MailboxIDs: []uuid.UUID{salesID, uuid.New()},
+		})
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "mailboxIds[1]", validationErr.Fields[0].Field)
+
+		_, err = svc.SetSequenceMailboxes(ctx, uuid.New(), SequenceMailboxes{Rotation: models.MailboxRotationRoundRobin})
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
+
+	t.Run("delete keeps a mailbox with unsent emails", func(t *testing.T) {
+		var enrollmentID uuid.UUID
+		err := pool.QueryRow(ctx, `
+			INSERT INTO enrollments (sequence_id, contact_id)
+			VALUES ($1, '00000000-0000-0000-0000-000000000010') RETURNING id
+		`, sequenceID).Scan(&enrollmentID)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `
+			INSERT INTO send_jobs (enrollment_id, mailbox_id, email_subject, email_content, scheduled_at)
+			VALUES ($1, $2, 'Subject', 'Content', NOW())
+		`, enrollmentID, salesID)
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, svc.DeleteMailbox(ctx, salesID), apperr.ErrMailboxInUse)
+
+		_, err = pool.Exec(ctx, "UPDATE enrollments SET state = 'stopped' WHERE id = $1", enrollmentID)
+		require.NoError(t, err)
+	})
+
+	t.Run("delete removes the mailbox from sequences", func(t *testing.T) {
+		require.NoError(t, svc.DeleteMailbox(ctx, salesID))
+
+		assigned, err := svc.GetSequenceMailboxes(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Empty(t, assigned.MailboxIDs)
+
+		_, err = svc.GetMailbox(ctx, salesID)
+		assert.ErrorIs(t, err, apperr.ErrMailboxNotFound)
+		assert.ErrorIs(t, svc.DeleteMailbox(ctx, salesID), apperr.ErrMailboxNotFound)
+	})
+}
@@ -16,21 +16,361 @@ import (
 	"strings"
 	"time"
 
+	"github.com/oapi-codegen/nullable"
 	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// Error defines model for Error.
+// Defines values for EnrollmentState.
+const (
+	EnrollmentStateActive    EnrollmentState = "active"
+	EnrollmentStateCompleted EnrollmentState = "completed"
+	EnrollmentStateFailed    EnrollmentState = "failed"
+	EnrollmentStatePaused    EnrollmentState = "paused"
+	EnrollmentStateStopped   EnrollmentState = "stopped"
+)
+
+// Defines values for MailboxRotation.
+const (
+	LeastUsed  MailboxRotation = "least_used"
+	RoundRobin MailboxRotation = "round_robin"
+)
+
+// Defines values for SendStatus.
+const (
+	SendStatusFailed  SendStatus = "failed"
+	SendStatusSending SendStatus = "sending"
+	SendStatusSent    SendStatus = "sent"
+)
+
+// Defines values for Weekday.
+const (
+	Friday    Weekday = "friday"
+	Monday    Weekday = "monday"
+	Saturday  Weekday = "saturday"
+	Sunday    Weekday = "sunday"
+	Thursday  Weekday = "thursday"
+	Tuesday   Weekday = "tuesday"
+	Wednesday Weekday = "wednesday"
+)
+
+// Defines values for ListSequencesParamsSort.
+const (
+	SortCreatedAt     ListSequencesParamsSort = "createdAt"
+	SortCreatedAtDesc ListSequencesParamsSort = "-createdAt"
+	SortName          ListSequencesParamsSort = "name"
+	SortNameDesc      ListSequencesParamsSort = "-name"
+)
+
+// BulkEnrollmentInput defines model for BulkEnrollmentInput.
+type BulkEnrollmentInput struct {
+	ContactIds []openapi_types.UUID `json:"contactIds"`
+}
+
+// BulkEnrollmentResult defines model for BulkEnrollmentResult.
+type BulkEnrollmentResult struct {
+	Items []Enrollment `json:"items"`
+
+	// SkippedContactIds Contacts that were enrolled in the sequence already
+	SkippedContactIds []openapi_types.UUID `json:"skippedContactIds"`
+}
+
+// Contact defines model for Contact.
+type Contact struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// CustomFields Arbitrary contact attributes available to templates. Keys are
+	// identifiers (letters, digits and underscores, not starting with a
+	// digit), values are strings, numbers, booleans or null.
+	CustomFields CustomFields `json:"customFields"`
+
+	// Email Email address, unique among contacts regardless of case
+	Email     string             `json:"email"`
+	FirstName string             `json:"firstName"`
+	Id        openapi_types.UUID `json:"id"`
+	LastName  string             `json:"lastName"`
+
+	// TimeZone IANA time zone of the contact, absent when unknown
+	TimeZone  *string    `json:"timeZone,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ContactInput defines model for ContactInput.
+type ContactInput struct {
+	// CustomFields Arbitrary contact attributes available to templates. Keys are
+	// identifiers (letters, digits and underscores, not starting with a
+	// digit), values are strings, numbers, booleans or null.
+	CustomFields *CustomFields `json:"customFields,omitempty"`
+
+	// Email Email address, unique among contacts regardless of case
+	Email     string  `json:"email"`
+	FirstName *string `json:"firstName,omitempty"`
+	LastName  *string `json:"lastName,omitempty"`
+
+	// TimeZone IANA time zone of the contact
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// ContactList defines model for ContactList.
+type ContactList struct {
+	Items []Contact `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// CreateSequenceStepInput defines model for CreateSequenceStepInput.
+type CreateSequenceStepInput struct {
+	// AfterStepId Place the step right after this step
+	AfterStepId *openapi_types.UUID `json:"afterStepId,omitempty"`
+
+	// BeforeStepId Place the step right before this step
+	BeforeStepId          *openapi_types.UUID `json:"beforeStepId,omitempty"`
+	DaysAfterPreviousStep int                 `json:"daysAfterPreviousStep"`
+	EmailContent          string              `json:"emailContent"`
+	EmailSubject          string              `json:"emailSubject"`
+}
+
+// CustomFields Arbitrary contact attributes available to templates. Keys are
+// identifiers (letters, digits and underscores, not starting with a
+// digit), values are strings, numbers, booleans or null.
+type CustomFields map[string]interface{}
+
+// DeadLetter defines model for DeadLetter.
+type DeadLetter struct {
+	// Attempts Number of delivery attempts made before giving up
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Error of the last attempt
+	Error     string             `json:"error"`
+	Id        openapi_types.UUID `json:"id"`
+	SendJobId openapi_types.UUID `json:"sendJobId"`
+}
+
+// DeadLetterDetails defines model for DeadLetterDetails.
+type DeadLetterDetails struct {
+	DeadLetter DeadLetter `json:"deadLetter"`
+	SendJob    SendJob    `json:"sendJob"`
+	Sends      []Send     `json:"sends"`
+}
+
+// DeadLetterList defines model for DeadLetterList.
+type DeadLetterList struct {
+	Items []DeadLetter `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// Enrollment defines model for Enrollment.
+type Enrollment struct {
+	ContactId openapi_types.UUID `json:"contactId"`
+	CreatedAt *time.Time         `json:"createdAt,omitempty"`
+
+	// CurrentStepId Step sent next, absent once there is nothing left to send
+	CurrentStepId *openapi_types.UUID `json:"currentStepId,omitempty"`
+	Id            openapi_types.UUID  `json:"id"`
+
+	// NextSendAt When the current step is due
+	NextSendAt *time.Time         `json:"nextSendAt,omitempty"`
+	SequenceId openapi_types.UUID `json:"sequenceId"`
+
+	// State `active` enrollments are sent to, `paused` ones wait to be resumed.
+	// `completed`, `stopped` and `failed` are final.
+	State     EnrollmentState `json:"state"`
+	UpdatedAt *time.Time      `json:"updatedAt,omitempty"`
+}
+
+// EnrollmentInput defines model for EnrollmentInput.
+type EnrollmentInput struct {
+	ContactId openapi_types.UUID `json:"contactId"`
+}
+
+// EnrollmentState `active` enrollments are sent to, `paused` ones wait to be resumed.
+// `completed`, `stopped` and `failed` are final.
+type EnrollmentState string
+
+// Error Problem details as defined in RFC 7807
 type Error struct {
-	Message string `json:"message"`
+	// Detail Explanation specific to this occurrence of the problem
+	Detail *string        `json:"detail,omitempty"`
+	Errors *[]ErrorDetail `json:"errors,omitempty"`
+
+	// Instance ID of the request that caused the problem
+	Instance *string `json:"instance,omitempty"`
 
 	// Status HTTP status code
 	Status int64 `json:"status"`
+
+	// Title Short summary of the problem type
+	Title string `json:"title"`
+
+	// Type URI reference identifying the problem type
+	Type string `json:"type"`
+}
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	// Field Name of the invalid field
+	Field   string `json:"field"`
+	Message string `json:"message"`
+
+	// Pointer JSON pointer to the invalid field in the request body
+	Pointer string `json:"pointer"`
+}
+
+// Mailbox defines model for Mailbox.
+type Mailbox struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// DailyLimit Maximum number of emails sent from the mailbox per UTC day
+	DailyLimit  int    `json:"dailyLimit"`
+	FromAddress string `json:"fromAddress"`
+	FromName    string `json:"fromName"`
+
+	// HasSmtpPassword Whether an SMTP password is stored
+	HasSmtpPassword bool               `json:"hasSmtpPassword"`
+	Id              openapi_types.UUID `json:"id"`
+
+	// SendBurst Number of emails that may be sent back to back after a quiet period
+	SendBurst int `json:"sendBurst"`
+
+	// SendIntervalSeconds Average number of seconds between two emails sent from the mailbox
+	SendIntervalSeconds int    `json:"sendIntervalSeconds"`
+	SmtpHost            string `json:"smtpHost"`
+
+	// SmtpPort Port 465 uses implicit TLS, other ports STARTTLS when the server offers it
+	SmtpPort     int        `json:"smtpPort"`
+	SmtpUsername string     `json:"smtpUsername"`
+	UpdatedAt    *time.Time `json:"updatedAt,omitempty"`
+}
+
+// MailboxInput defines model for MailboxInput.
+type MailboxInput struct {
+	DailyLimit  int     `json:"dailyLimit"`
+	FromAddress string  `json:"fromAddress"`
+	FromName    *string `json:"fromName,omitempty"`
+
+	// SendBurst Number of emails that may be sent back to back after a quiet period
+	SendBurst *int `json:"sendBurst,omitempty"`
+
+	// SendIntervalSeconds Average number of seconds between two emails sent from the mailbox
+	SendIntervalSeconds *int    `json:"sendIntervalSeconds,omitempty"`
+	SmtpHost            string  `json:"smtpHost"`
+	SmtpPassword        *string `json:"smtpPassword,omitempty"`
+
+	// SmtpPort Port 465 uses implicit TLS, other ports STARTTLS when the server offers it
+	SmtpPort     int     `json:"smtpPort"`
+	SmtpUsername *string `json:"smtpUsername,omitempty"`
+}
+
+// MailboxList defines model for MailboxList.
+type MailboxList struct {
+	Items []Mailbox `json:"items"`
+}
+
+// MailboxRotation `round_robin` uses the mailboxes of a sequence in turn, `least_used`
+// the one with the fewest send jobs today.
+type MailboxRotation string
+
+// PatchSequenceStepInput defines model for PatchSequenceStepInput.
+type PatchSequenceStepInput struct {
+	// AfterStepId Move the step right after this step
+	AfterStepId *openapi_types.UUID `json:"afterStepId,omitempty"`
+
+	// BeforeStepId Move the step right before this step
+	BeforeStepId          *openapi_types.UUID       `json:"beforeStepId,omitempty"`
+	DaysAfterPreviousStep nullable.Nullable[int]    `json:"daysAfterPreviousStep,omitempty"`
+	EmailContent          nullable.Nullable[string] `json:"emailContent,omitempty"`
+	EmailSubject          nullable.Nullable[string] `json:"emailSubject,omitempty"`
+}
+
+// Preview defines model for Preview.
+type Preview struct {
+	Html string `json:"html"`
+
+	// Links Links of the HTML content, in order of appearance
+	Links []PreviewLink `json:"links"`
+
+	// MissingVariables Variables without a value, which sending would fail on
+	MissingVariables []string `json:"missingVariables"`
+	Subject          string   `json:"subject"`
+
+	// Text Plain-text alternative of the HTML content
+	Text string `json:"text"`
+}
+
+// PreviewInput defines model for PreviewInput.
+type PreviewInput struct {
+	// ContactId Contact whose values the step is rendered with
+	ContactId *openapi_types.UUID `json:"contactId,omitempty"`
+
+	// Variables Values by variable path, such as `contact.firstName` or
+	// `custom.company`, set over those of the contact. Values are
+	// strings, numbers, booleans or null.
+	Variables *map[string]interface{} `json:"variables,omitempty"`
+}
+
+// PreviewLink defines model for PreviewLink.
+type PreviewLink struct {
+	// TrackingUrl URL the link is rewritten to when the sequence tracks clicks. In
+	// previews it does not belong to a send and is not redirected.
+	TrackingUrl *string `json:"trackingUrl,omitempty"`
+	Url         string  `json:"url"`
+}
+
+// Send One attempt to deliver a send job. It is sending until it finishes; if it never does, the email may or may not have been sent.
+type Send struct {
+	Attempt    int        `json:"attempt"`
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	Status     SendStatus `json:"status"`
+}
+
+// SendStatus defines model for Send.Status.
+type SendStatus string
+
+// SendJob defines model for SendJob.
+type SendJob struct {
+	EmailContent string             `json:"emailContent"`
+	EmailSubject string             `json:"emailSubject"`
+	EnrollmentId openapi_types.UUID `json:"enrollmentId"`
+	Id           openapi_types.UUID `json:"id"`
+	ScheduledAt  time.Time          `json:"scheduledAt"`
+
+	// StepId Step the job was created for, absent once the step has been deleted
+	StepId *openapi_types.UUID `json:"stepId,omitempty"`
+}
+
+// SendSchedule defines model for SendSchedule.
+type SendSchedule struct {
+	// EndHour Hour emails are sent until, exclusive; 24 is midnight
+	EndHour int `json:"endHour"`
+
+	// Holidays Dates no emails are sent on
+	Holidays []openapi_types.Date `json:"holidays"`
+
+	// StartHour Hour emails are sent from, inclusive
+	StartHour int `json:"startHour"`
+
+	// TimeZone IANA time zone the hours and holidays are in
+	TimeZone string `json:"timeZone"`
+
+	// UseContactTimeZone Applies the hours and holidays in the time zone of each contact
+	// that has one instead of timeZone.
+	UseContactTimeZone bool `json:"useContactTimeZone"`
+
+	// Weekdays Days emails are sent on
+	Weekdays []Weekday `json:"weekdays"`
 }
 
 // Sequence defines model for Sequence.
 type Sequence struct {
+	// ArchivedAt Set when the sequence has been archived
+	ArchivedAt           *time.Time         `json:"archivedAt,omitempty"`
 	ClickTrackingEnabled bool               `json:"clickTrackingEnabled"`
 	CreatedAt            *time.Time         `json:"createdAt,omitempty"`
 	Id                   openapi_types.UUID `json:"id"`
@@ -40,37 +380,368 @@ type Sequence struct {
 	UpdatedAt            *time.Time         `json:"updatedAt,omitempty"`
 }
 
+// SequenceList defines model for SequenceList.
+type SequenceList struct {
+	Items []Sequence `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// SequenceMailboxes defines model for SequenceMailboxes.
+type SequenceMailboxes struct {
+	MailboxIds []openapi_types.UUID `json:"mailboxIds"`
+
+	// Rotation `round_robin` uses the mailboxes of a sequence in turn, `least_used`
+	// the one with the fewest send jobs today.
+	Rotation MailboxRotation `json:"rotation"`
+}
+
+// SequenceStats defines model for SequenceStats.
+type SequenceStats struct {
+	// Steps Stats of the steps of the sequence, in order. Emails of deleted steps only count towards the total.
+	Steps []StepStats `json:"steps"`
+	Total StatsCounts `json:"total"`
+}
+
 // SequenceStep defines model for SequenceStep.
 type SequenceStep struct {
-	CreatedAt             *time.Time         `json:"createdAt,omitempty"`
-	DaysAfterPreviousStep int                `json:"daysAfterPreviousStep"`
-	EmailContent          string             `json:"emailContent"`
-	EmailSubject          string             `json:"emailSubject"`
-	Id                    openapi_types.UUID `json:"id"`
-	UpdatedAt             *time.Time         `json:"updatedAt,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// DaysAfterPreviousStep Must be 0 for the first step. Step changes that would leave a
+	// delayed step first, or a sequence without steps, are rejected with
+	// 422.
+	DaysAfterPreviousStep int `json:"daysAfterPreviousStep"`
+
+	// EmailContent Template of the HTML content, values are HTML escaped
+	EmailContent string `json:"emailContent"`
+
+	// EmailSubject Template of the subject. Expressions like
+	// `{{contact.firstName | default "there"}}` are replaced with the
+	// values of each contact; a template with a syntax error or an
+	// unknown variable is rejected with 422.
+	EmailSubject string             `json:"emailSubject"`
+	Id           openapi_types.UUID `json:"id"`
+	UpdatedAt    *time.Time         `json:"updatedAt,omitempty"`
+}
+
+// SequenceStepInput defines model for SequenceStepInput.
+type SequenceStepInput struct {
+	DaysAfterPreviousStep int    `json:"daysAfterPreviousStep"`
+	EmailContent          string `json:"emailContent"`
+	EmailSubject          string `json:"emailSubject"`
+
+	// Id ID of an existing step to update, absent for a new step
+	Id *openapi_types.UUID `json:"id,omitempty"`
+}
+
+// StatsCounts defines model for StatsCounts.
+type StatsCounts struct {
+	Bounced int `json:"bounced"`
+
+	// Clicked Emails with at least one link clicked
+	Clicked int `json:"clicked"`
+
+	// Clicks Times links were clicked
+	Clicks    int `json:"clicks"`
+	Delivered int `json:"delivered"`
+
+	// Opened Emails opened at least once
+	Opened int `json:"opened"`
+
+	// Opens Times emails were opened
+	Opens int `json:"opens"`
+
+	// Rates Fractions of the emails sent for delivery and bounces, of the emails delivered for the rest. 0 when no emails were.
+	Rates StatsRates `json:"rates"`
+
+	// Replied Not recorded yet, always 0
+	Replied int `json:"replied"`
+	Sent    int `json:"sent"`
+
+	// Unsubscribed Not recorded yet, always 0
+	Unsubscribed int `json:"unsubscribed"`
+}
+
+// StatsRates Fractions of the emails sent for delivery and bounces, of the emails delivered for the rest. 0 when no emails were.
+type StatsRates struct {
+	Bounce      float64 `json:"bounce"`
+	Click       float64 `json:"click"`
+	Delivery    float64 `json:"delivery"`
+	Open        float64 `json:"open"`
+	Reply       float64 `json:"reply"`
+	Unsubscribe float64 `json:"unsubscribe"`
+}
+
+// StepPosition defines model for StepPosition.
+type StepPosition struct {
+	// AfterStepId Place the step right after this step
+	AfterStepId *openapi_types.UUID `json:"afterStepId,omitempty"`
+
+	// BeforeStepId Place the step right before this step
+	BeforeStepId *openapi_types.UUID `json:"beforeStepId,omitempty"`
+}
+
+// StepStats defines model for StepStats.
+type StepStats struct {
+	Counts StatsCounts        `json:"counts"`
+	StepId openapi_types.UUID `json:"stepId"`
 }
 
 // UpdateSequenceInput defines model for UpdateSequenceInput.
 type UpdateSequenceInput struct {
-	ClickTrackingEnabled *bool `json:"clickTrackingEnabled,omitempty"`
-	OpenTrackingEnabled  *bool `json:"openTrackingEnabled,omitempty"`
+	ClickTrackingEnabled *bool                `json:"clickTrackingEnabled,omitempty"`
+	Name                 *string              `json:"name,omitempty"`
+	OpenTrackingEnabled  *bool                `json:"openTrackingEnabled,omitempty"`
+	Steps                *[]SequenceStepInput `json:"steps,omitempty"`
 }
 
 // UpdateSequenceStepInput defines model for UpdateSequenceStepInput.
 type UpdateSequenceStepInput struct {
-	EmailContent *string `json:"emailContent,omitempty"`
-	EmailSubject *string `json:"emailSubject,omitempty"`
+	// AfterStepId Move the step right after this step
+	AfterStepId *openapi_types.UUID `json:"afterStepId,omitempty"`
+
+	// BeforeStepId Move the step right before this step
+	BeforeStepId          *openapi_types.UUID `json:"beforeStepId,omitempty"`
+	DaysAfterPreviousStep int                 `json:"daysAfterPreviousStep"`
+	EmailContent          string              `json:"emailContent"`
+	EmailSubject          string              `json:"emailSubject"`
+}
+
+// Weekday defines model for Weekday.
+type Weekday string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// TrackClickParams defines parameters for TrackClick.
+type TrackClickParams struct {
+	UserAgent *string `json:"User-Agent,omitempty"`
+}
+
+// TrackOpenParams defines parameters for TrackOpen.
+type TrackOpenParams struct {
+	UserAgent *string `json:"User-Agent,omitempty"`
 }
 
+// ListDeadLettersParams defines parameters for ListDeadLetters.
+type ListDeadLettersParams struct {
+	// Cursor Opaque cursor returned as `nextCursor` by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListContactsParams defines parameters for ListContacts.
+type ListContactsParams struct {
+	// Q Case-insensitive substring filter on the email, first and last name
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Cursor Opaque cursor returned as `nextCursor` by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateContactParams defines parameters for CreateContact.
+type CreateContactParams struct {
+	// IdempotencyKey Unique key making the request safe to retry. A repeated request with
+	// the same key gets the original response replayed, a request reusing
+	// the key with a different path, query or body is rejected with 422.
+	// Keys expire after a configurable TTL, 24 hours by default. While the
+	// first request is in progress, repeats are rejected with 409 for at
+	// most a configurable lease, 1 minute by default. Bodies of requests
+	// with a key are limited to 1 MiB.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateMailboxParams defines parameters for CreateMailbox.
+type CreateMailboxParams struct {
+	// IdempotencyKey Unique key making the request safe to retry. A repeated request with
+	// the same key gets the original response replayed, a request reusing
+	// the key with a different path, query or body is rejected with 422.
+	// Keys expire after a configurable TTL, 24 hours by default. While the
+	// first request is in progress, repeats are rejected with 409 for at
+	// most a configurable lease, 1 minute by default. Bodies of requests
+	// with a key are limited to 1 MiB.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ListSequencesParams defines parameters for ListSequences.
+type ListSequencesParams struct {
+	// Cursor Opaque cursor returned as `nextCursor` by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort Sort field, prefixed with `-` for descending order
+	Sort *ListSequencesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Name Case-insensitive substring filter on the sequence name
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
+
+// ListSequencesParamsSort defines parameters for ListSequences.
+type ListSequencesParamsSort string
+
+// CreateSequenceParams defines parameters for CreateSequence.
+type CreateSequenceParams struct {
+	// IdempotencyKey Unique key making the request safe to retry. A repeated request with
+	// the same key gets the original response replayed, a request reusing
+	// the key with a different path, query or body is rejected with 422.
+	// Keys expire after a configurable TTL, 24 hours by default. While the
+	// first request is in progress, repeats are rejected with 409 for at
+	// most a configurable lease, 1 minute by default. Bodies of requests
+	// with a key are limited to 1 MiB.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteSequenceParams defines parameters for DeleteSequence.
+type DeleteSequenceParams struct {
+	// Hard Permanently delete the sequence instead of archiving it. This also
+	// deletes its steps, enrollments, send jobs, sends and tracking
+	// events, so the sequence's stats history is lost.
+	Hard *bool `form:"hard,omitempty" json:"hard,omitempty"`
+}
+
+// UpdateSequenceParams defines parameters for UpdateSequence.
+type UpdateSequenceParams struct {
+	// IfMatch ETag of the resource as last seen by the client. The request fails
+	// with 412 Precondition Failed when the resource has changed since.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RestoreSequenceParams defines parameters for RestoreSequence.
+type RestoreSequenceParams struct {
+	// IdempotencyKey Unique key making the request safe to retry. A repeated request with
+	// the same key gets the original response replayed, a request reusing
+	// the key with a different path, query or body is rejected with 422.
+	// Keys expire after a configurable TTL, 24 hours by default. While the
+	// first request is in progress, repeats are rejected with 409 for at
+	// most a configurable lease, 1 minute by default. Bodies of requests
+	// with a key are limited to 1 MiB.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateEnrollmentParams defines parameters for CreateEnrollment.
+type CreateEnrollmentParams struct {
+	// IdempotencyKey Unique key making the request safe to retry. A repeated request with
+	// the same key gets the original response replayed, a request reusing
+	// the key with a different path, query or body is rejected with 422.
+	// Keys expire after a configurable TTL, 24 hours by default. While the
+	// first request is in progress, repeats are rejected with 409 for at
+	// most a configurable lease, 1 minute by default. Bodies of requests
+	// with a key are limited to 1 MiB.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateEnrollmentsParams defines parameters for CreateEnrollments.
+type CreateEnrollmentsParams struct {
+	// IdempotencyKey Unique key making the request safe to retry. A repeated request with
+	// the same key gets the original response replayed, a request reusing
+	// the key with a different path, query or body is rejected with 422.
+	// Keys expire after a configurable TTL, 24 hours by default. While the
+	// first request is in progress, repeats are rejected with 409 for at
+	// most a configurable lease, 1 minute by default. Bodies of requests
+	// with a key are limited to 1 MiB.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetSequenceStatsParams defines parameters for GetSequenceStats.
+type GetSequenceStatsParams struct {
+	// From Count only what happened at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Count only what happened before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// IncludeAutomated Also count opens and clicks that look machine-made, such as those of Apple Mail Privacy Protection and link scanners
+	IncludeAutomated *bool `form:"includeAutomated,omitempty" json:"includeAutomated,omitempty"`
+}
+
+// CreateSequenceStepParams defines parameters for CreateSequenceStep.
+type CreateSequenceStepParams struct {
+	// IdempotencyKey Unique key making the request safe to retry. A repeated request with
+	// the same key gets the original response replayed, a request reusing
+	// the key with a different path, query or body is rejected with 422.
+	// Keys expire after a configurable TTL, 24 hours by default. While the
+	// first request is in progress, repeats are rejected with 409 for at
+	// most a configurable lease, 1 minute by default. Bodies of requests
+	// with a key are limited to 1 MiB.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PatchSequenceStepParams defines parameters for PatchSequenceStep.
+type PatchSequenceStepParams struct {
+	// IfMatch ETag of the resource as last seen by the client. The request fails
+	// with 412 Precondition Failed when the resource has changed since.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateSequenceStepParams defines parameters for UpdateSequenceStep.
+type UpdateSequenceStepParams struct {
+	// IfMatch ETag of the resource as last seen by the client. The request fails
+	// with 412 Precondition Failed when the resource has changed since.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// MoveSequenceStepParams defines parameters for MoveSequenceStep.
+type MoveSequenceStepParams struct {
+	// IdempotencyKey Unique key making the request safe to retry. A repeated request with
+	// the same key gets the original response replayed, a request reusing
+	// the key with a different path, query or body is rejected with 422.
+	// Keys expire after a configurable TTL, 24 hours by default. While the
+	// first request is in progress, repeats are rejected with 409 for at
+	// most a configurable lease, 1 minute by default. Bodies of requests
+	// with a key are limited to 1 MiB.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateContactJSONRequestBody defines body for CreateContact for application/json ContentType.
+type CreateContactJSONRequestBody = ContactInput
+
+// UpdateContactJSONRequestBody defines body for UpdateContact for application/json ContentType.
+type UpdateContactJSONRequestBody = ContactInput
+
+// CreateMailboxJSONRequestBody defines body for CreateMailbox for application/json ContentType.
+type CreateMailboxJSONRequestBody = MailboxInput
+
+// UpdateMailboxJSONRequestBody defines body for UpdateMailbox for application/json ContentType.
+type UpdateMailboxJSONRequestBody = MailboxInput
+
 // CreateSequenceJSONRequestBody defines body for CreateSequence for application/json ContentType.
 type CreateSequenceJSONRequestBody = Sequence
 
 // UpdateSequenceJSONRequestBody defines body for UpdateSequence for application/json ContentType.
 type UpdateSequenceJSONRequestBody = UpdateSequenceInput
 
+// CreateEnrollmentJSONRequestBody defines body for CreateEnrollment for application/json ContentType.
+type CreateEnrollmentJSONRequestBody = EnrollmentInput
+
+// CreateEnrollmentsJSONRequestBody defines body for CreateEnrollments for application/json ContentType.
+type CreateEnrollmentsJSONRequestBody = BulkEnrollmentInput
+
+// SetSequenceMailboxesJSONRequestBody defines body for SetSequenceMailboxes for application/json ContentType.
+type SetSequenceMailboxesJSONRequestBody = SequenceMailboxes
+
+// SetSendScheduleJSONRequestBody defines body for SetSendSchedule for application/json ContentType.
+type SetSendScheduleJSONRequestBody = SendSchedule
+
+// CreateSequenceStepJSONRequestBody defines body for CreateSequenceStep for application/json ContentType.
+type CreateSequenceStepJSONRequestBody = CreateSequenceStepInput
+
+// PatchSequenceStepApplicationMergePatchPlusJSONRequestBody defines body for PatchSequenceStep for application/merge-patch+json ContentType.
+type PatchSequenceStepApplicationMergePatchPlusJSONRequestBody = PatchSequenceStepInput
+
 // UpdateSequenceStepJSONRequestBody defines body for UpdateSequenceStep for application/json ContentType.
 type UpdateSequenceStepJSONRequestBody = UpdateSequenceStepInput
 
+// MoveSequenceStepJSONRequestBody defines body for MoveSequenceStep for application/json ContentType.
+type MoveSequenceStepJSONRequestBody = StepPosition
+
+// PreviewSequenceStepJSONRequestBody defines body for PreviewSequenceStep for application/json ContentType.
+type PreviewSequenceStepJSONRequestBody = PreviewInput
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -144,27 +815,153 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// TrackClick request
+	TrackClick(ctx context.Context, token string, params *TrackClickParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TrackOpen request
+	TrackOpen(ctx context.Context, token string, params *TrackOpenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDeadLetters request
+	ListDeadLetters(ctx context.Context, params *ListDeadLettersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeadLetter request
+	GetDeadLetter(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequeueDeadLetter request
+	RequeueDeadLetter(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListContacts request
+	ListContacts(ctx context.Context, params *ListContactsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateContactWithBody request with any body
+	CreateContactWithBody(ctx context.Context, params *CreateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateContact(ctx context.Context, params *CreateContactParams, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteContact request
+	DeleteContact(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetContact request
+	GetContact(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateContactWithBody request with any body
+	UpdateContactWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateContact(ctx context.Context, id string, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEnrollment request
+	GetEnrollment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PauseEnrollment request
+	PauseEnrollment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResumeEnrollment request
+	ResumeEnrollment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StopEnrollment request
+	StopEnrollment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMailboxes request
+	ListMailboxes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateMailboxWithBody request with any body
+	CreateMailboxWithBody(ctx context.Context, params *CreateMailboxParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateMailbox(ctx context.Context, params *CreateMailboxParams, body CreateMailboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMailbox request
+	DeleteMailbox(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMailbox request
+	GetMailbox(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateMailboxWithBody request with any body
+	UpdateMailboxWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateMailbox(ctx context.Context, id string, body UpdateMailboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSequences request
+	ListSequences(ctx context.Context, params *ListSequencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateSequenceWithBody request with any body
-	CreateSequenceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateSequenceWithBody(ctx context.Context, params *CreateSequenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSequence(ctx context.Context, params *CreateSequenceParams, body CreateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreateSequence(ctx context.Context, body CreateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DeleteSequence request
+	DeleteSequence(ctx context.Context, id string, params *DeleteSequenceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSequence request
+	GetSequence(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateSequenceWithBody request with any body
-	UpdateSequenceWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateSequenceWithBody(ctx context.Context, id string, params *UpdateSequenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSequence(ctx context.Context, id string, params *UpdateSequenceParams, body UpdateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreSequence request
+	RestoreSequence(ctx context.Context, id string, params *RestoreSequenceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateEnrollmentWithBody request with any body
+	CreateEnrollmentWithBody(ctx context.Context, sequenceId string, params *CreateEnrollmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateEnrollment(ctx context.Context, sequenceId string, params *CreateEnrollmentParams, body CreateEnrollmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateEnrollmentsWithBody request with any body
+	CreateEnrollmentsWithBody(ctx context.Context, sequenceId string, params *CreateEnrollmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateSequence(ctx context.Context, id string, body UpdateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateEnrollments(ctx context.Context, sequenceId string, params *CreateEnrollmentsParams, body CreateEnrollmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSequenceMailboxes request
+	GetSequenceMailboxes(ctx context.Context, sequenceId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetSequenceMailboxesWithBody request with any body
+	SetSequenceMailboxesWithBody(ctx context.Context, sequenceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetSequenceMailboxes(ctx context.Context, sequenceId string, body SetSequenceMailboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSendSchedule request
+	GetSendSchedule(ctx context.Context, sequenceId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetSendScheduleWithBody request with any body
+	SetSendScheduleWithBody(ctx context.Context, sequenceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetSendSchedule(ctx context.Context, sequenceId string, body SetSendScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSequenceStats request
+	GetSequenceStats(ctx context.Context, sequenceId string, params *GetSequenceStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSequenceStepWithBody request with any body
+	CreateSequenceStepWithBody(ctx context.Context, sequenceId string, params *CreateSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSequenceStep(ctx context.Context, sequenceId string, params *CreateSequenceStepParams, body CreateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteSequenceStep request
 	DeleteSequenceStep(ctx context.Context, sequenceId string, stepId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchSequenceStepWithBody request with any body
+	PatchSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, params *PatchSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchSequenceStepWithApplicationMergePatchPlusJSONBody(ctx context.Context, sequenceId string, stepId string, params *PatchSequenceStepParams, body PatchSequenceStepApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UpdateSequenceStepWithBody request with any body
-	UpdateSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, params *UpdateSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSequenceStep(ctx context.Context, sequenceId string, stepId string, params *UpdateSequenceStepParams, body UpdateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateSequenceStep(ctx context.Context, sequenceId string, stepId string, body UpdateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// MoveSequenceStepWithBody request with any body
+	MoveSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, params *MoveSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveSequenceStep(ctx context.Context, sequenceId string, stepId string, params *MoveSequenceStepParams, body MoveSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewSequenceStepWithBody request with any body
+	PreviewSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewSequenceStep(ctx context.Context, sequenceId string, stepId string, body PreviewSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) CreateSequenceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSequenceRequestWithBody(c.Server, contentType, body)
+func (c *Client) TrackClick(ctx context.Context, token string, params *TrackClickParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTrackClickRequest(c.Server, token, params)
 	if err != nil {
 		return nil, err
 	}
@@ -175,8 +972,8 @@ func (c *Client) CreateSequenceWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateSequence(ctx context.Context, body CreateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateSequenceRequest(c.Server, body)
+func (c *Client) TrackOpen(ctx context.Context, token string, params *TrackOpenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTrackOpenRequest(c.Server, token, params)
 	if err != nil {
 		return nil, err
 	}
@@ -187,8 +984,8 @@ func (c *Client) CreateSequence(ctx context.Context, body CreateSequenceJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSequenceWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSequenceRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ListDeadLetters(ctx context.Context, params *ListDeadLettersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDeadLettersRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -199,8 +996,8 @@ func (c *Client) UpdateSequenceWithBody(ctx context.Context, id string, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSequence(ctx context.Context, id string, body UpdateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSequenceRequest(c.Server, id, body)
+func (c *Client) GetDeadLetter(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeadLetterRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -211,8 +1008,8 @@ func (c *Client) UpdateSequence(ctx context.Context, id string, body UpdateSeque
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteSequenceStep(ctx context.Context, sequenceId string, stepId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteSequenceStepRequest(c.Server, sequenceId, stepId)
+func (c *Client) RequeueDeadLetter(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequeueDeadLetterRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -223,8 +1020,8 @@ func (c *Client) DeleteSequenceStep(ctx context.Context, sequenceId string, step
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSequenceStepRequestWithBody(c.Server, sequenceId, stepId, contentType, body)
+func (c *Client) ListContacts(ctx context.Context, params *ListContactsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListContactsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -235,8 +1032,8 @@ func (c *Client) UpdateSequenceStepWithBody(ctx context.Context, sequenceId stri
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSequenceStep(ctx context.Context, sequenceId string, stepId string, body UpdateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSequenceStepRequest(c.Server, sequenceId, stepId, body)
+func (c *Client) CreateContactWithBody(ctx context.Context, params *CreateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateContactRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -247,955 +1044,9030 @@ func (c *Client) UpdateSequenceStep(ctx context.Context, sequenceId string, step
 	return c.Client.Do(req)
 }
 
-// NewCreateSequenceRequest calls the generic CreateSequence builder with application/json body
-func NewCreateSequenceRequest(server string, body CreateSequenceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateContact(ctx context.Context, params *CreateContactParams, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateContactRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateSequenceRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateSequenceRequestWithBody generates requests for CreateSequence with any type of body
-func NewCreateSequenceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteContact(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteContactRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/sequences")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetContact(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContactRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) UpdateContactWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateContactRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateSequenceRequest calls the generic UpdateSequence builder with application/json body
-func NewUpdateSequenceRequest(server string, id string, body UpdateSequenceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateContact(ctx context.Context, id string, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateContactRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateSequenceRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateSequenceRequestWithBody generates requests for UpdateSequence with any type of body
-func NewUpdateSequenceRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetEnrollment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnrollmentRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PauseEnrollment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPauseEnrollmentRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/sequences/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ResumeEnrollment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeEnrollmentRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteSequenceStepRequest generates requests for DeleteSequenceStep
-func NewDeleteSequenceStepRequest(server string, sequenceId string, stepId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+func (c *Client) StopEnrollment(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStopEnrollmentRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step_id", runtime.ParamLocationPath, stepId)
+func (c *Client) ListMailboxes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMailboxesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateMailboxWithBody(ctx context.Context, params *CreateMailboxParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMailboxRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/sequences/%s/steps/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateMailbox(ctx context.Context, params *CreateMailboxParams, body CreateMailboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMailboxRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) DeleteMailbox(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMailboxRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateSequenceStepRequest calls the generic UpdateSequenceStep builder with application/json body
-func NewUpdateSequenceStepRequest(server string, sequenceId string, stepId string, body UpdateSequenceStepJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetMailbox(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMailboxRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateSequenceStepRequestWithBody(server, sequenceId, stepId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateSequenceStepRequestWithBody generates requests for UpdateSequenceStep with any type of body
-func NewUpdateSequenceStepRequestWithBody(server string, sequenceId string, stepId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+func (c *Client) UpdateMailboxWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMailboxRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step_id", runtime.ParamLocationPath, stepId)
+func (c *Client) UpdateMailbox(ctx context.Context, id string, body UpdateMailboxJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMailboxRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListSequences(ctx context.Context, params *ListSequencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSequencesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/v1/sequences/%s/steps/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateSequenceWithBody(ctx context.Context, params *CreateSequenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSequenceRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) CreateSequence(ctx context.Context, params *CreateSequenceParams, body CreateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSequenceRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) DeleteSequence(ctx context.Context, id string, params *DeleteSequenceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSequenceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) GetSequence(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSequenceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) UpdateSequenceWithBody(ctx context.Context, id string, params *UpdateSequenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSequenceRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) UpdateSequence(ctx context.Context, id string, params *UpdateSequenceParams, body UpdateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSequenceRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CreateSequenceWithBodyWithResponse request with any body
-	CreateSequenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSequenceResponse, error)
-
-	CreateSequenceWithResponse(ctx context.Context, body CreateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSequenceResponse, error)
-
-	// UpdateSequenceWithBodyWithResponse request with any body
-	UpdateSequenceWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSequenceResponse, error)
-
-	UpdateSequenceWithResponse(ctx context.Context, id string, body UpdateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSequenceResponse, error)
-
-	// DeleteSequenceStepWithResponse request
-	DeleteSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, reqEditors ...RequestEditorFn) (*DeleteSequenceStepResponse, error)
-
-	// UpdateSequenceStepWithBodyWithResponse request with any body
-	UpdateSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSequenceStepResponse, error)
-
-	UpdateSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, body UpdateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSequenceStepResponse, error)
+func (c *Client) RestoreSequence(ctx context.Context, id string, params *RestoreSequenceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreSequenceRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type CreateSequenceResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON201                       *Sequence
-	ApplicationproblemJSONDefault *Error
+func (c *Client) CreateEnrollmentWithBody(ctx context.Context, sequenceId string, params *CreateEnrollmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnrollmentRequestWithBody(c.Server, sequenceId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateSequenceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) CreateEnrollment(ctx context.Context, sequenceId string, params *CreateEnrollmentParams, body CreateEnrollmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnrollmentRequest(c.Server, sequenceId, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateSequenceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) CreateEnrollmentsWithBody(ctx context.Context, sequenceId string, params *CreateEnrollmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnrollmentsRequestWithBody(c.Server, sequenceId, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type UpdateSequenceResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *Sequence
-	ApplicationproblemJSONDefault *Error
+func (c *Client) CreateEnrollments(ctx context.Context, sequenceId string, params *CreateEnrollmentsParams, body CreateEnrollmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnrollmentsRequest(c.Server, sequenceId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateSequenceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetSequenceMailboxes(ctx context.Context, sequenceId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSequenceMailboxesRequest(c.Server, sequenceId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSequenceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) SetSequenceMailboxesWithBody(ctx context.Context, sequenceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSequenceMailboxesRequestWithBody(c.Server, sequenceId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type DeleteSequenceStepResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	ApplicationproblemJSONDefault *Error
+func (c *Client) SetSequenceMailboxes(ctx context.Context, sequenceId string, body SetSequenceMailboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSequenceMailboxesRequest(c.Server, sequenceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteSequenceStepResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetSendSchedule(ctx context.Context, sequenceId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSendScheduleRequest(c.Server, sequenceId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSequenceStepResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) SetSendScheduleWithBody(ctx context.Context, sequenceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSendScheduleRequestWithBody(c.Server, sequenceId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type UpdateSequenceStepResponse struct {
-	Body                          []byte
-	HTTPResponse                  *http.Response
-	JSON200                       *SequenceStep
-	ApplicationproblemJSONDefault *Error
+func (c *Client) SetSendSchedule(ctx context.Context, sequenceId string, body SetSendScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSendScheduleRequest(c.Server, sequenceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateSequenceStepResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetSequenceStats(ctx context.Context, sequenceId string, params *GetSequenceStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSequenceStatsRequest(c.Server, sequenceId, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSequenceStepResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) CreateSequenceStepWithBody(ctx context.Context, sequenceId string, params *CreateSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSequenceStepRequestWithBody(c.Server, sequenceId, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// CreateSequenceWithBodyWithResponse request with arbitrary body returning *CreateSequenceResponse
-func (c *ClientWithResponses) CreateSequenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSequenceResponse, error) {
-	rsp, err := c.CreateSequenceWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) CreateSequenceStep(ctx context.Context, sequenceId string, params *CreateSequenceStepParams, body CreateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSequenceStepRequest(c.Server, sequenceId, params, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSequenceResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) CreateSequenceWithResponse(ctx context.Context, body CreateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSequenceResponse, error) {
-	rsp, err := c.CreateSequence(ctx, body, reqEditors...)
+func (c *Client) DeleteSequenceStep(ctx context.Context, sequenceId string, stepId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSequenceStepRequest(c.Server, sequenceId, stepId)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateSequenceResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// UpdateSequenceWithBodyWithResponse request with arbitrary body returning *UpdateSequenceResponse
-func (c *ClientWithResponses) UpdateSequenceWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSequenceResponse, error) {
-	rsp, err := c.UpdateSequenceWithBody(ctx, id, contentType, body, reqEditors...)
+func (c *Client) PatchSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, params *PatchSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSequenceStepRequestWithBody(c.Server, sequenceId, stepId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSequenceResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) UpdateSequenceWithResponse(ctx context.Context, id string, body UpdateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSequenceResponse, error) {
-	rsp, err := c.UpdateSequence(ctx, id, body, reqEditors...)
+func (c *Client) PatchSequenceStepWithApplicationMergePatchPlusJSONBody(ctx context.Context, sequenceId string, stepId string, params *PatchSequenceStepParams, body PatchSequenceStepApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchSequenceStepRequestWithApplicationMergePatchPlusJSONBody(c.Server, sequenceId, stepId, params, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSequenceResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// DeleteSequenceStepWithResponse request returning *DeleteSequenceStepResponse
-func (c *ClientWithResponses) DeleteSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, reqEditors ...RequestEditorFn) (*DeleteSequenceStepResponse, error) {
-	rsp, err := c.DeleteSequenceStep(ctx, sequenceId, stepId, reqEditors...)
+func (c *Client) UpdateSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, params *UpdateSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSequenceStepRequestWithBody(c.Server, sequenceId, stepId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteSequenceStepResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// UpdateSequenceStepWithBodyWithResponse request with arbitrary body returning *UpdateSequenceStepResponse
-func (c *ClientWithResponses) UpdateSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSequenceStepResponse, error) {
-	rsp, err := c.UpdateSequenceStepWithBody(ctx, sequenceId, stepId, contentType, body, reqEditors...)
+func (c *Client) UpdateSequenceStep(ctx context.Context, sequenceId string, stepId string, params *UpdateSequenceStepParams, body UpdateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSequenceStepRequest(c.Server, sequenceId, stepId, params, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSequenceStepResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) UpdateSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, body UpdateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSequenceStepResponse, error) {
-	rsp, err := c.UpdateSequenceStep(ctx, sequenceId, stepId, body, reqEditors...)
+func (c *Client) MoveSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, params *MoveSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveSequenceStepRequestWithBody(c.Server, sequenceId, stepId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateSequenceStepResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// ParseCreateSequenceResponse parses an HTTP response from a CreateSequenceWithResponse call
-func ParseCreateSequenceResponse(rsp *http.Response) (*CreateSequenceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *Client) MoveSequenceStep(ctx context.Context, sequenceId string, stepId string, params *MoveSequenceStepParams, body MoveSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveSequenceStepRequest(c.Server, sequenceId, stepId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	response := &CreateSequenceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Sequence
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationproblemJSONDefault = &dest
-
+func (c *Client) PreviewSequenceStepWithBody(ctx context.Context, sequenceId string, stepId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewSequenceStepRequestWithBody(c.Server, sequenceId, stepId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-
-	return response, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// ParseUpdateSequenceResponse parses an HTTP response from a UpdateSequenceWithResponse call
-func ParseUpdateSequenceResponse(rsp *http.Response) (*UpdateSequenceResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+func (c *Client) PreviewSequenceStep(ctx context.Context, sequenceId string, stepId string, body PreviewSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewSequenceStepRequest(c.Server, sequenceId, stepId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	response := &UpdateSequenceResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Sequence
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// NewTrackClickRequest generates requests for TrackClick
+func NewTrackClickRequest(server string, token string, params *TrackClickParams) (*http.Request, error) {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationproblemJSONDefault = &dest
+	var pathParam0 string
 
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// ParseDeleteSequenceStepResponse parses an HTTP response from a DeleteSequenceStepWithResponse call
-func ParseDeleteSequenceStepResponse(rsp *http.Response) (*DeleteSequenceStepResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	operationPath := fmt.Sprintf("/t/c/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteSequenceStepResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	if params != nil {
+
+		if params.UserAgent != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, *params.UserAgent)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("User-Agent", headerParam0)
 		}
-		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
-	return response, nil
+	return req, nil
 }
 
-// ParseUpdateSequenceStepResponse parses an HTTP response from a UpdateSequenceStepWithResponse call
-func ParseUpdateSequenceStepResponse(rsp *http.Response) (*UpdateSequenceStepResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// NewTrackOpenRequest generates requests for TrackOpen
+func NewTrackOpenRequest(server string, token string, params *TrackOpenParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "token", runtime.ParamLocationPath, token)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateSequenceStepResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SequenceStep
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.ApplicationproblemJSONDefault = &dest
-
+	operationPath := fmt.Sprintf("/t/o/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	return response, nil
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create sequence
-	// (POST /v1/sequences)
-	CreateSequence(w http.ResponseWriter, r *http.Request)
-	// Update sequence
-	// (PUT /v1/sequences/{id})
-	UpdateSequence(w http.ResponseWriter, r *http.Request, id string)
-	// Delete sequence step
-	// (DELETE /v1/sequences/{sequence_id}/steps/{step_id})
-	DeleteSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string)
-	// Update sequence step
-	// (PUT /v1/sequences/{sequence_id}/steps/{step_id})
-	UpdateSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string)
-}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	if params != nil {
 
-type MiddlewareFunc func(http.Handler) http.Handler
+		if params.UserAgent != nil {
+			var headerParam0 string
 
-// CreateSequence operation middleware
-func (siw *ServerInterfaceWrapper) CreateSequence(w http.ResponseWriter, r *http.Request) {
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, *params.UserAgent)
+			if err != nil {
+				return nil, err
+			}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSequence(w, r)
-	}))
+			req.Header.Set("User-Agent", headerParam0)
+		}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler.ServeHTTP(w, r)
+	return req, nil
 }
 
-// UpdateSequence operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSequence(w http.ResponseWriter, r *http.Request) {
-
+// NewListDeadLettersRequest generates requests for ListDeadLetters
+func NewListDeadLettersRequest(server string, params *ListDeadLettersParams) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	operationPath := fmt.Sprintf("/v1/admin/dead-letters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSequence(w, r, id)
-	}))
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-// DeleteSequenceStep operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSequenceStep(w http.ResponseWriter, r *http.Request) {
+	return req, nil
+}
 
+// NewGetDeadLetterRequest generates requests for GetDeadLetter
+func NewGetDeadLetterRequest(server string, id string) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "sequence_id" -------------
-	var sequenceId string
+	var pathParam0 string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
-		return
+		return nil, err
 	}
 
-	// ------------- Path parameter "step_id" -------------
-	var stepId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "step_id", r.PathValue("step_id"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "step_id", Err: err})
-		return
+		return nil, err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSequenceStep(w, r, sequenceId, stepId)
-	}))
+	operationPath := fmt.Sprintf("/v1/admin/dead-letters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-// UpdateSequenceStep operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSequenceStep(w http.ResponseWriter, r *http.Request) {
+	return req, nil
+}
 
+// NewRequeueDeadLetterRequest generates requests for RequeueDeadLetter
+func NewRequeueDeadLetterRequest(server string, id string) (*http.Request, error) {
 	var err error
 
-	// ------------- Path parameter "sequence_id" -------------
-	var sequenceId string
+	var pathParam0 string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
-		return
+		return nil, err
 	}
 
-	// ------------- Path parameter "step_id" -------------
-	var stepId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "step_id", r.PathValue("step_id"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	serverURL, err := url.Parse(server)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "step_id", Err: err})
-		return
+		return nil, err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSequenceStep(w, r, sequenceId, stepId)
-	}))
+	operationPath := fmt.Sprintf("/v1/admin/dead-letters/%s/requeue", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+	return req, nil
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// NewListContactsRequest generates requests for ListContacts
+func NewListContactsRequest(server string, params *ListContactsParams) (*http.Request, error) {
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	operationPath := fmt.Sprintf("/v1/contacts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type RequiredParamError struct {
-	ParamName string
-}
+		if params.Q != nil {
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+		}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+		if params.Cursor != nil {
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+		}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+		if params.Limit != nil {
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+		}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateContactRequest calls the generic CreateContact builder with application/json body
+func NewCreateContactRequest(server string, params *CreateContactParams, body CreateContactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateContactRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateContactRequestWithBody generates requests for CreateContact with any type of body
+func NewCreateContactRequestWithBody(server string, params *CreateContactParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/contacts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteContactRequest generates requests for DeleteContact
+func NewDeleteContactRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetContactRequest generates requests for GetContact
+func NewGetContactRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateContactRequest calls the generic UpdateContact builder with application/json body
+func NewUpdateContactRequest(server string, id string, body UpdateContactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateContactRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateContactRequestWithBody generates requests for UpdateContact with any type of body
+func NewUpdateContactRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/contacts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEnrollmentRequest generates requests for GetEnrollment
+func NewGetEnrollmentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/enrollments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPauseEnrollmentRequest generates requests for PauseEnrollment
+func NewPauseEnrollmentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/enrollments/%s/pause", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResumeEnrollmentRequest generates requests for ResumeEnrollment
+func NewResumeEnrollmentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/enrollments/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStopEnrollmentRequest generates requests for StopEnrollment
+func NewStopEnrollmentRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/enrollments/%s/stop", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListMailboxesRequest generates requests for ListMailboxes
+func NewListMailboxesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/mailboxes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateMailboxRequest calls the generic CreateMailbox builder with application/json body
+func NewCreateMailboxRequest(server string, params *CreateMailboxParams, body CreateMailboxJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMailboxRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateMailboxRequestWithBody generates requests for CreateMailbox with any type of body
+func NewCreateMailboxRequestWithBody(server string, params *CreateMailboxParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/mailboxes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteMailboxRequest generates requests for DeleteMailbox
+func NewDeleteMailboxRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/mailboxes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMailboxRequest generates requests for GetMailbox
+func NewGetMailboxRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/mailboxes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMailboxRequest calls the generic UpdateMailbox builder with application/json body
+func NewUpdateMailboxRequest(server string, id string, body UpdateMailboxJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMailboxRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateMailboxRequestWithBody generates requests for UpdateMailbox with any type of body
+func NewUpdateMailboxRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/mailboxes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSequencesRequest generates requests for ListSequences
+func NewListSequencesRequest(server string, params *ListSequencesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSequenceRequest calls the generic CreateSequence builder with application/json body
+func NewCreateSequenceRequest(server string, params *CreateSequenceParams, body CreateSequenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSequenceRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateSequenceRequestWithBody generates requests for CreateSequence with any type of body
+func NewCreateSequenceRequestWithBody(server string, params *CreateSequenceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteSequenceRequest generates requests for DeleteSequence
+func NewDeleteSequenceRequest(server string, id string, params *DeleteSequenceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Hard != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hard", runtime.ParamLocationQuery, *params.Hard); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSequenceRequest generates requests for GetSequence
+func NewGetSequenceRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSequenceRequest calls the generic UpdateSequence builder with application/json body
+func NewUpdateSequenceRequest(server string, id string, params *UpdateSequenceParams, body UpdateSequenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSequenceRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateSequenceRequestWithBody generates requests for UpdateSequence with any type of body
+func NewUpdateSequenceRequestWithBody(server string, id string, params *UpdateSequenceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRestoreSequenceRequest generates requests for RestoreSequence
+func NewRestoreSequenceRequest(server string, id string, params *RestoreSequenceParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateEnrollmentRequest calls the generic CreateEnrollment builder with application/json body
+func NewCreateEnrollmentRequest(server string, sequenceId string, params *CreateEnrollmentParams, body CreateEnrollmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateEnrollmentRequestWithBody(server, sequenceId, params, "application/json", bodyReader)
+}
+
+// NewCreateEnrollmentRequestWithBody generates requests for CreateEnrollment with any type of body
+func NewCreateEnrollmentRequestWithBody(server string, sequenceId string, params *CreateEnrollmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/enrollments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateEnrollmentsRequest calls the generic CreateEnrollments builder with application/json body
+func NewCreateEnrollmentsRequest(server string, sequenceId string, params *CreateEnrollmentsParams, body CreateEnrollmentsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateEnrollmentsRequestWithBody(server, sequenceId, params, "application/json", bodyReader)
+}
+
+// NewCreateEnrollmentsRequestWithBody generates requests for CreateEnrollments with any type of body
+func NewCreateEnrollmentsRequestWithBody(server string, sequenceId string, params *CreateEnrollmentsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/enrollments/bulk", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetSequenceMailboxesRequest generates requests for GetSequenceMailboxes
+func NewGetSequenceMailboxesRequest(server string, sequenceId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/mailboxes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetSequenceMailboxesRequest calls the generic SetSequenceMailboxes builder with application/json body
+func NewSetSequenceMailboxesRequest(server string, sequenceId string, body SetSequenceMailboxesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetSequenceMailboxesRequestWithBody(server, sequenceId, "application/json", bodyReader)
+}
+
+// NewSetSequenceMailboxesRequestWithBody generates requests for SetSequenceMailboxes with any type of body
+func NewSetSequenceMailboxesRequestWithBody(server string, sequenceId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/mailboxes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSendScheduleRequest generates requests for GetSendSchedule
+func NewGetSendScheduleRequest(server string, sequenceId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetSendScheduleRequest calls the generic SetSendSchedule builder with application/json body
+func NewSetSendScheduleRequest(server string, sequenceId string, body SetSendScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetSendScheduleRequestWithBody(server, sequenceId, "application/json", bodyReader)
+}
+
+// NewSetSendScheduleRequestWithBody generates requests for SetSendSchedule with any type of body
+func NewSetSendScheduleRequestWithBody(server string, sequenceId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSequenceStatsRequest generates requests for GetSequenceStats
+func NewGetSequenceStatsRequest(server string, sequenceId string, params *GetSequenceStatsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeAutomated != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeAutomated", runtime.ParamLocationQuery, *params.IncludeAutomated); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSequenceStepRequest calls the generic CreateSequenceStep builder with application/json body
+func NewCreateSequenceStepRequest(server string, sequenceId string, params *CreateSequenceStepParams, body CreateSequenceStepJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSequenceStepRequestWithBody(server, sequenceId, params, "application/json", bodyReader)
+}
+
+// NewCreateSequenceStepRequestWithBody generates requests for CreateSequenceStep with any type of body
+func NewCreateSequenceStepRequestWithBody(server string, sequenceId string, params *CreateSequenceStepParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/steps", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteSequenceStepRequest generates requests for DeleteSequenceStep
+func NewDeleteSequenceStepRequest(server string, sequenceId string, stepId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step_id", runtime.ParamLocationPath, stepId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/steps/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchSequenceStepRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchSequenceStep builder with application/merge-patch+json body
+func NewPatchSequenceStepRequestWithApplicationMergePatchPlusJSONBody(server string, sequenceId string, stepId string, params *PatchSequenceStepParams, body PatchSequenceStepApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchSequenceStepRequestWithBody(server, sequenceId, stepId, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchSequenceStepRequestWithBody generates requests for PatchSequenceStep with any type of body
+func NewPatchSequenceStepRequestWithBody(server string, sequenceId string, stepId string, params *PatchSequenceStepParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step_id", runtime.ParamLocationPath, stepId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/steps/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateSequenceStepRequest calls the generic UpdateSequenceStep builder with application/json body
+func NewUpdateSequenceStepRequest(server string, sequenceId string, stepId string, params *UpdateSequenceStepParams, body UpdateSequenceStepJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSequenceStepRequestWithBody(server, sequenceId, stepId, params, "application/json", bodyReader)
+}
+
+// NewUpdateSequenceStepRequestWithBody generates requests for UpdateSequenceStep with any type of body
+func NewUpdateSequenceStepRequestWithBody(server string, sequenceId string, stepId string, params *UpdateSequenceStepParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step_id", runtime.ParamLocationPath, stepId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/steps/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewMoveSequenceStepRequest calls the generic MoveSequenceStep builder with application/json body
+func NewMoveSequenceStepRequest(server string, sequenceId string, stepId string, params *MoveSequenceStepParams, body MoveSequenceStepJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveSequenceStepRequestWithBody(server, sequenceId, stepId, params, "application/json", bodyReader)
+}
+
+// NewMoveSequenceStepRequestWithBody generates requests for MoveSequenceStep with any type of body
+func NewMoveSequenceStepRequestWithBody(server string, sequenceId string, stepId string, params *MoveSequenceStepParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step_id", runtime.ParamLocationPath, stepId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/steps/%s/move", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPreviewSequenceStepRequest calls the generic PreviewSequenceStep builder with application/json body
+func NewPreviewSequenceStepRequest(server string, sequenceId string, stepId string, body PreviewSequenceStepJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewSequenceStepRequestWithBody(server, sequenceId, stepId, "application/json", bodyReader)
+}
+
+// NewPreviewSequenceStepRequestWithBody generates requests for PreviewSequenceStep with any type of body
+func NewPreviewSequenceStepRequestWithBody(server string, sequenceId string, stepId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, sequenceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "step_id", runtime.ParamLocationPath, stepId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/sequences/%s/steps/%s/preview", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// TrackClickWithResponse request
+	TrackClickWithResponse(ctx context.Context, token string, params *TrackClickParams, reqEditors ...RequestEditorFn) (*TrackClickResponse, error)
+
+	// TrackOpenWithResponse request
+	TrackOpenWithResponse(ctx context.Context, token string, params *TrackOpenParams, reqEditors ...RequestEditorFn) (*TrackOpenResponse, error)
+
+	// ListDeadLettersWithResponse request
+	ListDeadLettersWithResponse(ctx context.Context, params *ListDeadLettersParams, reqEditors ...RequestEditorFn) (*ListDeadLettersResponse, error)
+
+	// GetDeadLetterWithResponse request
+	GetDeadLetterWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetDeadLetterResponse, error)
+
+	// RequeueDeadLetterWithResponse request
+	RequeueDeadLetterWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RequeueDeadLetterResponse, error)
+
+	// ListContactsWithResponse request
+	ListContactsWithResponse(ctx context.Context, params *ListContactsParams, reqEditors ...RequestEditorFn) (*ListContactsResponse, error)
+
+	// CreateContactWithBodyWithResponse request with any body
+	CreateContactWithBodyWithResponse(ctx context.Context, params *CreateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContactResponse, error)
+
+	CreateContactWithResponse(ctx context.Context, params *CreateContactParams, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContactResponse, error)
+
+	// DeleteContactWithResponse request
+	DeleteContactWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteContactResponse, error)
+
+	// GetContactWithResponse request
+	GetContactWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetContactResponse, error)
+
+	// UpdateContactWithBodyWithResponse request with any body
+	UpdateContactWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error)
+
+	UpdateContactWithResponse(ctx context.Context, id string, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error)
+
+	// GetEnrollmentWithResponse request
+	GetEnrollmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetEnrollmentResponse, error)
+
+	// PauseEnrollmentWithResponse request
+	PauseEnrollmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PauseEnrollmentResponse, error)
+
+	// ResumeEnrollmentWithResponse request
+	ResumeEnrollmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ResumeEnrollmentResponse, error)
+
+	// StopEnrollmentWithResponse request
+	StopEnrollmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopEnrollmentResponse, error)
+
+	// ListMailboxesWithResponse request
+	ListMailboxesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMailboxesResponse, error)
+
+	// CreateMailboxWithBodyWithResponse request with any body
+	CreateMailboxWithBodyWithResponse(ctx context.Context, params *CreateMailboxParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMailboxResponse, error)
+
+	CreateMailboxWithResponse(ctx context.Context, params *CreateMailboxParams, body CreateMailboxJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMailboxResponse, error)
+
+	// DeleteMailboxWithResponse request
+	DeleteMailboxWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteMailboxResponse, error)
+
+	// GetMailboxWithResponse request
+	GetMailboxWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetMailboxResponse, error)
+
+	// UpdateMailboxWithBodyWithResponse request with any body
+	UpdateMailboxWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMailboxResponse, error)
+
+	UpdateMailboxWithResponse(ctx context.Context, id string, body UpdateMailboxJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMailboxResponse, error)
+
+	// ListSequencesWithResponse request
+	ListSequencesWithResponse(ctx context.Context, params *ListSequencesParams, reqEditors ...RequestEditorFn) (*ListSequencesResponse, error)
+
+	// CreateSequenceWithBodyWithResponse request with any body
+	CreateSequenceWithBodyWithResponse(ctx context.Context, params *CreateSequenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSequenceResponse, error)
+
+	CreateSequenceWithResponse(ctx context.Context, params *CreateSequenceParams, body CreateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSequenceResponse, error)
+
+	// DeleteSequenceWithResponse request
+	DeleteSequenceWithResponse(ctx context.Context, id string, params *DeleteSequenceParams, reqEditors ...RequestEditorFn) (*DeleteSequenceResponse, error)
+
+	// GetSequenceWithResponse request
+	GetSequenceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetSequenceResponse, error)
+
+	// UpdateSequenceWithBodyWithResponse request with any body
+	UpdateSequenceWithBodyWithResponse(ctx context.Context, id string, params *UpdateSequenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSequenceResponse, error)
+
+	UpdateSequenceWithResponse(ctx context.Context, id string, params *UpdateSequenceParams, body UpdateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSequenceResponse, error)
+
+	// RestoreSequenceWithResponse request
+	RestoreSequenceWithResponse(ctx context.Context, id string, params *RestoreSequenceParams, reqEditors ...RequestEditorFn) (*RestoreSequenceResponse, error)
+
+	// CreateEnrollmentWithBodyWithResponse request with any body
+	CreateEnrollmentWithBodyWithResponse(ctx context.Context, sequenceId string, params *CreateEnrollmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnrollmentResponse, error)
+
+	CreateEnrollmentWithResponse(ctx context.Context, sequenceId string, params *CreateEnrollmentParams, body CreateEnrollmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnrollmentResponse, error)
+
+	// CreateEnrollmentsWithBodyWithResponse request with any body
+	CreateEnrollmentsWithBodyWithResponse(ctx context.Context, sequenceId string, params *CreateEnrollmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnrollmentsResponse, error)
+
+	CreateEnrollmentsWithResponse(ctx context.Context, sequenceId string, params *CreateEnrollmentsParams, body CreateEnrollmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnrollmentsResponse, error)
+
+	// GetSequenceMailboxesWithResponse request
+	GetSequenceMailboxesWithResponse(ctx context.Context, sequenceId string, reqEditors ...RequestEditorFn) (*GetSequenceMailboxesResponse, error)
+
+	// SetSequenceMailboxesWithBodyWithResponse request with any body
+	SetSequenceMailboxesWithBodyWithResponse(ctx context.Context, sequenceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSequenceMailboxesResponse, error)
+
+	SetSequenceMailboxesWithResponse(ctx context.Context, sequenceId string, body SetSequenceMailboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSequenceMailboxesResponse, error)
+
+	// GetSendScheduleWithResponse request
+	GetSendScheduleWithResponse(ctx context.Context, sequenceId string, reqEditors ...RequestEditorFn) (*GetSendScheduleResponse, error)
+
+	// SetSendScheduleWithBodyWithResponse request with any body
+	SetSendScheduleWithBodyWithResponse(ctx context.Context, sequenceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSendScheduleResponse, error)
+
+	SetSendScheduleWithResponse(ctx context.Context, sequenceId string, body SetSendScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSendScheduleResponse, error)
+
+	// GetSequenceStatsWithResponse request
+	GetSequenceStatsWithResponse(ctx context.Context, sequenceId string, params *GetSequenceStatsParams, reqEditors ...RequestEditorFn) (*GetSequenceStatsResponse, error)
+
+	// CreateSequenceStepWithBodyWithResponse request with any body
+	CreateSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, params *CreateSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSequenceStepResponse, error)
+
+	CreateSequenceStepWithResponse(ctx context.Context, sequenceId string, params *CreateSequenceStepParams, body CreateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSequenceStepResponse, error)
+
+	// DeleteSequenceStepWithResponse request
+	DeleteSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, reqEditors ...RequestEditorFn) (*DeleteSequenceStepResponse, error)
+
+	// PatchSequenceStepWithBodyWithResponse request with any body
+	PatchSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, params *PatchSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSequenceStepResponse, error)
+
+	PatchSequenceStepWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, sequenceId string, stepId string, params *PatchSequenceStepParams, body PatchSequenceStepApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSequenceStepResponse, error)
+
+	// UpdateSequenceStepWithBodyWithResponse request with any body
+	UpdateSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, params *UpdateSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSequenceStepResponse, error)
+
+	UpdateSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, params *UpdateSequenceStepParams, body UpdateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSequenceStepResponse, error)
+
+	// MoveSequenceStepWithBodyWithResponse request with any body
+	MoveSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, params *MoveSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveSequenceStepResponse, error)
+
+	MoveSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, params *MoveSequenceStepParams, body MoveSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveSequenceStepResponse, error)
+
+	// PreviewSequenceStepWithBodyWithResponse request with any body
+	PreviewSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewSequenceStepResponse, error)
+
+	PreviewSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, body PreviewSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewSequenceStepResponse, error)
+}
+
+type TrackClickResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TrackClickResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TrackClickResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TrackOpenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TrackOpenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TrackOpenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDeadLettersResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeadLetterList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDeadLettersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDeadLettersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeadLetterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *DeadLetterDetails
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeadLetterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeadLetterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequeueDeadLetterResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RequeueDeadLetterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequeueDeadLetterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListContactsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ContactList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListContactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListContactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateContactResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Contact
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteContactResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetContactResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Contact
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateContactResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Contact
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateContactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateContactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnrollmentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Enrollment
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnrollmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnrollmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PauseEnrollmentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Enrollment
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PauseEnrollmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PauseEnrollmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResumeEnrollmentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Enrollment
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResumeEnrollmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumeEnrollmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StopEnrollmentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Enrollment
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r StopEnrollmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopEnrollmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMailboxesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *MailboxList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMailboxesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMailboxesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMailboxResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Mailbox
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMailboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMailboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMailboxResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMailboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMailboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMailboxResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Mailbox
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMailboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMailboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMailboxResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Mailbox
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMailboxResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMailboxResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSequencesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SequenceList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSequencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSequencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSequenceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Sequence
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSequenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSequenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSequenceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSequenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSequenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSequenceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Sequence
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSequenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSequenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSequenceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Sequence
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSequenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSequenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreSequenceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Sequence
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreSequenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreSequenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEnrollmentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Enrollment
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEnrollmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEnrollmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEnrollmentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *BulkEnrollmentResult
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEnrollmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEnrollmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSequenceMailboxesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SequenceMailboxes
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSequenceMailboxesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSequenceMailboxesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetSequenceMailboxesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SequenceMailboxes
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetSequenceMailboxesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetSequenceMailboxesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSendScheduleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SendSchedule
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSendScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSendScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetSendScheduleResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SendSchedule
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SetSendScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetSendScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSequenceStatsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SequenceStats
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSequenceStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSequenceStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSequenceStepResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *SequenceStep
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSequenceStepResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSequenceStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSequenceStepResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSequenceStepResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSequenceStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchSequenceStepResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SequenceStep
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchSequenceStepResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchSequenceStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSequenceStepResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SequenceStep
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSequenceStepResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSequenceStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveSequenceStepResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Sequence
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveSequenceStepResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveSequenceStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewSequenceStepResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Preview
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewSequenceStepResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewSequenceStepResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// TrackClickWithResponse request returning *TrackClickResponse
+func (c *ClientWithResponses) TrackClickWithResponse(ctx context.Context, token string, params *TrackClickParams, reqEditors ...RequestEditorFn) (*TrackClickResponse, error) {
+	rsp, err := c.TrackClick(ctx, token, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTrackClickResponse(rsp)
+}
+
+// TrackOpenWithResponse request returning *TrackOpenResponse
+func (c *ClientWithResponses) TrackOpenWithResponse(ctx context.Context, token string, params *TrackOpenParams, reqEditors ...RequestEditorFn) (*TrackOpenResponse, error) {
+	rsp, err := c.TrackOpen(ctx, token, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTrackOpenResponse(rsp)
+}
+
+// ListDeadLettersWithResponse request returning *ListDeadLettersResponse
+func (c *ClientWithResponses) ListDeadLettersWithResponse(ctx context.Context, params *ListDeadLettersParams, reqEditors ...RequestEditorFn) (*ListDeadLettersResponse, error) {
+	rsp, err := c.ListDeadLetters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDeadLettersResponse(rsp)
+}
+
+// GetDeadLetterWithResponse request returning *GetDeadLetterResponse
+func (c *ClientWithResponses) GetDeadLetterWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetDeadLetterResponse, error) {
+	rsp, err := c.GetDeadLetter(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeadLetterResponse(rsp)
+}
+
+// RequeueDeadLetterWithResponse request returning *RequeueDeadLetterResponse
+func (c *ClientWithResponses) RequeueDeadLetterWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RequeueDeadLetterResponse, error) {
+	rsp, err := c.RequeueDeadLetter(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequeueDeadLetterResponse(rsp)
+}
+
+// ListContactsWithResponse request returning *ListContactsResponse
+func (c *ClientWithResponses) ListContactsWithResponse(ctx context.Context, params *ListContactsParams, reqEditors ...RequestEditorFn) (*ListContactsResponse, error) {
+	rsp, err := c.ListContacts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListContactsResponse(rsp)
+}
+
+// CreateContactWithBodyWithResponse request with arbitrary body returning *CreateContactResponse
+func (c *ClientWithResponses) CreateContactWithBodyWithResponse(ctx context.Context, params *CreateContactParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContactResponse, error) {
+	rsp, err := c.CreateContactWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateContactResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateContactWithResponse(ctx context.Context, params *CreateContactParams, body CreateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContactResponse, error) {
+	rsp, err := c.CreateContact(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateContactResponse(rsp)
+}
+
+// DeleteContactWithResponse request returning *DeleteContactResponse
+func (c *ClientWithResponses) DeleteContactWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteContactResponse, error) {
+	rsp, err := c.DeleteContact(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteContactResponse(rsp)
+}
+
+// GetContactWithResponse request returning *GetContactResponse
+func (c *ClientWithResponses) GetContactWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetContactResponse, error) {
+	rsp, err := c.GetContact(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContactResponse(rsp)
+}
+
+// UpdateContactWithBodyWithResponse request with arbitrary body returning *UpdateContactResponse
+func (c *ClientWithResponses) UpdateContactWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error) {
+	rsp, err := c.UpdateContactWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateContactResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateContactWithResponse(ctx context.Context, id string, body UpdateContactJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactResponse, error) {
+	rsp, err := c.UpdateContact(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateContactResponse(rsp)
+}
+
+// GetEnrollmentWithResponse request returning *GetEnrollmentResponse
+func (c *ClientWithResponses) GetEnrollmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetEnrollmentResponse, error) {
+	rsp, err := c.GetEnrollment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnrollmentResponse(rsp)
+}
+
+// PauseEnrollmentWithResponse request returning *PauseEnrollmentResponse
+func (c *ClientWithResponses) PauseEnrollmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PauseEnrollmentResponse, error) {
+	rsp, err := c.PauseEnrollment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePauseEnrollmentResponse(rsp)
+}
+
+// ResumeEnrollmentWithResponse request returning *ResumeEnrollmentResponse
+func (c *ClientWithResponses) ResumeEnrollmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ResumeEnrollmentResponse, error) {
+	rsp, err := c.ResumeEnrollment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeEnrollmentResponse(rsp)
+}
+
+// StopEnrollmentWithResponse request returning *StopEnrollmentResponse
+func (c *ClientWithResponses) StopEnrollmentWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*StopEnrollmentResponse, error) {
+	rsp, err := c.StopEnrollment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopEnrollmentResponse(rsp)
+}
+
+// ListMailboxesWithResponse request returning *ListMailboxesResponse
+func (c *ClientWithResponses) ListMailboxesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMailboxesResponse, error) {
+	rsp, err := c.ListMailboxes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMailboxesResponse(rsp)
+}
+
+// CreateMailboxWithBodyWithResponse request with arbitrary body returning *CreateMailboxResponse
+func (c *ClientWithResponses) CreateMailboxWithBodyWithResponse(ctx context.Context, params *CreateMailboxParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMailboxResponse, error) {
+	rsp, err := c.CreateMailboxWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMailboxResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMailboxWithResponse(ctx context.Context, params *CreateMailboxParams, body CreateMailboxJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMailboxResponse, error) {
+	rsp, err := c.CreateMailbox(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMailboxResponse(rsp)
+}
+
+// DeleteMailboxWithResponse request returning *DeleteMailboxResponse
+func (c *ClientWithResponses) DeleteMailboxWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteMailboxResponse, error) {
+	rsp, err := c.DeleteMailbox(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMailboxResponse(rsp)
+}
+
+// GetMailboxWithResponse request returning *GetMailboxResponse
+func (c *ClientWithResponses) GetMailboxWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetMailboxResponse, error) {
+	rsp, err := c.GetMailbox(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMailboxResponse(rsp)
+}
+
+// UpdateMailboxWithBodyWithResponse request with arbitrary body returning *UpdateMailboxResponse
+func (c *ClientWithResponses) UpdateMailboxWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMailboxResponse, error) {
+	rsp, err := c.UpdateMailboxWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMailboxResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMailboxWithResponse(ctx context.Context, id string, body UpdateMailboxJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMailboxResponse, error) {
+	rsp, err := c.UpdateMailbox(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMailboxResponse(rsp)
+}
+
+// ListSequencesWithResponse request returning *ListSequencesResponse
+func (c *ClientWithResponses) ListSequencesWithResponse(ctx context.Context, params *ListSequencesParams, reqEditors ...RequestEditorFn) (*ListSequencesResponse, error) {
+	rsp, err := c.ListSequences(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSequencesResponse(rsp)
+}
+
+// CreateSequenceWithBodyWithResponse request with arbitrary body returning *CreateSequenceResponse
+func (c *ClientWithResponses) CreateSequenceWithBodyWithResponse(ctx context.Context, params *CreateSequenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSequenceResponse, error) {
+	rsp, err := c.CreateSequenceWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSequenceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSequenceWithResponse(ctx context.Context, params *CreateSequenceParams, body CreateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSequenceResponse, error) {
+	rsp, err := c.CreateSequence(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSequenceResponse(rsp)
+}
+
+// DeleteSequenceWithResponse request returning *DeleteSequenceResponse
+func (c *ClientWithResponses) DeleteSequenceWithResponse(ctx context.Context, id string, params *DeleteSequenceParams, reqEditors ...RequestEditorFn) (*DeleteSequenceResponse, error) {
+	rsp, err := c.DeleteSequence(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSequenceResponse(rsp)
+}
+
+// GetSequenceWithResponse request returning *GetSequenceResponse
+func (c *ClientWithResponses) GetSequenceWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetSequenceResponse, error) {
+	rsp, err := c.GetSequence(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSequenceResponse(rsp)
+}
+
+// UpdateSequenceWithBodyWithResponse request with arbitrary body returning *UpdateSequenceResponse
+func (c *ClientWithResponses) UpdateSequenceWithBodyWithResponse(ctx context.Context, id string, params *UpdateSequenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSequenceResponse, error) {
+	rsp, err := c.UpdateSequenceWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSequenceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSequenceWithResponse(ctx context.Context, id string, params *UpdateSequenceParams, body UpdateSequenceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSequenceResponse, error) {
+	rsp, err := c.UpdateSequence(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSequenceResponse(rsp)
+}
+
+// RestoreSequenceWithResponse request returning *RestoreSequenceResponse
+func (c *ClientWithResponses) RestoreSequenceWithResponse(ctx context.Context, id string, params *RestoreSequenceParams, reqEditors ...RequestEditorFn) (*RestoreSequenceResponse, error) {
+	rsp, err := c.RestoreSequence(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreSequenceResponse(rsp)
+}
+
+// CreateEnrollmentWithBodyWithResponse request with arbitrary body returning *CreateEnrollmentResponse
+func (c *ClientWithResponses) CreateEnrollmentWithBodyWithResponse(ctx context.Context, sequenceId string, params *CreateEnrollmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnrollmentResponse, error) {
+	rsp, err := c.CreateEnrollmentWithBody(ctx, sequenceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnrollmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEnrollmentWithResponse(ctx context.Context, sequenceId string, params *CreateEnrollmentParams, body CreateEnrollmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnrollmentResponse, error) {
+	rsp, err := c.CreateEnrollment(ctx, sequenceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnrollmentResponse(rsp)
+}
+
+// CreateEnrollmentsWithBodyWithResponse request with arbitrary body returning *CreateEnrollmentsResponse
+func (c *ClientWithResponses) CreateEnrollmentsWithBodyWithResponse(ctx context.Context, sequenceId string, params *CreateEnrollmentsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnrollmentsResponse, error) {
+	rsp, err := c.CreateEnrollmentsWithBody(ctx, sequenceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnrollmentsResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEnrollmentsWithResponse(ctx context.Context, sequenceId string, params *CreateEnrollmentsParams, body CreateEnrollmentsJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnrollmentsResponse, error) {
+	rsp, err := c.CreateEnrollments(ctx, sequenceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnrollmentsResponse(rsp)
+}
+
+// GetSequenceMailboxesWithResponse request returning *GetSequenceMailboxesResponse
+func (c *ClientWithResponses) GetSequenceMailboxesWithResponse(ctx context.Context, sequenceId string, reqEditors ...RequestEditorFn) (*GetSequenceMailboxesResponse, error) {
+	rsp, err := c.GetSequenceMailboxes(ctx, sequenceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSequenceMailboxesResponse(rsp)
+}
+
+// SetSequenceMailboxesWithBodyWithResponse request with arbitrary body returning *SetSequenceMailboxesResponse
+func (c *ClientWithResponses) SetSequenceMailboxesWithBodyWithResponse(ctx context.Context, sequenceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSequenceMailboxesResponse, error) {
+	rsp, err := c.SetSequenceMailboxesWithBody(ctx, sequenceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSequenceMailboxesResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetSequenceMailboxesWithResponse(ctx context.Context, sequenceId string, body SetSequenceMailboxesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSequenceMailboxesResponse, error) {
+	rsp, err := c.SetSequenceMailboxes(ctx, sequenceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSequenceMailboxesResponse(rsp)
+}
+
+// GetSendScheduleWithResponse request returning *GetSendScheduleResponse
+func (c *ClientWithResponses) GetSendScheduleWithResponse(ctx context.Context, sequenceId string, reqEditors ...RequestEditorFn) (*GetSendScheduleResponse, error) {
+	rsp, err := c.GetSendSchedule(ctx, sequenceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSendScheduleResponse(rsp)
+}
+
+// SetSendScheduleWithBodyWithResponse request with arbitrary body returning *SetSendScheduleResponse
+func (c *ClientWithResponses) SetSendScheduleWithBodyWithResponse(ctx context.Context, sequenceId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSendScheduleResponse, error) {
+	rsp, err := c.SetSendScheduleWithBody(ctx, sequenceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSendScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetSendScheduleWithResponse(ctx context.Context, sequenceId string, body SetSendScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSendScheduleResponse, error) {
+	rsp, err := c.SetSendSchedule(ctx, sequenceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSendScheduleResponse(rsp)
+}
+
+// GetSequenceStatsWithResponse request returning *GetSequenceStatsResponse
+func (c *ClientWithResponses) GetSequenceStatsWithResponse(ctx context.Context, sequenceId string, params *GetSequenceStatsParams, reqEditors ...RequestEditorFn) (*GetSequenceStatsResponse, error) {
+	rsp, err := c.GetSequenceStats(ctx, sequenceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSequenceStatsResponse(rsp)
+}
+
+// CreateSequenceStepWithBodyWithResponse request with arbitrary body returning *CreateSequenceStepResponse
+func (c *ClientWithResponses) CreateSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, params *CreateSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSequenceStepResponse, error) {
+	rsp, err := c.CreateSequenceStepWithBody(ctx, sequenceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSequenceStepResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSequenceStepWithResponse(ctx context.Context, sequenceId string, params *CreateSequenceStepParams, body CreateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSequenceStepResponse, error) {
+	rsp, err := c.CreateSequenceStep(ctx, sequenceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSequenceStepResponse(rsp)
+}
+
+// DeleteSequenceStepWithResponse request returning *DeleteSequenceStepResponse
+func (c *ClientWithResponses) DeleteSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, reqEditors ...RequestEditorFn) (*DeleteSequenceStepResponse, error) {
+	rsp, err := c.DeleteSequenceStep(ctx, sequenceId, stepId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSequenceStepResponse(rsp)
+}
+
+// PatchSequenceStepWithBodyWithResponse request with arbitrary body returning *PatchSequenceStepResponse
+func (c *ClientWithResponses) PatchSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, params *PatchSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchSequenceStepResponse, error) {
+	rsp, err := c.PatchSequenceStepWithBody(ctx, sequenceId, stepId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchSequenceStepResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchSequenceStepWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, sequenceId string, stepId string, params *PatchSequenceStepParams, body PatchSequenceStepApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchSequenceStepResponse, error) {
+	rsp, err := c.PatchSequenceStepWithApplicationMergePatchPlusJSONBody(ctx, sequenceId, stepId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchSequenceStepResponse(rsp)
+}
+
+// UpdateSequenceStepWithBodyWithResponse request with arbitrary body returning *UpdateSequenceStepResponse
+func (c *ClientWithResponses) UpdateSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, params *UpdateSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSequenceStepResponse, error) {
+	rsp, err := c.UpdateSequenceStepWithBody(ctx, sequenceId, stepId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSequenceStepResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, params *UpdateSequenceStepParams, body UpdateSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSequenceStepResponse, error) {
+	rsp, err := c.UpdateSequenceStep(ctx, sequenceId, stepId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSequenceStepResponse(rsp)
+}
+
+// MoveSequenceStepWithBodyWithResponse request with arbitrary body returning *MoveSequenceStepResponse
+func (c *ClientWithResponses) MoveSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, params *MoveSequenceStepParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveSequenceStepResponse, error) {
+	rsp, err := c.MoveSequenceStepWithBody(ctx, sequenceId, stepId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveSequenceStepResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, params *MoveSequenceStepParams, body MoveSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveSequenceStepResponse, error) {
+	rsp, err := c.MoveSequenceStep(ctx, sequenceId, stepId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveSequenceStepResponse(rsp)
+}
+
+// PreviewSequenceStepWithBodyWithResponse request with arbitrary body returning *PreviewSequenceStepResponse
+func (c *ClientWithResponses) PreviewSequenceStepWithBodyWithResponse(ctx context.Context, sequenceId string, stepId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewSequenceStepResponse, error) {
+	rsp, err := c.PreviewSequenceStepWithBody(ctx, sequenceId, stepId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewSequenceStepResponse(rsp)
+}
+
+func (c *ClientWithResponses) PreviewSequenceStepWithResponse(ctx context.Context, sequenceId string, stepId string, body PreviewSequenceStepJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewSequenceStepResponse, error) {
+	rsp, err := c.PreviewSequenceStep(ctx, sequenceId, stepId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewSequenceStepResponse(rsp)
+}
+
+// ParseTrackClickResponse parses an HTTP response from a TrackClickWithResponse call
+func ParseTrackClickResponse(rsp *http.Response) (*TrackClickResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TrackClickResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTrackOpenResponse parses an HTTP response from a TrackOpenWithResponse call
+func ParseTrackOpenResponse(rsp *http.Response) (*TrackOpenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TrackOpenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListDeadLettersResponse parses an HTTP response from a ListDeadLettersWithResponse call
+func ParseListDeadLettersResponse(rsp *http.Response) (*ListDeadLettersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDeadLettersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeadLetterList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeadLetterResponse parses an HTTP response from a GetDeadLetterWithResponse call
+func ParseGetDeadLetterResponse(rsp *http.Response) (*GetDeadLetterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeadLetterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeadLetterDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequeueDeadLetterResponse parses an HTTP response from a RequeueDeadLetterWithResponse call
+func ParseRequeueDeadLetterResponse(rsp *http.Response) (*RequeueDeadLetterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequeueDeadLetterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListContactsResponse parses an HTTP response from a ListContactsWithResponse call
+func ParseListContactsResponse(rsp *http.Response) (*ListContactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListContactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ContactList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateContactResponse parses an HTTP response from a CreateContactWithResponse call
+func ParseCreateContactResponse(rsp *http.Response) (*CreateContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Contact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteContactResponse parses an HTTP response from a DeleteContactWithResponse call
+func ParseDeleteContactResponse(rsp *http.Response) (*DeleteContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetContactResponse parses an HTTP response from a GetContactWithResponse call
+func ParseGetContactResponse(rsp *http.Response) (*GetContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Contact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateContactResponse parses an HTTP response from a UpdateContactWithResponse call
+func ParseUpdateContactResponse(rsp *http.Response) (*UpdateContactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateContactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Contact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnrollmentResponse parses an HTTP response from a GetEnrollmentWithResponse call
+func ParseGetEnrollmentResponse(rsp *http.Response) (*GetEnrollmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnrollmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Enrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePauseEnrollmentResponse parses an HTTP response from a PauseEnrollmentWithResponse call
+func ParsePauseEnrollmentResponse(rsp *http.Response) (*PauseEnrollmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PauseEnrollmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Enrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResumeEnrollmentResponse parses an HTTP response from a ResumeEnrollmentWithResponse call
+func ParseResumeEnrollmentResponse(rsp *http.Response) (*ResumeEnrollmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResumeEnrollmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Enrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStopEnrollmentResponse parses an HTTP response from a StopEnrollmentWithResponse call
+func ParseStopEnrollmentResponse(rsp *http.Response) (*StopEnrollmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StopEnrollmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Enrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMailboxesResponse parses an HTTP response from a ListMailboxesWithResponse call
+func ParseListMailboxesResponse(rsp *http.Response) (*ListMailboxesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMailboxesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MailboxList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMailboxResponse parses an HTTP response from a CreateMailboxWithResponse call
+func ParseCreateMailboxResponse(rsp *http.Response) (*CreateMailboxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMailboxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Mailbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMailboxResponse parses an HTTP response from a DeleteMailboxWithResponse call
+func ParseDeleteMailboxResponse(rsp *http.Response) (*DeleteMailboxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMailboxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMailboxResponse parses an HTTP response from a GetMailboxWithResponse call
+func ParseGetMailboxResponse(rsp *http.Response) (*GetMailboxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMailboxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Mailbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMailboxResponse parses an HTTP response from a UpdateMailboxWithResponse call
+func ParseUpdateMailboxResponse(rsp *http.Response) (*UpdateMailboxResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMailboxResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Mailbox
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSequencesResponse parses an HTTP response from a ListSequencesWithResponse call
+func ParseListSequencesResponse(rsp *http.Response) (*ListSequencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSequencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SequenceList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSequenceResponse parses an HTTP response from a CreateSequenceWithResponse call
+func ParseCreateSequenceResponse(rsp *http.Response) (*CreateSequenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSequenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Sequence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSequenceResponse parses an HTTP response from a DeleteSequenceWithResponse call
+func ParseDeleteSequenceResponse(rsp *http.Response) (*DeleteSequenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSequenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSequenceResponse parses an HTTP response from a GetSequenceWithResponse call
+func ParseGetSequenceResponse(rsp *http.Response) (*GetSequenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSequenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Sequence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSequenceResponse parses an HTTP response from a UpdateSequenceWithResponse call
+func ParseUpdateSequenceResponse(rsp *http.Response) (*UpdateSequenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSequenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Sequence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreSequenceResponse parses an HTTP response from a RestoreSequenceWithResponse call
+func ParseRestoreSequenceResponse(rsp *http.Response) (*RestoreSequenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreSequenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Sequence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateEnrollmentResponse parses an HTTP response from a CreateEnrollmentWithResponse call
+func ParseCreateEnrollmentResponse(rsp *http.Response) (*CreateEnrollmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateEnrollmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Enrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateEnrollmentsResponse parses an HTTP response from a CreateEnrollmentsWithResponse call
+func ParseCreateEnrollmentsResponse(rsp *http.Response) (*CreateEnrollmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateEnrollmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkEnrollmentResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSequenceMailboxesResponse parses an HTTP response from a GetSequenceMailboxesWithResponse call
+func ParseGetSequenceMailboxesResponse(rsp *http.Response) (*GetSequenceMailboxesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSequenceMailboxesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SequenceMailboxes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetSequenceMailboxesResponse parses an HTTP response from a SetSequenceMailboxesWithResponse call
+func ParseSetSequenceMailboxesResponse(rsp *http.Response) (*SetSequenceMailboxesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetSequenceMailboxesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SequenceMailboxes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSendScheduleResponse parses an HTTP response from a GetSendScheduleWithResponse call
+func ParseGetSendScheduleResponse(rsp *http.Response) (*GetSendScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSendScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SendSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetSendScheduleResponse parses an HTTP response from a SetSendScheduleWithResponse call
+func ParseSetSendScheduleResponse(rsp *http.Response) (*SetSendScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetSendScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SendSchedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSequenceStatsResponse parses an HTTP response from a GetSequenceStatsWithResponse call
+func ParseGetSequenceStatsResponse(rsp *http.Response) (*GetSequenceStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSequenceStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SequenceStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSequenceStepResponse parses an HTTP response from a CreateSequenceStepWithResponse call
+func ParseCreateSequenceStepResponse(rsp *http.Response) (*CreateSequenceStepResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSequenceStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SequenceStep
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSequenceStepResponse parses an HTTP response from a DeleteSequenceStepWithResponse call
+func ParseDeleteSequenceStepResponse(rsp *http.Response) (*DeleteSequenceStepResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSequenceStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchSequenceStepResponse parses an HTTP response from a PatchSequenceStepWithResponse call
+func ParsePatchSequenceStepResponse(rsp *http.Response) (*PatchSequenceStepResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchSequenceStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SequenceStep
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSequenceStepResponse parses an HTTP response from a UpdateSequenceStepWithResponse call
+func ParseUpdateSequenceStepResponse(rsp *http.Response) (*UpdateSequenceStepResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSequenceStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SequenceStep
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveSequenceStepResponse parses an HTTP response from a MoveSequenceStepWithResponse call
+func ParseMoveSequenceStepResponse(rsp *http.Response) (*MoveSequenceStepResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveSequenceStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Sequence
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewSequenceStepResponse parses an HTTP response from a PreviewSequenceStepWithResponse call
+func ParsePreviewSequenceStepResponse(rsp *http.Response) (*PreviewSequenceStepResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewSequenceStepResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Preview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Track click
+	// (GET /t/c/{token})
+	TrackClick(w http.ResponseWriter, r *http.Request, token string, params TrackClickParams)
+	// Track open
+	// (GET /t/o/{token})
+	TrackOpen(w http.ResponseWriter, r *http.Request, token string, params TrackOpenParams)
+	// List dead letters
+	// (GET /v1/admin/dead-letters)
+	ListDeadLetters(w http.ResponseWriter, r *http.Request, params ListDeadLettersParams)
+	// Get dead letter
+	// (GET /v1/admin/dead-letters/{id})
+	GetDeadLetter(w http.ResponseWriter, r *http.Request, id string)
+	// Requeue dead letter
+	// (POST /v1/admin/dead-letters/{id}/requeue)
+	RequeueDeadLetter(w http.ResponseWriter, r *http.Request, id string)
+	// List contacts
+	// (GET /v1/contacts)
+	ListContacts(w http.ResponseWriter, r *http.Request, params ListContactsParams)
+	// Create contact
+	// (POST /v1/contacts)
+	CreateContact(w http.ResponseWriter, r *http.Request, params CreateContactParams)
+	// Delete contact
+	// (DELETE /v1/contacts/{id})
+	DeleteContact(w http.ResponseWriter, r *http.Request, id string)
+	// Get contact
+	// (GET /v1/contacts/{id})
+	GetContact(w http.ResponseWriter, r *http.Request, id string)
+	// Replace contact
+	// (PUT /v1/contacts/{id})
+	UpdateContact(w http.ResponseWriter, r *http.Request, id string)
+	// Get enrollment
+	// (GET /v1/enrollments/{id})
+	GetEnrollment(w http.ResponseWriter, r *http.Request, id string)
+	// Pause enrollment
+	// (POST /v1/enrollments/{id}/pause)
+	PauseEnrollment(w http.ResponseWriter, r *http.Request, id string)
+	// Resume enrollment
+	// (POST /v1/enrollments/{id}/resume)
+	ResumeEnrollment(w http.ResponseWriter, r *http.Request, id string)
+	// Stop enrollment
+	// (POST /v1/enrollments/{id}/stop)
+	StopEnrollment(w http.ResponseWriter, r *http.Request, id string)
+	// List mailboxes
+	// (GET /v1/mailboxes)
+	ListMailboxes(w http.ResponseWriter, r *http.Request)
+	// Create mailbox
+	// (POST /v1/mailboxes)
+	CreateMailbox(w http.ResponseWriter, r *http.Request, params CreateMailboxParams)
+	// Delete mailbox
+	// (DELETE /v1/mailboxes/{id})
+	DeleteMailbox(w http.ResponseWriter, r *http.Request, id string)
+	// Get mailbox
+	// (GET /v1/mailboxes/{id})
+	GetMailbox(w http.ResponseWriter, r *http.Request, id string)
+	// Update mailbox
+	// (PUT /v1/mailboxes/{id})
+	UpdateMailbox(w http.ResponseWriter, r *http.Request, id string)
+	// List sequences
+	// (GET /v1/sequences)
+	ListSequences(w http.ResponseWriter, r *http.Request, params ListSequencesParams)
+	// Create sequence
+	// (POST /v1/sequences)
+	CreateSequence(w http.ResponseWriter, r *http.Request, params CreateSequenceParams)
+	// Delete sequence
+	// (DELETE /v1/sequences/{id})
+	DeleteSequence(w http.ResponseWriter, r *http.Request, id string, params DeleteSequenceParams)
+	// Get sequence
+	// (GET /v1/sequences/{id})
+	GetSequence(w http.ResponseWriter, r *http.Request, id string)
+	// Update sequence
+	// (PUT /v1/sequences/{id})
+	UpdateSequence(w http.ResponseWriter, r *http.Request, id string, params UpdateSequenceParams)
+	// Restore archived sequence
+	// (POST /v1/sequences/{id}/restore)
+	RestoreSequence(w http.ResponseWriter, r *http.Request, id string, params RestoreSequenceParams)
+	// Enroll contact
+	// (POST /v1/sequences/{sequence_id}/enrollments)
+	CreateEnrollment(w http.ResponseWriter, r *http.Request, sequenceId string, params CreateEnrollmentParams)
+	// Enroll contacts
+	// (POST /v1/sequences/{sequence_id}/enrollments/bulk)
+	CreateEnrollments(w http.ResponseWriter, r *http.Request, sequenceId string, params CreateEnrollmentsParams)
+	// Get sequence mailboxes
+	// (GET /v1/sequences/{sequence_id}/mailboxes)
+	GetSequenceMailboxes(w http.ResponseWriter, r *http.Request, sequenceId string)
+	// Set sequence mailboxes
+	// (PUT /v1/sequences/{sequence_id}/mailboxes)
+	SetSequenceMailboxes(w http.ResponseWriter, r *http.Request, sequenceId string)
+	// Get send schedule
+	// (GET /v1/sequences/{sequence_id}/schedule)
+	GetSendSchedule(w http.ResponseWriter, r *http.Request, sequenceId string)
+	// Set send schedule
+	// (PUT /v1/sequences/{sequence_id}/schedule)
+	SetSendSchedule(w http.ResponseWriter, r *http.Request, sequenceId string)
+	// Get sequence stats
+	// (GET /v1/sequences/{sequence_id}/stats)
+	GetSequenceStats(w http.ResponseWriter, r *http.Request, sequenceId string, params GetSequenceStatsParams)
+	// Add sequence step
+	// (POST /v1/sequences/{sequence_id}/steps)
+	CreateSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, params CreateSequenceStepParams)
+	// Delete sequence step
+	// (DELETE /v1/sequences/{sequence_id}/steps/{step_id})
+	DeleteSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string)
+	// Patch sequence step
+	// (PATCH /v1/sequences/{sequence_id}/steps/{step_id})
+	PatchSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string, params PatchSequenceStepParams)
+	// Replace sequence step
+	// (PUT /v1/sequences/{sequence_id}/steps/{step_id})
+	UpdateSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string, params UpdateSequenceStepParams)
+	// Move sequence step
+	// (POST /v1/sequences/{sequence_id}/steps/{step_id}/move)
+	MoveSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string, params MoveSequenceStepParams)
+	// Preview sequence step
+	// (POST /v1/sequences/{sequence_id}/steps/{step_id}/preview)
+	PreviewSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// TrackClick operation middleware
+func (siw *ServerInterfaceWrapper) TrackClick(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TrackClickParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "User-Agent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
+		var UserAgent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "User-Agent", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", valueList[0], &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "User-Agent", Err: err})
+			return
+		}
+
+		params.UserAgent = &UserAgent
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TrackClick(w, r, token, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TrackOpen operation middleware
+func (siw *ServerInterfaceWrapper) TrackOpen(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", r.PathValue("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TrackOpenParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "User-Agent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("User-Agent")]; found {
+		var UserAgent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "User-Agent", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "User-Agent", valueList[0], &UserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "User-Agent", Err: err})
+			return
+		}
+
+		params.UserAgent = &UserAgent
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TrackOpen(w, r, token, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDeadLettersParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDeadLetters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) GetDeadLetter(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeadLetter(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequeueDeadLetter operation middleware
+func (siw *ServerInterfaceWrapper) RequeueDeadLetter(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequeueDeadLetter(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListContacts operation middleware
+func (siw *ServerInterfaceWrapper) ListContacts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListContactsParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListContacts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateContact operation middleware
+func (siw *ServerInterfaceWrapper) CreateContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateContactParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateContact(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteContact operation middleware
+func (siw *ServerInterfaceWrapper) DeleteContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteContact(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetContact operation middleware
+func (siw *ServerInterfaceWrapper) GetContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContact(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateContact operation middleware
+func (siw *ServerInterfaceWrapper) UpdateContact(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateContact(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnrollment(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PauseEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) PauseEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseEnrollment(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ResumeEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) ResumeEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeEnrollment(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StopEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) StopEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StopEnrollment(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMailboxes operation middleware
+func (siw *ServerInterfaceWrapper) ListMailboxes(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMailboxes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMailbox operation middleware
+func (siw *ServerInterfaceWrapper) CreateMailbox(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateMailboxParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMailbox(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMailbox operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMailbox(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMailbox(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMailbox operation middleware
+func (siw *ServerInterfaceWrapper) GetMailbox(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMailbox(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMailbox operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMailbox(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMailbox(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSequences operation middleware
+func (siw *ServerInterfaceWrapper) ListSequences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSequencesParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSequences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSequence operation middleware
+func (siw *ServerInterfaceWrapper) CreateSequence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSequenceParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSequence(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSequence operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSequence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSequenceParams
+
+	// ------------- Optional query parameter "hard" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hard", r.URL.Query(), &params.Hard)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hard", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSequence(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSequence operation middleware
+func (siw *ServerInterfaceWrapper) GetSequence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSequence(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSequence operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSequence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSequenceParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSequence(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreSequence operation middleware
+func (siw *ServerInterfaceWrapper) RestoreSequence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreSequenceParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreSequence(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEnrollment operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnrollment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateEnrollmentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEnrollment(w, r, sequenceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEnrollments operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnrollments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateEnrollmentsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEnrollments(w, r, sequenceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSequenceMailboxes operation middleware
+func (siw *ServerInterfaceWrapper) GetSequenceMailboxes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSequenceMailboxes(w, r, sequenceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSequenceMailboxes operation middleware
+func (siw *ServerInterfaceWrapper) SetSequenceMailboxes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSequenceMailboxes(w, r, sequenceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSendSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetSendSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSendSchedule(w, r, sequenceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetSendSchedule operation middleware
+func (siw *ServerInterfaceWrapper) SetSendSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSendSchedule(w, r, sequenceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSequenceStats operation middleware
+func (siw *ServerInterfaceWrapper) GetSequenceStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSequenceStatsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeAutomated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeAutomated", r.URL.Query(), &params.IncludeAutomated)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeAutomated", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSequenceStats(w, r, sequenceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSequenceStep operation middleware
+func (siw *ServerInterfaceWrapper) CreateSequenceStep(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSequenceStepParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSequenceStep(w, r, sequenceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSequenceStep operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSequenceStep(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "step_id" -------------
+	var stepId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "step_id", r.PathValue("step_id"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "step_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSequenceStep(w, r, sequenceId, stepId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchSequenceStep operation middleware
+func (siw *ServerInterfaceWrapper) PatchSequenceStep(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "step_id" -------------
+	var stepId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "step_id", r.PathValue("step_id"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "step_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchSequenceStepParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchSequenceStep(w, r, sequenceId, stepId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSequenceStep operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSequenceStep(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "step_id" -------------
+	var stepId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "step_id", r.PathValue("step_id"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "step_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSequenceStepParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSequenceStep(w, r, sequenceId, stepId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MoveSequenceStep operation middleware
+func (siw *ServerInterfaceWrapper) MoveSequenceStep(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "step_id" -------------
+	var stepId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "step_id", r.PathValue("step_id"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "step_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MoveSequenceStepParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MoveSequenceStep(w, r, sequenceId, stepId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PreviewSequenceStep operation middleware
+func (siw *ServerInterfaceWrapper) PreviewSequenceStep(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sequence_id", r.PathValue("sequence_id"), &sequenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "step_id" -------------
+	var stepId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "step_id", r.PathValue("step_id"), &stepId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "step_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewSequenceStep(w, r, sequenceId, stepId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/t/c/{token}", wrapper.TrackClick)
+	m.HandleFunc("GET "+options.BaseURL+"/t/o/{token}", wrapper.TrackOpen)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/admin/dead-letters", wrapper.ListDeadLetters)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/admin/dead-letters/{id}", wrapper.GetDeadLetter)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/admin/dead-letters/{id}/requeue", wrapper.RequeueDeadLetter)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/contacts", wrapper.ListContacts)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/contacts", wrapper.CreateContact)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/contacts/{id}", wrapper.DeleteContact)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/contacts/{id}", wrapper.GetContact)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/contacts/{id}", wrapper.UpdateContact)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/enrollments/{id}", wrapper.GetEnrollment)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/enrollments/{id}/pause", wrapper.PauseEnrollment)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/enrollments/{id}/resume", wrapper.ResumeEnrollment)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/enrollments/{id}/stop", wrapper.StopEnrollment)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/mailboxes", wrapper.ListMailboxes)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/mailboxes", wrapper.CreateMailbox)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/mailboxes/{id}", wrapper.DeleteMailbox)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/mailboxes/{id}", wrapper.GetMailbox)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/mailboxes/{id}", wrapper.UpdateMailbox)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/sequences", wrapper.ListSequences)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/sequences", wrapper.CreateSequence)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/sequences/{id}", wrapper.DeleteSequence)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/sequences/{id}", wrapper.GetSequence)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/sequences/{id}", wrapper.UpdateSequence)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/sequences/{id}/restore", wrapper.RestoreSequence)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/sequences/{sequence_id}/enrollments", wrapper.CreateEnrollment)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/sequences/{sequence_id}/enrollments/bulk", wrapper.CreateEnrollments)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/sequences/{sequence_id}/mailboxes", wrapper.GetSequenceMailboxes)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/sequences/{sequence_id}/mailboxes", wrapper.SetSequenceMailboxes)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/sequences/{sequence_id}/schedule", wrapper.GetSendSchedule)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/sequences/{sequence_id}/schedule", wrapper.SetSendSchedule)
+	m.HandleFunc("GET "+options.BaseURL+"/v1/sequences/{sequence_id}/stats", wrapper.GetSequenceStats)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/sequences/{sequence_id}/steps", wrapper.CreateSequenceStep)
+	m.HandleFunc("DELETE "+options.BaseURL+"/v1/sequences/{sequence_id}/steps/{step_id}", wrapper.DeleteSequenceStep)
+	m.HandleFunc("PATCH "+options.BaseURL+"/v1/sequences/{sequence_id}/steps/{step_id}", wrapper.PatchSequenceStep)
+	m.HandleFunc("PUT "+options.BaseURL+"/v1/sequences/{sequence_id}/steps/{step_id}", wrapper.UpdateSequenceStep)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/sequences/{sequence_id}/steps/{step_id}/move", wrapper.MoveSequenceStep)
+	m.HandleFunc("POST "+options.BaseURL+"/v1/sequences/{sequence_id}/steps/{step_id}/preview", wrapper.PreviewSequenceStep)
+
+	return m
+}
+
+type TrackClickRequestObject struct {
+	Token  string `json:"token"`
+	Params TrackClickParams
+}
+
+type TrackClickResponseObject interface {
+	VisitTrackClickResponse(w http.ResponseWriter) error
+}
+
+type TrackClick302ResponseHeaders struct {
+	CacheControl string
+	Location     string
+}
+
+type TrackClick302Response struct {
+	Headers TrackClick302ResponseHeaders
+}
+
+func (response TrackClick302Response) VisitTrackClickResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
+}
+
+type TrackClickdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response TrackClickdefaultApplicationProblemPlusJSONResponse) VisitTrackClickResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type TrackOpenRequestObject struct {
+	Token  string `json:"token"`
+	Params TrackOpenParams
+}
+
+type TrackOpenResponseObject interface {
+	VisitTrackOpenResponse(w http.ResponseWriter) error
+}
+
+type TrackOpen200ResponseHeaders struct {
+	CacheControl string
+}
+
+type TrackOpen200ImagegifResponse struct {
+	Body          io.Reader
+	Headers       TrackOpen200ResponseHeaders
+	ContentLength int64
+}
+
+func (response TrackOpen200ImagegifResponse) VisitTrackOpenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/gif")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ListDeadLettersRequestObject struct {
+	Params ListDeadLettersParams
+}
+
+type ListDeadLettersResponseObject interface {
+	VisitListDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type ListDeadLetters200JSONResponse DeadLetterList
+
+func (response ListDeadLetters200JSONResponse) VisitListDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListDeadLettersdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ListDeadLettersdefaultApplicationProblemPlusJSONResponse) VisitListDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDeadLetterRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetDeadLetterResponseObject interface {
+	VisitGetDeadLetterResponse(w http.ResponseWriter) error
+}
+
+type GetDeadLetter200JSONResponse DeadLetterDetails
+
+func (response GetDeadLetter200JSONResponse) VisitGetDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeadLetterdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetDeadLetterdefaultApplicationProblemPlusJSONResponse) VisitGetDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RequeueDeadLetterRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RequeueDeadLetterResponseObject interface {
+	VisitRequeueDeadLetterResponse(w http.ResponseWriter) error
+}
+
+type RequeueDeadLetter202Response struct {
+}
+
+func (response RequeueDeadLetter202Response) VisitRequeueDeadLetterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type RequeueDeadLetterdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RequeueDeadLetterdefaultApplicationProblemPlusJSONResponse) VisitRequeueDeadLetterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListContactsRequestObject struct {
+	Params ListContactsParams
+}
+
+type ListContactsResponseObject interface {
+	VisitListContactsResponse(w http.ResponseWriter) error
+}
+
+type ListContacts200JSONResponse ContactList
+
+func (response ListContacts200JSONResponse) VisitListContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListContactsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ListContactsdefaultApplicationProblemPlusJSONResponse) VisitListContactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateContactRequestObject struct {
+	Params CreateContactParams
+	Body   *CreateContactJSONRequestBody
+}
+
+type CreateContactResponseObject interface {
+	VisitCreateContactResponse(w http.ResponseWriter) error
+}
+
+type CreateContact201JSONResponse Contact
+
+func (response CreateContact201JSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateContactdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateContactdefaultApplicationProblemPlusJSONResponse) VisitCreateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteContactRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteContactResponseObject interface {
+	VisitDeleteContactResponse(w http.ResponseWriter) error
+}
+
+type DeleteContact204Response struct {
+}
+
+func (response DeleteContact204Response) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteContactdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DeleteContactdefaultApplicationProblemPlusJSONResponse) VisitDeleteContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetContactRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetContactResponseObject interface {
+	VisitGetContactResponse(w http.ResponseWriter) error
+}
+
+type GetContact200JSONResponse Contact
+
+func (response GetContact200JSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetContactdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetContactdefaultApplicationProblemPlusJSONResponse) VisitGetContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateContactRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateContactJSONRequestBody
+}
+
+type UpdateContactResponseObject interface {
+	VisitUpdateContactResponse(w http.ResponseWriter) error
+}
+
+type UpdateContact200JSONResponse Contact
+
+func (response UpdateContact200JSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateContactdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UpdateContactdefaultApplicationProblemPlusJSONResponse) VisitUpdateContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetEnrollmentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetEnrollmentResponseObject interface {
+	VisitGetEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type GetEnrollment200JSONResponse Enrollment
+
+func (response GetEnrollment200JSONResponse) VisitGetEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEnrollmentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetEnrollmentdefaultApplicationProblemPlusJSONResponse) VisitGetEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PauseEnrollmentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PauseEnrollmentResponseObject interface {
+	VisitPauseEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type PauseEnrollment200JSONResponse Enrollment
+
+func (response PauseEnrollment200JSONResponse) VisitPauseEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PauseEnrollmentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PauseEnrollmentdefaultApplicationProblemPlusJSONResponse) VisitPauseEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ResumeEnrollmentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ResumeEnrollmentResponseObject interface {
+	VisitResumeEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type ResumeEnrollment200JSONResponse Enrollment
+
+func (response ResumeEnrollment200JSONResponse) VisitResumeEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumeEnrollmentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ResumeEnrollmentdefaultApplicationProblemPlusJSONResponse) VisitResumeEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type StopEnrollmentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type StopEnrollmentResponseObject interface {
+	VisitStopEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type StopEnrollment200JSONResponse Enrollment
+
+func (response StopEnrollment200JSONResponse) VisitStopEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopEnrollmentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response StopEnrollmentdefaultApplicationProblemPlusJSONResponse) VisitStopEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListMailboxesRequestObject struct {
+}
+
+type ListMailboxesResponseObject interface {
+	VisitListMailboxesResponse(w http.ResponseWriter) error
+}
+
+type ListMailboxes200JSONResponse MailboxList
+
+func (response ListMailboxes200JSONResponse) VisitListMailboxesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMailboxesdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ListMailboxesdefaultApplicationProblemPlusJSONResponse) VisitListMailboxesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateMailboxRequestObject struct {
+	Params CreateMailboxParams
+	Body   *CreateMailboxJSONRequestBody
+}
+
+type CreateMailboxResponseObject interface {
+	VisitCreateMailboxResponse(w http.ResponseWriter) error
+}
+
+type CreateMailbox201JSONResponse Mailbox
+
+func (response CreateMailbox201JSONResponse) VisitCreateMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMailboxdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateMailboxdefaultApplicationProblemPlusJSONResponse) VisitCreateMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteMailboxRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteMailboxResponseObject interface {
+	VisitDeleteMailboxResponse(w http.ResponseWriter) error
+}
+
+type DeleteMailbox204Response struct {
+}
+
+func (response DeleteMailbox204Response) VisitDeleteMailboxResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteMailboxdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DeleteMailboxdefaultApplicationProblemPlusJSONResponse) VisitDeleteMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMailboxRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetMailboxResponseObject interface {
+	VisitGetMailboxResponse(w http.ResponseWriter) error
+}
+
+type GetMailbox200JSONResponse Mailbox
+
+func (response GetMailbox200JSONResponse) VisitGetMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMailboxdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetMailboxdefaultApplicationProblemPlusJSONResponse) VisitGetMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateMailboxRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateMailboxJSONRequestBody
+}
+
+type UpdateMailboxResponseObject interface {
+	VisitUpdateMailboxResponse(w http.ResponseWriter) error
+}
+
+type UpdateMailbox200JSONResponse Mailbox
+
+func (response UpdateMailbox200JSONResponse) VisitUpdateMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMailboxdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UpdateMailboxdefaultApplicationProblemPlusJSONResponse) VisitUpdateMailboxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListSequencesRequestObject struct {
+	Params ListSequencesParams
+}
+
+type ListSequencesResponseObject interface {
+	VisitListSequencesResponse(w http.ResponseWriter) error
+}
+
+type ListSequences200JSONResponse SequenceList
+
+func (response ListSequences200JSONResponse) VisitListSequencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSequencesdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response ListSequencesdefaultApplicationProblemPlusJSONResponse) VisitListSequencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateSequenceRequestObject struct {
+	Params CreateSequenceParams
+	Body   *CreateSequenceJSONRequestBody
+}
+
+type CreateSequenceResponseObject interface {
+	VisitCreateSequenceResponse(w http.ResponseWriter) error
+}
+
+type CreateSequence201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateSequence201JSONResponse struct {
+	Body    Sequence
+	Headers CreateSequence201ResponseHeaders
+}
+
+func (response CreateSequence201JSONResponse) VisitCreateSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateSequencedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateSequencedefaultApplicationProblemPlusJSONResponse) VisitCreateSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteSequenceRequestObject struct {
+	Id     string `json:"id"`
+	Params DeleteSequenceParams
+}
+
+type DeleteSequenceResponseObject interface {
+	VisitDeleteSequenceResponse(w http.ResponseWriter) error
+}
+
+type DeleteSequence204Response struct {
+}
+
+func (response DeleteSequence204Response) VisitDeleteSequenceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSequencedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DeleteSequencedefaultApplicationProblemPlusJSONResponse) VisitDeleteSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSequenceRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetSequenceResponseObject interface {
+	VisitGetSequenceResponse(w http.ResponseWriter) error
+}
+
+type GetSequence200ResponseHeaders struct {
+	ETag string
+}
+
+type GetSequence200JSONResponse struct {
+	Body    Sequence
+	Headers GetSequence200ResponseHeaders
+}
+
+func (response GetSequence200JSONResponse) VisitGetSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSequencedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSequencedefaultApplicationProblemPlusJSONResponse) VisitGetSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateSequenceRequestObject struct {
+	Id     string `json:"id"`
+	Params UpdateSequenceParams
+	Body   *UpdateSequenceJSONRequestBody
+}
+
+type UpdateSequenceResponseObject interface {
+	VisitUpdateSequenceResponse(w http.ResponseWriter) error
+}
+
+type UpdateSequence200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateSequence200JSONResponse struct {
+	Body    Sequence
+	Headers UpdateSequence200ResponseHeaders
+}
+
+func (response UpdateSequence200JSONResponse) VisitUpdateSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateSequencedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UpdateSequencedefaultApplicationProblemPlusJSONResponse) VisitUpdateSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreSequenceRequestObject struct {
+	Id     string `json:"id"`
+	Params RestoreSequenceParams
+}
+
+type RestoreSequenceResponseObject interface {
+	VisitRestoreSequenceResponse(w http.ResponseWriter) error
+}
+
+type RestoreSequence200ResponseHeaders struct {
+	ETag string
+}
+
+type RestoreSequence200JSONResponse struct {
+	Body    Sequence
+	Headers RestoreSequence200ResponseHeaders
+}
+
+func (response RestoreSequence200JSONResponse) VisitRestoreSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreSequencedefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response RestoreSequencedefaultApplicationProblemPlusJSONResponse) VisitRestoreSequenceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateEnrollmentRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	Params     CreateEnrollmentParams
+	Body       *CreateEnrollmentJSONRequestBody
+}
+
+type CreateEnrollmentResponseObject interface {
+	VisitCreateEnrollmentResponse(w http.ResponseWriter) error
+}
+
+type CreateEnrollment201JSONResponse Enrollment
+
+func (response CreateEnrollment201JSONResponse) VisitCreateEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEnrollmentdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateEnrollmentdefaultApplicationProblemPlusJSONResponse) VisitCreateEnrollmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateEnrollmentsRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	Params     CreateEnrollmentsParams
+	Body       *CreateEnrollmentsJSONRequestBody
+}
+
+type CreateEnrollmentsResponseObject interface {
+	VisitCreateEnrollmentsResponse(w http.ResponseWriter) error
+}
+
+type CreateEnrollments200JSONResponse BulkEnrollmentResult
+
+func (response CreateEnrollments200JSONResponse) VisitCreateEnrollmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateEnrollmentsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateEnrollmentsdefaultApplicationProblemPlusJSONResponse) VisitCreateEnrollmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSequenceMailboxesRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+}
+
+type GetSequenceMailboxesResponseObject interface {
+	VisitGetSequenceMailboxesResponse(w http.ResponseWriter) error
+}
+
+type GetSequenceMailboxes200JSONResponse SequenceMailboxes
+
+func (response GetSequenceMailboxes200JSONResponse) VisitGetSequenceMailboxesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSequenceMailboxesdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSequenceMailboxesdefaultApplicationProblemPlusJSONResponse) VisitGetSequenceMailboxesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetSequenceMailboxesRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	Body       *SetSequenceMailboxesJSONRequestBody
+}
+
+type SetSequenceMailboxesResponseObject interface {
+	VisitSetSequenceMailboxesResponse(w http.ResponseWriter) error
+}
+
+type SetSequenceMailboxes200JSONResponse SequenceMailboxes
+
+func (response SetSequenceMailboxes200JSONResponse) VisitSetSequenceMailboxesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSequenceMailboxesdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetSequenceMailboxesdefaultApplicationProblemPlusJSONResponse) VisitSetSequenceMailboxesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSendScheduleRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+}
+
+type GetSendScheduleResponseObject interface {
+	VisitGetSendScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetSendSchedule200JSONResponse SendSchedule
+
+func (response GetSendSchedule200JSONResponse) VisitGetSendScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSendScheduledefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSendScheduledefaultApplicationProblemPlusJSONResponse) VisitGetSendScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type SetSendScheduleRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	Body       *SetSendScheduleJSONRequestBody
+}
+
+type SetSendScheduleResponseObject interface {
+	VisitSetSendScheduleResponse(w http.ResponseWriter) error
+}
+
+type SetSendSchedule200JSONResponse SendSchedule
+
+func (response SetSendSchedule200JSONResponse) VisitSetSendScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSendScheduledefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response SetSendScheduledefaultApplicationProblemPlusJSONResponse) VisitSetSendScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetSequenceStatsRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	Params     GetSequenceStatsParams
+}
+
+type GetSequenceStatsResponseObject interface {
+	VisitGetSequenceStatsResponse(w http.ResponseWriter) error
+}
+
+type GetSequenceStats200JSONResponse SequenceStats
+
+func (response GetSequenceStats200JSONResponse) VisitGetSequenceStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSequenceStatsdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response GetSequenceStatsdefaultApplicationProblemPlusJSONResponse) VisitGetSequenceStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateSequenceStepRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	Params     CreateSequenceStepParams
+	Body       *CreateSequenceStepJSONRequestBody
+}
+
+type CreateSequenceStepResponseObject interface {
+	VisitCreateSequenceStepResponse(w http.ResponseWriter) error
+}
+
+type CreateSequenceStep201ResponseHeaders struct {
+	ETag string
+}
+
+type CreateSequenceStep201JSONResponse struct {
+	Body    SequenceStep
+	Headers CreateSequenceStep201ResponseHeaders
+}
+
+func (response CreateSequenceStep201JSONResponse) VisitCreateSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateSequenceStepdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response CreateSequenceStepdefaultApplicationProblemPlusJSONResponse) VisitCreateSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteSequenceStepRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	StepId     string `json:"step_id"`
+}
+
+type DeleteSequenceStepResponseObject interface {
+	VisitDeleteSequenceStepResponse(w http.ResponseWriter) error
+}
+
+type DeleteSequenceStep204Response struct {
+}
+
+func (response DeleteSequenceStep204Response) VisitDeleteSequenceStepResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSequenceStepdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response DeleteSequenceStepdefaultApplicationProblemPlusJSONResponse) VisitDeleteSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchSequenceStepRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	StepId     string `json:"step_id"`
+	Params     PatchSequenceStepParams
+	Body       *PatchSequenceStepApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchSequenceStepResponseObject interface {
+	VisitPatchSequenceStepResponse(w http.ResponseWriter) error
+}
+
+type PatchSequenceStep200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchSequenceStep200JSONResponse struct {
+	Body    SequenceStep
+	Headers PatchSequenceStep200ResponseHeaders
+}
+
+func (response PatchSequenceStep200JSONResponse) VisitPatchSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchSequenceStepdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PatchSequenceStepdefaultApplicationProblemPlusJSONResponse) VisitPatchSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateSequenceStepRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	StepId     string `json:"step_id"`
+	Params     UpdateSequenceStepParams
+	Body       *UpdateSequenceStepJSONRequestBody
+}
+
+type UpdateSequenceStepResponseObject interface {
+	VisitUpdateSequenceStepResponse(w http.ResponseWriter) error
+}
+
+type UpdateSequenceStep200ResponseHeaders struct {
+	ETag string
+}
+
+type UpdateSequenceStep200JSONResponse struct {
+	Body    SequenceStep
+	Headers UpdateSequenceStep200ResponseHeaders
+}
+
+func (response UpdateSequenceStep200JSONResponse) VisitUpdateSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateSequenceStepdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response UpdateSequenceStepdefaultApplicationProblemPlusJSONResponse) VisitUpdateSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MoveSequenceStepRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	StepId     string `json:"step_id"`
+	Params     MoveSequenceStepParams
+	Body       *MoveSequenceStepJSONRequestBody
+}
+
+type MoveSequenceStepResponseObject interface {
+	VisitMoveSequenceStepResponse(w http.ResponseWriter) error
+}
+
+type MoveSequenceStep200ResponseHeaders struct {
+	ETag string
+}
+
+type MoveSequenceStep200JSONResponse struct {
+	Body    Sequence
+	Headers MoveSequenceStep200ResponseHeaders
+}
+
+func (response MoveSequenceStep200JSONResponse) VisitMoveSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type MoveSequenceStepdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response MoveSequenceStepdefaultApplicationProblemPlusJSONResponse) VisitMoveSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PreviewSequenceStepRequestObject struct {
+	SequenceId string `json:"sequence_id"`
+	StepId     string `json:"step_id"`
+	Body       *PreviewSequenceStepJSONRequestBody
+}
+
+type PreviewSequenceStepResponseObject interface {
+	VisitPreviewSequenceStepResponse(w http.ResponseWriter) error
+}
+
+type PreviewSequenceStep200JSONResponse Preview
+
+func (response PreviewSequenceStep200JSONResponse) VisitPreviewSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewSequenceStepdefaultApplicationProblemPlusJSONResponse struct {
+	Body       Error
+	StatusCode int
+}
+
+func (response PreviewSequenceStepdefaultApplicationProblemPlusJSONResponse) VisitPreviewSequenceStepResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Track click
+	// (GET /t/c/{token})
+	TrackClick(ctx context.Context, request TrackClickRequestObject) (TrackClickResponseObject, error)
+	// Track open
+	// (GET /t/o/{token})
+	TrackOpen(ctx context.Context, request TrackOpenRequestObject) (TrackOpenResponseObject, error)
+	// List dead letters
+	// (GET /v1/admin/dead-letters)
+	ListDeadLetters(ctx context.Context, request ListDeadLettersRequestObject) (ListDeadLettersResponseObject, error)
+	// Get dead letter
+	// (GET /v1/admin/dead-letters/{id})
+	GetDeadLetter(ctx context.Context, request GetDeadLetterRequestObject) (GetDeadLetterResponseObject, error)
+	// Requeue dead letter
+	// (POST /v1/admin/dead-letters/{id}/requeue)
+	RequeueDeadLetter(ctx context.Context, request RequeueDeadLetterRequestObject) (RequeueDeadLetterResponseObject, error)
+	// List contacts
+	// (GET /v1/contacts)
+	ListContacts(ctx context.Context, request ListContactsRequestObject) (ListContactsResponseObject, error)
+	// Create contact
+	// (POST /v1/contacts)
+	CreateContact(ctx context.Context, request CreateContactRequestObject) (CreateContactResponseObject, error)
+	// Delete contact
+	// (DELETE /v1/contacts/{id})
+	DeleteContact(ctx context.Context, request DeleteContactRequestObject) (DeleteContactResponseObject, error)
+	// Get contact
+	// (GET /v1/contacts/{id})
+	GetContact(ctx context.Context, request GetContactRequestObject) (GetContactResponseObject, error)
+	// Replace contact
+	// (PUT /v1/contacts/{id})
+	UpdateContact(ctx context.Context, request UpdateContactRequestObject) (UpdateContactResponseObject, error)
+	// Get enrollment
+	// (GET /v1/enrollments/{id})
+	GetEnrollment(ctx context.Context, request GetEnrollmentRequestObject) (GetEnrollmentResponseObject, error)
+	// Pause enrollment
+	// (POST /v1/enrollments/{id}/pause)
+	PauseEnrollment(ctx context.Context, request PauseEnrollmentRequestObject) (PauseEnrollmentResponseObject, error)
+	// Resume enrollment
+	// (POST /v1/enrollments/{id}/resume)
+	ResumeEnrollment(ctx context.Context, request ResumeEnrollmentRequestObject) (ResumeEnrollmentResponseObject, error)
+	// Stop enrollment
+	// (POST /v1/enrollments/{id}/stop)
+	StopEnrollment(ctx context.Context, request StopEnrollmentRequestObject) (StopEnrollmentResponseObject, error)
+	// List mailboxes
+	// (GET /v1/mailboxes)
+	ListMailboxes(ctx context.Context, request ListMailboxesRequestObject) (ListMailboxesResponseObject, error)
+	// Create mailbox
+	// (POST /v1/mailboxes)
+	CreateMailbox(ctx context.Context, request CreateMailboxRequestObject) (CreateMailboxResponseObject, error)
+	// Delete mailbox
+	// (DELETE /v1/mailboxes/{id})
+	DeleteMailbox(ctx context.Context, request DeleteMailboxRequestObject) (DeleteMailboxResponseObject, error)
+	// Get mailbox
+	// (GET /v1/mailboxes/{id})
+	GetMailbox(ctx context.Context, request GetMailboxRequestObject) (GetMailboxResponseObject, error)
+	// Update mailbox
+	// (PUT /v1/mailboxes/{id})
+	UpdateMailbox(ctx context.Context, request UpdateMailboxRequestObject) (UpdateMailboxResponseObject, error)
+	// List sequences
+	// (GET /v1/sequences)
+	ListSequences(ctx context.Context, request ListSequencesRequestObject) (ListSequencesResponseObject, error)
+	// Create sequence
+	// (POST /v1/sequences)
+	CreateSequence(ctx context.Context, request CreateSequenceRequestObject) (CreateSequenceResponseObject, error)
+	// Delete sequence
+	// (DELETE /v1/sequences/{id})
+	DeleteSequence(ctx context.Context, request DeleteSequenceRequestObject) (DeleteSequenceResponseObject, error)
+	// Get sequence
+	// (GET /v1/sequences/{id})
+	GetSequence(ctx context.Context, request GetSequenceRequestObject) (GetSequenceResponseObject, error)
+	// Update sequence
+	// (PUT /v1/sequences/{id})
+	UpdateSequence(ctx context.Context, request UpdateSequenceRequestObject) (UpdateSequenceResponseObject, error)
+	// Restore archived sequence
+	// (POST /v1/sequences/{id}/restore)
+	RestoreSequence(ctx context.Context, request RestoreSequenceRequestObject) (RestoreSequenceResponseObject, error)
+	// Enroll contact
+	// (POST /v1/sequences/{sequence_id}/enrollments)
+	CreateEnrollment(ctx context.Context, request CreateEnrollmentRequestObject) (CreateEnrollmentResponseObject, error)
+	// Enroll contacts
+	// (POST /v1/sequences/{sequence_id}/enrollments/bulk)
+	CreateEnrollments(ctx context.Context, request CreateEnrollmentsRequestObject) (CreateEnrollmentsResponseObject, error)
+	// Get sequence mailboxes
+	// (GET /v1/sequences/{sequence_id}/mailboxes)
+	GetSequenceMailboxes(ctx context.Context, request GetSequenceMailboxesRequestObject) (GetSequenceMailboxesResponseObject, error)
+	// Set sequence mailboxes
+	// (PUT /v1/sequences/{sequence_id}/mailboxes)
+	SetSequenceMailboxes(ctx context.Context, request SetSequenceMailboxesRequestObject) (SetSequenceMailboxesResponseObject, error)
+	// Get send schedule
+	// (GET /v1/sequences/{sequence_id}/schedule)
+	GetSendSchedule(ctx context.Context, request GetSendScheduleRequestObject) (GetSendScheduleResponseObject, error)
+	// Set send schedule
+	// (PUT /v1/sequences/{sequence_id}/schedule)
+	SetSendSchedule(ctx context.Context, request SetSendScheduleRequestObject) (SetSendScheduleResponseObject, error)
+	// Get sequence stats
+	// (GET /v1/sequences/{sequence_id}/stats)
+	GetSequenceStats(ctx context.Context, request GetSequenceStatsRequestObject) (GetSequenceStatsResponseObject, error)
+	// Add sequence step
+	// (POST /v1/sequences/{sequence_id}/steps)
+	CreateSequenceStep(ctx context.Context, request CreateSequenceStepRequestObject) (CreateSequenceStepResponseObject, error)
+	// Delete sequence step
+	// (DELETE /v1/sequences/{sequence_id}/steps/{step_id})
+	DeleteSequenceStep(ctx context.Context, request DeleteSequenceStepRequestObject) (DeleteSequenceStepResponseObject, error)
+	// Patch sequence step
+	// (PATCH /v1/sequences/{sequence_id}/steps/{step_id})
+	PatchSequenceStep(ctx context.Context, request PatchSequenceStepRequestObject) (PatchSequenceStepResponseObject, error)
+	// Replace sequence step
+	// (PUT /v1/sequences/{sequence_id}/steps/{step_id})
+	UpdateSequenceStep(ctx context.Context, request UpdateSequenceStepRequestObject) (UpdateSequenceStepResponseObject, error)
+	// Move sequence step
+	// (POST /v1/sequences/{sequence_id}/steps/{step_id}/move)
+	MoveSequenceStep(ctx context.Context, request MoveSequenceStepRequestObject) (MoveSequenceStepResponseObject, error)
+	// Preview sequence step
+	// (POST /v1/sequences/{sequence_id}/steps/{step_id}/preview)
+	PreviewSequenceStep(ctx context.Context, request PreviewSequenceStepRequestObject) (PreviewSequenceStepResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// TrackClick operation middleware
+func (sh *strictHandler) TrackClick(w http.ResponseWriter, r *http.Request, token string, params TrackClickParams) {
+	var request TrackClickRequestObject
+
+	request.Token = token
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TrackClick(ctx, request.(TrackClickRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TrackClick")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TrackClickResponseObject); ok {
+		if err := validResponse.VisitTrackClickResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TrackOpen operation middleware
+func (sh *strictHandler) TrackOpen(w http.ResponseWriter, r *http.Request, token string, params TrackOpenParams) {
+	var request TrackOpenRequestObject
+
+	request.Token = token
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TrackOpen(ctx, request.(TrackOpenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TrackOpen")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TrackOpenResponseObject); ok {
+		if err := validResponse.VisitTrackOpenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDeadLetters operation middleware
+func (sh *strictHandler) ListDeadLetters(w http.ResponseWriter, r *http.Request, params ListDeadLettersParams) {
+	var request ListDeadLettersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDeadLetters(ctx, request.(ListDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDeadLettersResponseObject); ok {
+		if err := validResponse.VisitListDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDeadLetter operation middleware
+func (sh *strictHandler) GetDeadLetter(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetDeadLetterRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDeadLetter(ctx, request.(GetDeadLetterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDeadLetter")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDeadLetterResponseObject); ok {
+		if err := validResponse.VisitGetDeadLetterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
+// RequeueDeadLetter operation middleware
+func (sh *strictHandler) RequeueDeadLetter(w http.ResponseWriter, r *http.Request, id string) {
+	var request RequeueDeadLetterRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequeueDeadLetter(ctx, request.(RequeueDeadLetterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequeueDeadLetter")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequeueDeadLetterResponseObject); ok {
+		if err := validResponse.VisitRequeueDeadLetterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListContacts operation middleware
+func (sh *strictHandler) ListContacts(w http.ResponseWriter, r *http.Request, params ListContactsParams) {
+	var request ListContactsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListContacts(ctx, request.(ListContactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListContacts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListContactsResponseObject); ok {
+		if err := validResponse.VisitListContactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateContact operation middleware
+func (sh *strictHandler) CreateContact(w http.ResponseWriter, r *http.Request, params CreateContactParams) {
+	var request CreateContactRequestObject
+
+	request.Params = params
+
+	var body CreateContactJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateContact(ctx, request.(CreateContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateContactResponseObject); ok {
+		if err := validResponse.VisitCreateContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteContact operation middleware
+func (sh *strictHandler) DeleteContact(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteContactRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteContact(ctx, request.(DeleteContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteContactResponseObject); ok {
+		if err := validResponse.VisitDeleteContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetContact operation middleware
+func (sh *strictHandler) GetContact(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetContactRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetContact(ctx, request.(GetContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetContactResponseObject); ok {
+		if err := validResponse.VisitGetContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateContact operation middleware
+func (sh *strictHandler) UpdateContact(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateContactRequestObject
+
+	request.Id = id
+
+	var body UpdateContactJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateContact(ctx, request.(UpdateContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateContactResponseObject); ok {
+		if err := validResponse.VisitUpdateContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEnrollment operation middleware
+func (sh *strictHandler) GetEnrollment(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetEnrollmentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEnrollment(ctx, request.(GetEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEnrollmentResponseObject); ok {
+		if err := validResponse.VisitGetEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PauseEnrollment operation middleware
+func (sh *strictHandler) PauseEnrollment(w http.ResponseWriter, r *http.Request, id string) {
+	var request PauseEnrollmentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PauseEnrollment(ctx, request.(PauseEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PauseEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PauseEnrollmentResponseObject); ok {
+		if err := validResponse.VisitPauseEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumeEnrollment operation middleware
+func (sh *strictHandler) ResumeEnrollment(w http.ResponseWriter, r *http.Request, id string) {
+	var request ResumeEnrollmentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumeEnrollment(ctx, request.(ResumeEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumeEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResumeEnrollmentResponseObject); ok {
+		if err := validResponse.VisitResumeEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StopEnrollment operation middleware
+func (sh *strictHandler) StopEnrollment(w http.ResponseWriter, r *http.Request, id string) {
+	var request StopEnrollmentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StopEnrollment(ctx, request.(StopEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StopEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StopEnrollmentResponseObject); ok {
+		if err := validResponse.VisitStopEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListMailboxes operation middleware
+func (sh *strictHandler) ListMailboxes(w http.ResponseWriter, r *http.Request) {
+	var request ListMailboxesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMailboxes(ctx, request.(ListMailboxesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMailboxes")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMailboxesResponseObject); ok {
+		if err := validResponse.VisitListMailboxesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateMailbox operation middleware
+func (sh *strictHandler) CreateMailbox(w http.ResponseWriter, r *http.Request, params CreateMailboxParams) {
+	var request CreateMailboxRequestObject
+
+	request.Params = params
+
+	var body CreateMailboxJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateMailbox(ctx, request.(CreateMailboxRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateMailbox")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateMailboxResponseObject); ok {
+		if err := validResponse.VisitCreateMailboxResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+// DeleteMailbox operation middleware
+func (sh *strictHandler) DeleteMailbox(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteMailboxRequestObject
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	request.Id = id
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMailbox(ctx, request.(DeleteMailboxRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMailbox")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteMailboxResponseObject); ok {
+		if err := validResponse.VisitDeleteMailboxResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+// GetMailbox operation middleware
+func (sh *strictHandler) GetMailbox(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetMailboxRequestObject
 
-	if m == nil {
-		m = http.NewServeMux()
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMailbox(ctx, request.(GetMailboxRequestObject))
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMailbox")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMailboxResponseObject); ok {
+		if err := validResponse.VisitGetMailboxResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
+}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+// UpdateMailbox operation middleware
+func (sh *strictHandler) UpdateMailbox(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateMailboxRequestObject
+
+	request.Id = id
+
+	var body UpdateMailboxJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
 	}
+	request.Body = &body
 
-	m.HandleFunc("POST "+options.BaseURL+"/v1/sequences", wrapper.CreateSequence)
-	m.HandleFunc("PUT "+options.BaseURL+"/v1/sequences/{id}", wrapper.UpdateSequence)
-	m.HandleFunc("DELETE "+options.BaseURL+"/v1/sequences/{sequence_id}/steps/{step_id}", wrapper.DeleteSequenceStep)
-	m.HandleFunc("PUT "+options.BaseURL+"/v1/sequences/{sequence_id}/steps/{step_id}", wrapper.UpdateSequenceStep)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateMailbox(ctx, request.(UpdateMailboxRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateMailbox")
+	}
 
-	return m
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateSequenceRequestObject struct {
-	Body *CreateSequenceJSONRequestBody
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateMailboxResponseObject); ok {
+		if err := validResponse.VisitUpdateMailboxResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type CreateSequenceResponseObject interface {
-	VisitCreateSequenceResponse(w http.ResponseWriter) error
-}
+// ListSequences operation middleware
+func (sh *strictHandler) ListSequences(w http.ResponseWriter, r *http.Request, params ListSequencesParams) {
+	var request ListSequencesRequestObject
 
-type CreateSequence201JSONResponse Sequence
+	request.Params = params
 
-func (response CreateSequence201JSONResponse) VisitCreateSequenceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSequences(ctx, request.(ListSequencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSequences")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type CreateSequencedefaultApplicationProblemPlusJSONResponse struct {
-	Body       Error
-	StatusCode int
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSequencesResponseObject); ok {
+		if err := validResponse.VisitListSequencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response CreateSequencedefaultApplicationProblemPlusJSONResponse) VisitCreateSequenceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+// CreateSequence operation middleware
+func (sh *strictHandler) CreateSequence(w http.ResponseWriter, r *http.Request, params CreateSequenceParams) {
+	var request CreateSequenceRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
+	request.Params = params
+
+	var body CreateSequenceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSequence(ctx, request.(CreateSequenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSequence")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateSequenceResponseObject); ok {
+		if err := validResponse.VisitCreateSequenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSequenceRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateSequenceJSONRequestBody
+// DeleteSequence operation middleware
+func (sh *strictHandler) DeleteSequence(w http.ResponseWriter, r *http.Request, id string, params DeleteSequenceParams) {
+	var request DeleteSequenceRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSequence(ctx, request.(DeleteSequenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSequence")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSequenceResponseObject); ok {
+		if err := validResponse.VisitDeleteSequenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSequenceResponseObject interface {
-	VisitUpdateSequenceResponse(w http.ResponseWriter) error
+// GetSequence operation middleware
+func (sh *strictHandler) GetSequence(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetSequenceRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSequence(ctx, request.(GetSequenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSequence")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSequenceResponseObject); ok {
+		if err := validResponse.VisitGetSequenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSequence200JSONResponse Sequence
+// UpdateSequence operation middleware
+func (sh *strictHandler) UpdateSequence(w http.ResponseWriter, r *http.Request, id string, params UpdateSequenceParams) {
+	var request UpdateSequenceRequestObject
 
-func (response UpdateSequence200JSONResponse) VisitUpdateSequenceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	var body UpdateSequenceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateSequence(ctx, request.(UpdateSequenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateSequence")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateSequenceResponseObject); ok {
+		if err := validResponse.VisitUpdateSequenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSequencedefaultApplicationProblemPlusJSONResponse struct {
-	Body       Error
-	StatusCode int
+// RestoreSequence operation middleware
+func (sh *strictHandler) RestoreSequence(w http.ResponseWriter, r *http.Request, id string, params RestoreSequenceParams) {
+	var request RestoreSequenceRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreSequence(ctx, request.(RestoreSequenceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreSequence")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreSequenceResponseObject); ok {
+		if err := validResponse.VisitRestoreSequenceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response UpdateSequencedefaultApplicationProblemPlusJSONResponse) VisitUpdateSequenceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+// CreateEnrollment operation middleware
+func (sh *strictHandler) CreateEnrollment(w http.ResponseWriter, r *http.Request, sequenceId string, params CreateEnrollmentParams) {
+	var request CreateEnrollmentRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
+	request.SequenceId = sequenceId
+	request.Params = params
+
+	var body CreateEnrollmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEnrollment(ctx, request.(CreateEnrollmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEnrollment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEnrollmentResponseObject); ok {
+		if err := validResponse.VisitCreateEnrollmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeleteSequenceStepRequestObject struct {
-	SequenceId string `json:"sequence_id"`
-	StepId     string `json:"step_id"`
-}
+// CreateEnrollments operation middleware
+func (sh *strictHandler) CreateEnrollments(w http.ResponseWriter, r *http.Request, sequenceId string, params CreateEnrollmentsParams) {
+	var request CreateEnrollmentsRequestObject
 
-type DeleteSequenceStepResponseObject interface {
-	VisitDeleteSequenceStepResponse(w http.ResponseWriter) error
-}
+	request.SequenceId = sequenceId
+	request.Params = params
 
-type DeleteSequenceStep204Response struct {
-}
+	var body CreateEnrollmentsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response DeleteSequenceStep204Response) VisitDeleteSequenceStepResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEnrollments(ctx, request.(CreateEnrollmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEnrollments")
+	}
 
-type DeleteSequenceStepdefaultApplicationProblemPlusJSONResponse struct {
-	Body       Error
-	StatusCode int
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEnrollmentsResponseObject); ok {
+		if err := validResponse.VisitCreateEnrollmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeleteSequenceStepdefaultApplicationProblemPlusJSONResponse) VisitDeleteSequenceStepResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+// GetSequenceMailboxes operation middleware
+func (sh *strictHandler) GetSequenceMailboxes(w http.ResponseWriter, r *http.Request, sequenceId string) {
+	var request GetSequenceMailboxesRequestObject
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	request.SequenceId = sequenceId
 
-type UpdateSequenceStepRequestObject struct {
-	SequenceId string `json:"sequence_id"`
-	StepId     string `json:"step_id"`
-	Body       *UpdateSequenceStepJSONRequestBody
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSequenceMailboxes(ctx, request.(GetSequenceMailboxesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSequenceMailboxes")
+	}
 
-type UpdateSequenceStepResponseObject interface {
-	VisitUpdateSequenceStepResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSequenceMailboxesResponseObject); ok {
+		if err := validResponse.VisitGetSequenceMailboxesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSequenceStep200JSONResponse SequenceStep
+// SetSequenceMailboxes operation middleware
+func (sh *strictHandler) SetSequenceMailboxes(w http.ResponseWriter, r *http.Request, sequenceId string) {
+	var request SetSequenceMailboxesRequestObject
 
-func (response UpdateSequenceStep200JSONResponse) VisitUpdateSequenceStepResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.SequenceId = sequenceId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body SetSequenceMailboxesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateSequenceStepdefaultApplicationProblemPlusJSONResponse struct {
-	Body       Error
-	StatusCode int
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetSequenceMailboxes(ctx, request.(SetSequenceMailboxesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetSequenceMailboxes")
+	}
 
-func (response UpdateSequenceStepdefaultApplicationProblemPlusJSONResponse) VisitUpdateSequenceStepResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(response.StatusCode)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetSequenceMailboxesResponseObject); ok {
+		if err := validResponse.VisitSetSequenceMailboxesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Create sequence
-	// (POST /v1/sequences)
-	CreateSequence(ctx context.Context, request CreateSequenceRequestObject) (CreateSequenceResponseObject, error)
-	// Update sequence
-	// (PUT /v1/sequences/{id})
-	UpdateSequence(ctx context.Context, request UpdateSequenceRequestObject) (UpdateSequenceResponseObject, error)
-	// Delete sequence step
-	// (DELETE /v1/sequences/{sequence_id}/steps/{step_id})
-	DeleteSequenceStep(ctx context.Context, request DeleteSequenceStepRequestObject) (DeleteSequenceStepResponseObject, error)
-	// Update sequence step
-	// (PUT /v1/sequences/{sequence_id}/steps/{step_id})
-	UpdateSequenceStep(ctx context.Context, request UpdateSequenceStepRequestObject) (UpdateSequenceStepResponseObject, error)
-}
+// GetSendSchedule operation middleware
+func (sh *strictHandler) GetSendSchedule(w http.ResponseWriter, r *http.Request, sequenceId string) {
+	var request GetSendScheduleRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.SequenceId = sequenceId
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSendSchedule(ctx, request.(GetSendScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSendSchedule")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSendScheduleResponseObject); ok {
+		if err := validResponse.VisitGetSendScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// SetSendSchedule operation middleware
+func (sh *strictHandler) SetSendSchedule(w http.ResponseWriter, r *http.Request, sequenceId string) {
+	var request SetSendScheduleRequestObject
 
-// CreateSequence operation middleware
-func (sh *strictHandler) CreateSequence(w http.ResponseWriter, r *http.Request) {
-	var request CreateSequenceRequestObject
+	request.SequenceId = sequenceId
 
-	var body CreateSequenceJSONRequestBody
+	var body SetSendScheduleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1203,18 +10075,18 @@ func (sh *strictHandler) CreateSequence(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateSequence(ctx, request.(CreateSequenceRequestObject))
+		return sh.ssi.SetSendSchedule(ctx, request.(SetSendScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateSequence")
+		handler = middleware(handler, "SetSendSchedule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateSequenceResponseObject); ok {
-		if err := validResponse.VisitCreateSequenceResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetSendScheduleResponseObject); ok {
+		if err := validResponse.VisitSetSendScheduleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1222,13 +10094,41 @@ func (sh *strictHandler) CreateSequence(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// UpdateSequence operation middleware
-func (sh *strictHandler) UpdateSequence(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateSequenceRequestObject
+// GetSequenceStats operation middleware
+func (sh *strictHandler) GetSequenceStats(w http.ResponseWriter, r *http.Request, sequenceId string, params GetSequenceStatsParams) {
+	var request GetSequenceStatsRequestObject
 
-	request.Id = id
+	request.SequenceId = sequenceId
+	request.Params = params
 
-	var body UpdateSequenceJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSequenceStats(ctx, request.(GetSequenceStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSequenceStats")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSequenceStatsResponseObject); ok {
+		if err := validResponse.VisitGetSequenceStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateSequenceStep operation middleware
+func (sh *strictHandler) CreateSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, params CreateSequenceStepParams) {
+	var request CreateSequenceStepRequestObject
+
+	request.SequenceId = sequenceId
+	request.Params = params
+
+	var body CreateSequenceStepJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1236,18 +10136,18 @@ func (sh *strictHandler) UpdateSequence(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateSequence(ctx, request.(UpdateSequenceRequestObject))
+		return sh.ssi.CreateSequenceStep(ctx, request.(CreateSequenceStepRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateSequence")
+		handler = middleware(handler, "CreateSequenceStep")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateSequenceResponseObject); ok {
-		if err := validResponse.VisitUpdateSequenceResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSequenceStepResponseObject); ok {
+		if err := validResponse.VisitCreateSequenceStepResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1282,12 +10182,48 @@ func (sh *strictHandler) DeleteSequenceStep(w http.ResponseWriter, r *http.Reque
 	}
 }
 
+// PatchSequenceStep operation middleware
+func (sh *strictHandler) PatchSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string, params PatchSequenceStepParams) {
+	var request PatchSequenceStepRequestObject
+
+	request.SequenceId = sequenceId
+	request.StepId = stepId
+	request.Params = params
+
+	var body PatchSequenceStepApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchSequenceStep(ctx, request.(PatchSequenceStepRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchSequenceStep")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchSequenceStepResponseObject); ok {
+		if err := validResponse.VisitPatchSequenceStepResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // UpdateSequenceStep operation middleware
-func (sh *strictHandler) UpdateSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string) {
+func (sh *strictHandler) UpdateSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string, params UpdateSequenceStepParams) {
 	var request UpdateSequenceStepRequestObject
 
 	request.SequenceId = sequenceId
 	request.StepId = stepId
+	request.Params = params
 
 	var body UpdateSequenceStepJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
@@ -1315,3 +10251,72 @@ func (sh *strictHandler) UpdateSequenceStep(w http.ResponseWriter, r *http.Reque
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// MoveSequenceStep operation middleware
+func (sh *strictHandler) MoveSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string, params MoveSequenceStepParams) {
+	var request MoveSequenceStepRequestObject
+
+	request.SequenceId = sequenceId
+	request.StepId = stepId
+	request.Params = params
+
+	var body MoveSequenceStepJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveSequenceStep(ctx, request.(MoveSequenceStepRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveSequenceStep")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MoveSequenceStepResponseObject); ok {
+		if err := validResponse.VisitMoveSequenceStepResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewSequenceStep operation middleware
+func (sh *strictHandler) PreviewSequenceStep(w http.ResponseWriter, r *http.Request, sequenceId string, stepId string) {
+	var request PreviewSequenceStepRequestObject
+
+	request.SequenceId = sequenceId
+	request.StepId = stepId
+
+	var body PreviewSequenceStepJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewSequenceStep(ctx, request.(PreviewSequenceStepRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewSequenceStep")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PreviewSequenceStepResponseObject); ok {
+		if err := validResponse.VisitPreviewSequenceStepResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
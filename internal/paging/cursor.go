@@ -0,0 +1,59 @@
+// Package paging encodes the cursors of keyset paginated listings. Cursors
+// point at the last row of a page and are handed out to clients as opaque
+// base64 strings.
+package paging
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/apperr"
+)
+
+var ErrInvalidCursor error = apperr.Validation(apperr.FieldError{
+	Field:   "cursor",
+	Message: "invalid cursor",
+})
+
+// Encode returns the opaque form of cursor, which must marshal to JSON.
+func Encode(cursor any) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode reads an opaque cursor into cursor. It fails with ErrInvalidCursor
+// for strings Encode did not return.
+func Decode(s string, cursor any) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
+
+// Newest is the cursor of listings that are always ordered newest first, for
+// which the creation time and ID of a row are all it needs.
+type Newest struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"c"`
+}
+
+func (c Newest) Encode() string {
+	return Encode(c)
+}
+
+func DecodeNewest(s string) (*Newest, error) {
+	var c Newest
+	if err := Decode(s, &c); err != nil {
+		return nil, err
+	}
+	if c.ID == uuid.Nil || c.CreatedAt.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
@@ -0,0 +1,31 @@
+package paging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewest(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		c := Newest{ID: uuid.New(), CreatedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+		decoded, err := DecodeNewest(c.Encode())
+		require.NoError(t, err)
+		assert.Equal(t, c.ID, decoded.ID)
+		assert.True(t, c.CreatedAt.Equal(decoded.CreatedAt))
+	})
+
+	t.Run("rejects garbage", func(t *testing.T) {
+		_, err := DecodeNewest("not a cursor!")
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("rejects incomplete cursors", func(t *testing.T) {
+		_, err := DecodeNewest(Encode(map[string]any{"id": uuid.New()}))
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
@@ -0,0 +1,130 @@
+package queue
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var _ Queue = (*Memory)(nil)
+
+// Memory is a queue held in memory, meant for tests and local runs of a
+// single process.
+type Memory struct {
+	mu       sync.Mutex
+	messages []*memoryMessage
+	now      func() time.Time
+}
+
+type memoryMessage struct {
+	Message
+	visibleAt time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{now: time.Now}
+}
+
+func (m *Memory) Enqueue(_ context.Context, body []byte, delay time.Duration) (uuid.UUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	msg := &memoryMessage{
+		Message:   Message{ID: uuid.New(), Body: slices.Clone(body)},
+		visibleAt: m.now().Add(delay),
+	}
+	m.messages = append(m.messages, msg)
+	return msg.ID, nil
+}
+
+func (m *Memory) Receive(_ context.Context, max int, visibility time.Duration) ([]*Message, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	var visible []*memoryMessage
+	for _, msg := range m.messages {
+		if !msg.visibleAt.After(now) {
+			visible = append(visible, msg)
+		}
+	}
+	slices.SortStableFunc(visible, func(a, b *memoryMessage) int {
+		return a.visibleAt.Compare(b.visibleAt)
+	})
+
+	received := make([]*Message, 0, min(max, len(visible)))
+	for _, msg := range visible[:min(max, len(visible))] {
+		msg.Receipt = uuid.New()
+		msg.Attempts++
+		msg.visibleAt = now.Add(visibility)
+
+		received = append(received, &Message{
+			ID:       msg.ID,
+			Body:     slices.Clone(msg.Body),
+			Attempts: msg.Attempts,
+			Receipt:  msg.Receipt,
+		})
+	}
+	return received, nil
+}
+
+func (m *Memory) Extend(_ context.Context, msg *Message, visibility time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	held, err := m.held(msg)
+	if err != nil {
+		return err
+	}
+	held.visibleAt = m.now().Add(visibility)
+	return nil
+}
+
+func (m *Memory) Ack(_ context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	held, err := m.held(msg)
+	if err != nil {
+		return err
+	}
+	m.messages = slices.DeleteFunc(m.messages, func(other *memoryMessage) bool {
+		return other == held
+	})
+	return nil
+}
+
+func (m *Memory) Nack(_ context.Context, msg *Message, delay time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	held, err := m.held(msg)
+	if err != nil {
+		return err
+	}
+	held.Receipt = uuid.Nil
+	held.visibleAt = m.now().Add(delay)
+	return nil
+}
+
+// held returns the stored message if msg is still hidden under its receipt.
+func (m *Memory) held(msg *Message) (*memoryMessage, error) {
+	now := m.now()
+	for _, stored := range m.messages {
+		if stored.ID == msg.ID && stored.Receipt == msg.Receipt && stored.visibleAt.After(now) {
+			return stored, nil
+		}
+	}
+	return nil, ErrReceiptExpired
+}
+
+// Len returns the number of messages in the queue, hidden ones included.
+func (m *Memory) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.messages)
+}
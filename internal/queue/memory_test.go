@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory(t *testing.T) {
+	testQueue(t, NewMemory())
+}
+
+func TestHeartbeatLostMessage(t *testing.T) {
+	q := NewMemory()
+	ctx := context.Background()
+
+	_, err := q.Enqueue(ctx, []byte("lost"), 0)
+	require.NoError(t, err)
+	received, err := q.Receive(ctx, 1, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+
+	// The message is gone before the heartbeat first extends it.
+	require.NoError(t, q.Ack(ctx, received[0]))
+
+	hbCtx, stop := Heartbeat(ctx, q, received[0], 20*time.Millisecond)
+	defer stop()
+
+	select {
+	case <-hbCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("heartbeat did not give up on the lost message")
+	}
+	assert.Zero(t, q.Len())
+}
@@ -0,0 +1,101 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db/models"
+)
+
+var _ Queue = (*Postgres)(nil)
+
+// Postgres keeps the messages of a named queue in the queue_messages table.
+// Timeouts are measured by the database clock, so receivers running on hosts
+// with skewed clocks still agree on when a message is visible.
+type Postgres struct {
+	db   *pgxpool.Pool
+	name string
+}
+
+func NewPostgres(db *pgxpool.Pool, name string) *Postgres {
+	return &Postgres{db: db, name: name}
+}
+
+func (p *Postgres) Enqueue(ctx context.Context, body []byte, delay time.Duration) (uuid.UUID, error) {
+	id, err := models.New(p.db).EnqueueMessage(ctx, &models.EnqueueMessageParams{
+		Queue:        p.name,
+		Body:         body,
+		DelaySeconds: delay.Seconds(),
+	})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("enqueueing message: %w", err)
+	}
+	return id, nil
+}
+
+func (p *Postgres) Receive(ctx context.Context, max int, visibility time.Duration) ([]*Message, error) {
+	rows, err := models.New(p.db).ReceiveMessages(ctx, &models.ReceiveMessagesParams{
+		QueueName:         p.name,
+		Limit:             int32(max),
+		VisibilitySeconds: visibility.Seconds(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("receiving messages: %w", err)
+	}
+
+	messages := make([]*Message, len(rows))
+	for i, row := range rows {
+		messages[i] = &Message{
+			ID:       row.ID,
+			Body:     row.Body,
+			Attempts: int(row.Attempts),
+			Receipt:  row.Receipt.Bytes,
+		}
+	}
+	return messages, nil
+}
+
+func (p *Postgres) Extend(ctx context.Context, msg *Message, visibility time.Duration) error {
+	rows, err := models.New(p.db).ExtendMessage(ctx, &models.ExtendMessageParams{
+		ID:                msg.ID,
+		Receipt:           receipt(msg),
+		VisibilitySeconds: visibility.Seconds(),
+	})
+	return result("extending message", rows, err)
+}
+
+func (p *Postgres) Ack(ctx context.Context, msg *Message) error {
+	rows, err := models.New(p.db).DeleteMessage(ctx, &models.DeleteMessageParams{
+		ID:      msg.ID,
+		Receipt: receipt(msg),
+	})
+	return result("acking message", rows, err)
+}
+
+func (p *Postgres) Nack(ctx context.Context, msg *Message, delay time.Duration) error {
+	rows, err := models.New(p.db).ReleaseMessage(ctx, &models.ReleaseMessageParams{
+		ID:           msg.ID,
+		Receipt:      receipt(msg),
+		DelaySeconds: delay.Seconds(),
+	})
+	return result("nacking message", rows, err)
+}
+
+func receipt(msg *Message) pgtype.UUID {
+	return pgtype.UUID{Bytes: msg.Receipt, Valid: true}
+}
+
+// result turns an update that matched no row into ErrReceiptExpired.
+func result(action string, rows int64, err error) error {
+	if err != nil {
+		return fmt.Errorf("%s: %w", action, err)
+	}
+	if rows == 0 {
+		return ErrReceiptExpired
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+)
+
+func TestPostgres(t *testing.T) {
+	testQueue(t, NewPostgres(dbtest.New(t, ""), "test"))
+}
@@ -0,0 +1,71 @@
+// Package queue hands jobs from producers to workers. A received message is
+// hidden from other receivers for a visibility timeout; if it is neither
+// acknowledged nor extended in time it becomes visible again and is
+// redelivered, so every message is processed at least once.
+package queue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrReceiptExpired is returned when a message is acked, nacked or extended
+// after its visibility timeout passed. It may have been received by someone
+// else in the meantime.
+var ErrReceiptExpired = errors.New("message receipt expired")
+
+type Message struct {
+	ID   uuid.UUID
+	Body []byte
+	// Attempts counts how many times the message has been received, this
+	// time included.
+	Attempts int
+	// Receipt identifies this particular receipt of the message.
+	Receipt uuid.UUID
+}
+
+type Queue interface {
+	// Enqueue adds a message that becomes visible after delay.
+	Enqueue(ctx context.Context, body []byte, delay time.Duration) (uuid.UUID, error)
+	// Receive returns up to max visible messages and hides them for
+	// visibility. It does not wait for messages to arrive.
+	Receive(ctx context.Context, max int, visibility time.Duration) ([]*Message, error)
+	// Extend hides a received message for visibility from now on.
+	Extend(ctx context.Context, msg *Message, visibility time.Duration) error
+	// Ack removes a processed message from the queue.
+	Ack(ctx context.Context, msg *Message) error
+	// Nack returns a message to the queue to be received again after delay.
+	Nack(ctx context.Context, msg *Message, delay time.Duration) error
+}
+
+// Heartbeat keeps msg hidden while it is being processed by extending its
+// visibility every half of visibility. The returned context is cancelled when
+// the message could not be extended, in which case the processing should be
+// abandoned, or when the returned cancel function is called.
+func Heartbeat(ctx context.Context, q Queue, msg *Message, visibility time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		ticker := time.NewTicker(visibility / 2)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := q.Extend(ctx, msg, visibility); err != nil {
+					if ctx.Err() == nil {
+						slog.WarnContext(ctx, "extending message visibility", "message_id", msg.ID, "err", err)
+					}
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	return ctx, cancel
+}
@@ -0,0 +1,122 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testQueue checks the behaviour every Queue implementation has to share.
+// Timeouts are real, so they are kept short.
+func testQueue(t *testing.T, q Queue) {
+	ctx := context.Background()
+	const visibility = 500 * time.Millisecond
+
+	t.Run("ack removes the message", func(t *testing.T) {
+		id, err := q.Enqueue(ctx, []byte("ack"), 0)
+		require.NoError(t, err)
+
+		received, err := q.Receive(ctx, 10, visibility)
+		require.NoError(t, err)
+		require.Len(t, received, 1)
+		assert.Equal(t, id, received[0].ID)
+		assert.Equal(t, []byte("ack"), received[0].Body)
+		assert.Equal(t, 1, received[0].Attempts)
+
+		again, err := q.Receive(ctx, 10, visibility)
+		require.NoError(t, err)
+		assert.Empty(t, again, "a received message is hidden")
+
+		require.NoError(t, q.Ack(ctx, received[0]))
+		assert.ErrorIs(t, q.Ack(ctx, received[0]), ErrReceiptExpired)
+	})
+
+	t.Run("delayed messages are hidden", func(t *testing.T) {
+		_, err := q.Enqueue(ctx, []byte("delayed"), time.Hour)
+		require.NoError(t, err)
+
+		received, err := q.Receive(ctx, 10, visibility)
+		require.NoError(t, err)
+		assert.Empty(t, received)
+	})
+
+	t.Run("expired messages are redelivered", func(t *testing.T) {
+		_, err := q.Enqueue(ctx, []byte("expire"), 0)
+		require.NoError(t, err)
+
+		first, err := q.Receive(ctx, 1, visibility)
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+
+		time.Sleep(visibility + 100*time.Millisecond)
+
+		second, err := q.Receive(ctx, 1, visibility)
+		require.NoError(t, err)
+		require.Len(t, second, 1)
+		assert.Equal(t, first[0].ID, second[0].ID)
+		assert.Equal(t, 2, second[0].Attempts)
+		assert.NotEqual(t, first[0].Receipt, second[0].Receipt)
+
+		assert.ErrorIs(t, q.Ack(ctx, first[0]), ErrReceiptExpired, "the stale receipt must not ack")
+		require.NoError(t, q.Ack(ctx, second[0]))
+	})
+
+	t.Run("extend keeps the message hidden", func(t *testing.T) {
+		_, err := q.Enqueue(ctx, []byte("extend"), 0)
+		require.NoError(t, err)
+
+		received, err := q.Receive(ctx, 1, visibility)
+		require.NoError(t, err)
+		require.Len(t, received, 1)
+
+		time.Sleep(visibility / 2)
+		require.NoError(t, q.Extend(ctx, received[0], visibility))
+		time.Sleep(visibility/2 + 100*time.Millisecond)
+
+		again, err := q.Receive(ctx, 1, visibility)
+		require.NoError(t, err)
+		assert.Empty(t, again)
+
+		require.NoError(t, q.Ack(ctx, received[0]))
+	})
+
+	t.Run("nack redelivers after the delay", func(t *testing.T) {
+		_, err := q.Enqueue(ctx, []byte("nack"), 0)
+		require.NoError(t, err)
+
+		received, err := q.Receive(ctx, 1, visibility)
+		require.NoError(t, err)
+		require.Len(t, received, 1)
+		require.NoError(t, q.Nack(ctx, received[0], 0))
+		assert.ErrorIs(t, q.Extend(ctx, received[0], visibility), ErrReceiptExpired)
+
+		again, err := q.Receive(ctx, 1, visibility)
+		require.NoError(t, err)
+		require.Len(t, again, 1)
+		assert.Equal(t, received[0].ID, again[0].ID)
+		require.NoError(t, q.Ack(ctx, again[0]))
+	})
+
+	t.Run("heartbeat", func(t *testing.T) {
+		_, err := q.Enqueue(ctx, []byte("heartbeat"), 0)
+		require.NoError(t, err)
+
+		received, err := q.Receive(ctx, 1, visibility)
+		require.NoError(t, err)
+		require.Len(t, received, 1)
+
+		hbCtx, stop := Heartbeat(ctx, q, received[0], visibility)
+		time.Sleep(2 * visibility)
+		assert.NoError(t, hbCtx.Err())
+
+		again, err := q.Receive(ctx, 1, visibility)
+		require.NoError(t, err)
+		assert.Empty(t, again, "the heartbeat keeps the message hidden")
+
+		stop()
+		require.NoError(t, q.Ack(ctx, received[0]))
+	})
+}
@@ -0,0 +1,115 @@
+package render
+
+import (
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Link is a link of an email.
+type Link struct {
+	URL string
+	// TrackingURL is the URL the link was rewritten to, empty if the link is
+	// not tracked.
+	TrackingURL string
+}
+
+// Links returns the URLs the HTML content of an email links to, in order of
+// their first appearance. Links within the email are left out.
+func Links(content string) []string {
+	var links []string
+	z := html.NewTokenizer(strings.NewReader(content))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return links
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			if tok.DataAtom != atom.A {
+				continue
+			}
+			href := strings.TrimSpace(attr(tok, "href"))
+			if href != "" && !strings.HasPrefix(href, "#") && !slices.Contains(links, href) {
+				links = append(links, href)
+			}
+		}
+	}
+}
+
+// trackable reports whether a link can be rewritten to a tracking URL. Only
+// web links are, and unsubscribe links are left alone so that they work
+// whatever happens to the tracking.
+func trackable(href string) bool {
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+	scheme := strings.ToLower(u.Scheme)
+	return (scheme == "http" || scheme == "https") && !strings.Contains(strings.ToLower(href), "unsubscribe")
+}
+
+// trackLinks rewrites the links of the HTML content that track returns a
+// tracking URL for. Everything but the rewritten tags is kept as it is.
+func trackLinks(content string, track func(url string) (string, bool)) string {
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(content))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			return b.String()
+		}
+		raw := string(z.Raw())
+		if tt != html.StartTagToken && tt != html.SelfClosingTagToken {
+			b.WriteString(raw)
+			continue
+		}
+
+		tok := z.Token()
+		i := slices.IndexFunc(tok.Attr, func(a html.Attribute) bool { return a.Key == "href" })
+		if tok.DataAtom != atom.A || i < 0 {
+			b.WriteString(raw)
+			continue
+		}
+		tracked, ok := track(strings.TrimSpace(tok.Attr[i].Val))
+		if !ok {
+			b.WriteString(raw)
+			continue
+		}
+		tok.Attr[i].Val = tracked
+		b.WriteString(tok.String())
+	}
+}
+
+// expression matches the expressions of templates.
+var expression = regexp.MustCompile(`{{.*?}}`)
+
+// HasLink reports whether the template of a step content links to url. The
+// expressions in the links of content are filled in for every contact, so
+// they match any text, but only in links whose host comes before the first
+// expression. Anything else could lead anywhere.
+func HasLink(content, url string) bool {
+	for _, link := range Links(content) {
+		parts := expression.Split(link, -1)
+		if len(parts) > 1 && !literalHost(parts[0]) {
+			continue
+		}
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		if regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(url) {
+			return true
+		}
+	}
+	return false
+}
+
+// literalHost reports whether the start of a link ends after its host, so
+// that what follows cannot change where the link leads.
+func literalHost(start string) bool {
+	_, rest, ok := strings.Cut(start, "://")
+	return ok && strings.ContainsAny(rest, "/?#")
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinks(t *testing.T) {
+	content := `<a href="https://example.com/a">A</a> <a href=" https://example.com/b ">B</a>
+<a href="#top">Top</a> <a>No href</a> <a href="https://example.com/a">A again</a> <a href="mailto:jane@example.com">Jane</a>`
+
+	assert.Equal(t, []string{"https://example.com/a", "https://example.com/b", "mailto:jane@example.com"}, Links(content))
+	assert.Empty(t, Links("<p>No links</p>"))
+}
+
+func TestTrackLinks(t *testing.T) {
+	track := func(url string) (string, bool) {
+		if !trackable(url) {
+			return "", false
+		}
+		return "https://t.example.com/c?u=" + url, true
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"web links", `<p>Read <a class="btn" href="https://example.com/docs">docs</a></p>`, `<p>Read <a class="btn" href="https://t.example.com/c?u=https://example.com/docs">docs</a></p>`},
+		{"attributes are escaped", `<a href=" http://example.com/?a=1&amp;b=2 ">x</a>`, `<a href="https://t.example.com/c?u=http://example.com/?a=1&amp;b=2">x</a>`},
+		{"mailto links", `<a href="mailto:jane@example.com">Jane</a>`, `<a href="mailto:jane@example.com">Jane</a>`},
+		{"unsubscribe links", `<a href="https://example.com/Unsubscribe?id=1">Unsubscribe</a>`, `<a href="https://example.com/Unsubscribe?id=1">Unsubscribe</a>`},
+		{"anchors", `<a href="#top">Top</a><a name="x">X</a>`, `<a href="#top">Top</a><a name="x">X</a>`},
+		{"other markup is kept", "<!DOCTYPE html><P CLASS=x>Hi &nbsp;<br/></P><!-- note -->", "<!DOCTYPE html><P CLASS=x>Hi &nbsp;<br/></P><!-- note -->"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, trackLinks(tt.content, track))
+		})
+	}
+}
+
+func TestHasLink(t *testing.T) {
+	content := `<a href="https://example.com/pricing">Pricing</a>
+<a href="https://example.com/u/{{contact.email}}?ref={{ sequence.name | lower }}">Account</a>
+<a href="https://example.com?ref={{sequence.name}}">Home</a>
+<a href="{{custom.website}}">Website</a> <a href="https://{{custom.domain}}/about">About</a>
+<a href="https://example.org:{{custom.port}}/">Port</a>`
+
+	assert.True(t, HasLink(content, "https://example.com/pricing"))
+	assert.True(t, HasLink(content, "https://example.com/u/jane@example.com?ref=onboarding"))
+	assert.True(t, HasLink(content, "https://example.com?ref=onboarding"))
+	assert.False(t, HasLink(content, "https://example.com/pricing/extra"))
+	assert.False(t, HasLink(content, "https://evil.example.com/u/x?ref=y"))
+	assert.False(t, HasLink(content, "https://example.com.evil.com/pricing"))
+
+	t.Run("expressions in the host", func(t *testing.T) {
+		assert.False(t, HasLink(content, "https://evil.example.com"))
+		assert.False(t, HasLink(content, "https://evil.example.com/about"))
+		assert.False(t, HasLink(content, "https://example.org:443/"))
+	})
+}
@@ -0,0 +1,197 @@
+// Package render turns the steps of a sequence into the emails sent to
+// contacts. Subjects and contents are templates with these variables:
+//
+//	contact.email, contact.firstName, contact.lastName, contact.timeZone
+//	custom.<name>  custom fields of the contact
+//	sender.name, sender.email  the mailbox the email is sent from
+//	sequence.name
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/pirellik/sequence-api/internal/template"
+)
+
+type Contact struct {
+	Email     string
+	FirstName string
+	LastName  string
+	TimeZone  string
+	// CustomFields is the JSON object stored with the contact.
+	CustomFields []byte
+}
+
+type Sender struct {
+	Name  string
+	Email string
+}
+
+type Sequence struct {
+	Name string
+}
+
+// Variables are the values a step is rendered with. A nil contact or sender
+// leaves their variables without a value.
+type Variables struct {
+	Contact  *Contact
+	Sender   *Sender
+	Sequence Sequence
+}
+
+// Step holds the templates of a step.
+type Step struct {
+	Subject string
+	Content string
+}
+
+// Email is a step rendered for one contact.
+type Email struct {
+	Subject string
+	HTML    string
+	// Text is the plain-text alternative of HTML.
+	Text string
+	// Links are the links of HTML, in order of their first appearance.
+	Links []Link
+	// Missing lists the variables that rendered empty for lack of a value,
+	// which only happens when rendering leniently.
+	Missing []string
+}
+
+// variables lists the variables other than custom fields.
+var variables = map[string]bool{
+	"contact.email":     true,
+	"contact.firstName": true,
+	"contact.lastName":  true,
+	"contact.timeZone":  true,
+	"sender.name":       true,
+	"sender.email":      true,
+	"sequence.name":     true,
+}
+
+// Known reports whether steps can use the variable at path. Contacts have
+// different custom fields, so any custom field is known.
+func Known(path string) bool {
+	if name, ok := strings.CutPrefix(path, "custom."); ok {
+		return !strings.Contains(name, ".")
+	}
+	return variables[path]
+}
+
+// Parse parses the template of a step subject or content and checks that it
+// only uses known variables.
+func Parse(text string) (*template.Template, error) {
+	tmpl, err := template.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if err := tmpl.Check(Known); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
+
+// Data returns the template data of vars.
+func (vars *Variables) Data() template.Data {
+	data := template.Data{
+		"sequence": map[string]any{
+			"name": vars.Sequence.Name,
+		},
+	}
+	if c := vars.Contact; c != nil {
+		// Custom fields are only ever written as JSON objects.
+		custom := map[string]any{}
+		if len(c.CustomFields) > 0 {
+			_ = json.Unmarshal(c.CustomFields, &custom)
+		}
+		data["contact"] = map[string]any{
+			"email":     c.Email,
+			"firstName": c.FirstName,
+			"lastName":  c.LastName,
+			"timeZone":  c.TimeZone,
+		}
+		data["custom"] = custom
+	}
+	if vars.Sender != nil {
+		data["sender"] = map[string]any{
+			"name":  vars.Sender.Name,
+			"email": vars.Sender.Email,
+		}
+	}
+	return data
+}
+
+type Options struct {
+	// Strict fails rendering when a variable has neither a value nor a
+	// default, rather than an email going out with a gap in it.
+	Strict bool
+	// PixelURL, when set, is the URL of a tracking pixel added to the end of
+	// the content.
+	PixelURL string
+	// TrackLink, when set, returns the tracking URL a link of the content is
+	// rewritten to, in the HTML and the text. Links other than web links,
+	// unsubscribe links and links whose host is filled in by an expression
+	// are not rewritten.
+	TrackLink func(url string) string
+}
+
+// Render renders step with data, which is usually the Data of Variables.
+// Content is HTML, so values are escaped in it.
+func Render(step Step, data template.Data, opts Options) (*Email, error) {
+	subject, err := Parse(step.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("rendering subject: %w", err)
+	}
+	content, err := Parse(step.Content)
+	if err != nil {
+		return nil, fmt.Errorf("rendering content: %w", err)
+	}
+
+	email := &Email{}
+	email.Subject, err = subject.Execute(data, template.Options{Strict: opts.Strict})
+	if err != nil {
+		return nil, fmt.Errorf("rendering subject: %w", err)
+	}
+	email.HTML, err = content.Execute(data, template.Options{Strict: opts.Strict, Escape: html.EscapeString})
+	if err != nil {
+		return nil, fmt.Errorf("rendering content: %w", err)
+	}
+	track := func(link string) (string, bool) {
+		if opts.TrackLink == nil || !trackable(link) || !HasLink(step.Content, link) {
+			return "", false
+		}
+		return opts.TrackLink(link), true
+	}
+	email.Text = text(email.HTML, track)
+	for _, link := range Links(email.HTML) {
+		l := Link{URL: link}
+		l.TrackingURL, _ = track(link)
+		email.Links = append(email.Links, l)
+	}
+	if opts.TrackLink != nil {
+		email.HTML = trackLinks(email.HTML, track)
+	}
+	if opts.PixelURL != "" {
+		email.HTML = addPixel(email.HTML, opts.PixelURL)
+	}
+	for _, path := range slices.Concat(subject.Missing(data), content.Missing(data)) {
+		if !slices.Contains(email.Missing, path) {
+			email.Missing = append(email.Missing, path)
+		}
+	}
+	return email, nil
+}
+
+// addPixel adds an image of url to content, inside the body element when
+// content has one.
+func addPixel(content, url string) string {
+	pixel := `<img src="` + html.EscapeString(url) + `" width="1" height="1" alt="" style="border:0;width:1px;height:1px">`
+	if i := strings.LastIndex(strings.ToLower(content), "</body>"); i >= 0 {
+		return content[:i] + pixel + content[i:]
+	}
+	return content + pixel
+}
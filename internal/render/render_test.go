@@ -0,0 +1,111 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/pirellik/sequence-api/internal/template"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var vars = &Variables{
+	Contact: &Contact{
+		Email:        "jane@example.com",
+		FirstName:    "Jane",
+		CustomFields: []byte(`{"company": "Acme & Co", "seats": 12}`),
+	},
+	Sender:   &Sender{Name: "Sales", Email: "sales@example.com"},
+	Sequence: Sequence{Name: "Onboarding"},
+}
+
+func TestRender(t *testing.T) {
+	email, err := Render(Step{
+		Subject: "{{custom.company}} & {{sequence.name}}",
+		Content: `<p>Hi {{contact.firstName}} {{contact.lastName | default "there"}},</p><p>{{custom.seats}} seats at {{custom.company}}.</p><p>{{sender.name}} ({{sender.email}})</p>`,
+	}, vars.Data(), Options{Strict: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Acme & Co & Onboarding", email.Subject, "subjects are plain text")
+	assert.Equal(t, "<p>Hi Jane there,</p><p>12 seats at Acme &amp; Co.</p><p>Sales (sales@example.com)</p>", email.HTML)
+	assert.Equal(t, "Hi Jane there,\n\n12 seats at Acme & Co.\n\nSales (sales@example.com)", email.Text)
+	assert.Empty(t, email.Missing)
+}
+
+func TestRenderLenient(t *testing.T) {
+	data := (&Variables{Sequence: Sequence{Name: "Onboarding"}}).Data()
+	email, err := Render(Step{
+		Subject: "{{contact.firstName}}, welcome to {{sequence.name}}",
+		Content: `<p>Hi {{contact.firstName}} from {{custom.company | default "your team"}}</p><a href="https://example.com/{{custom.plan}}">Plans</a>`,
+	}, data, Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, ", welcome to Onboarding", email.Subject)
+	assert.Equal(t, []string{"contact.firstName", "custom.plan"}, email.Missing)
+	assert.Equal(t, []Link{{URL: "https://example.com/"}}, email.Links)
+}
+
+func TestRenderTrackLinks(t *testing.T) {
+	step := Step{
+		Subject: "Hi",
+		Content: `<p><a href="https://example.com/{{contact.firstName}}">https://example.com/{{contact.firstName}}</a> or <a href="https://example.com/docs">the docs</a></p>` +
+			`<p><a href="https://example.com/unsubscribe">Unsubscribe</a></p><p><a href="https://{{contact.firstName}}.example.com/">Jane</a></p>`,
+	}
+	track := func(url string) string { return "https://t.example.com/" + url[len("https://example.com/"):] }
+
+	email, err := Render(step, vars.Data(), Options{Strict: true, TrackLink: track})
+	require.NoError(t, err)
+	assert.Equal(t, `<p><a href="https://t.example.com/Jane">https://example.com/Jane</a> or <a href="https://t.example.com/docs">the docs</a></p>`+
+		`<p><a href="https://example.com/unsubscribe">Unsubscribe</a></p><p><a href="https://Jane.example.com/">Jane</a></p>`, email.HTML)
+	assert.Equal(t, "https://t.example.com/Jane or the docs (https://t.example.com/docs)\n\nUnsubscribe (https://example.com/unsubscribe)\n\nJane (https://Jane.example.com/)", email.Text)
+	assert.Equal(t, []Link{
+		{URL: "https://example.com/Jane", TrackingURL: "https://t.example.com/Jane"},
+		{URL: "https://example.com/docs", TrackingURL: "https://t.example.com/docs"},
+		{URL: "https://example.com/unsubscribe"},
+		{URL: "https://Jane.example.com/"},
+	}, email.Links, "links whose host is an expression are not tracked")
+}
+
+func TestRenderPixel(t *testing.T) {
+	step := Step{Subject: "Hi", Content: "<p>Hi {{contact.firstName}}</p>"}
+	pixel := `<img src="https://api.example.com/t/o/abc?a=1&amp;b=2" width="1" height="1" alt="" style="border:0;width:1px;height:1px">`
+
+	email, err := Render(step, vars.Data(), Options{PixelURL: "https://api.example.com/t/o/abc?a=1&b=2"})
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Hi Jane</p>"+pixel, email.HTML)
+	assert.Equal(t, "Hi Jane", email.Text, "the pixel is not part of the text")
+
+	step.Content = "<html><body><p>Hi</p></BODY></html>"
+	email, err = Render(step, vars.Data(), Options{PixelURL: "https://api.example.com/t/o/abc?a=1&b=2"})
+	require.NoError(t, err)
+	assert.Equal(t, "<html><body><p>Hi</p>"+pixel+"</BODY></html>", email.HTML)
+}
+
+func TestRenderFailures(t *testing.T) {
+	t.Run("missing variables", func(t *testing.T) {
+		_, err := Render(Step{Subject: "Hi", Content: "{{custom.title}} {{contact.lastName}}"}, vars.Data(), Options{Strict: true})
+		var missingErr *template.MissingError
+		require.ErrorAs(t, err, &missingErr)
+		assert.Equal(t, []string{"custom.title"}, missingErr.Variables, "empty fields are not missing")
+	})
+
+	t.Run("unknown variables", func(t *testing.T) {
+		_, err := Render(Step{Subject: "Hi {{contact.name}}", Content: "Hi"}, vars.Data(), Options{Strict: true})
+		assert.EqualError(t, err, "rendering subject: line 1, column 4: unknown variable contact.name")
+	})
+
+	t.Run("contacts without custom fields", func(t *testing.T) {
+		email, err := Render(Step{Subject: "Hi", Content: `{{custom.company | default "your company"}}`}, (&Variables{}).Data(), Options{Strict: true})
+		require.NoError(t, err)
+		assert.Equal(t, "your company", email.HTML)
+	})
+}
+
+func TestKnown(t *testing.T) {
+	assert.True(t, Known("contact.firstName"))
+	assert.True(t, Known("custom.anything"))
+	assert.True(t, Known("sender.email"))
+	assert.False(t, Known("contact.customFields"))
+	assert.False(t, Known("custom"))
+	assert.False(t, Known("custom.address.city"))
+	assert.False(t, Known("mailbox.name"))
+}
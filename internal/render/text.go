@@ -0,0 +1,194 @@
+package render
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Text converts the HTML content of an email into its plain-text
+// alternative. Block elements become line breaks, list items get a dash and
+// links are followed by their URL unless the link text already shows it.
+func Text(content string) string {
+	return text(content, nil)
+}
+
+// text is Text showing the tracking URLs of the links track rewrites.
+func text(content string, track func(url string) (string, bool)) string {
+	var (
+		w     textWriter
+		skip  int
+		links []textLink
+	)
+	z := html.NewTokenizer(strings.NewReader(content))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			return w.String()
+		case html.TextToken:
+			if skip == 0 {
+				w.text(string(z.Text()))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			switch tok.DataAtom {
+			case atom.Script, atom.Style, atom.Head, atom.Title:
+				if tt == html.StartTagToken {
+					skip++
+				}
+			case atom.Br:
+				w.newlines++
+			case atom.Li:
+				w.breakLine(1)
+				w.write("-")
+				w.space = true
+			case atom.Td, atom.Th:
+				w.space = true
+			case atom.Img:
+				if alt := attr(tok, "alt"); alt != "" {
+					w.text(alt)
+				}
+			case atom.Pre:
+				w.breakLine(2)
+				w.pre++
+			case atom.A:
+				links = append(links, textLink{href: strings.TrimSpace(attr(tok, "href")), start: len(w.b)})
+			default:
+				w.breakLine(blockBreaks[tok.DataAtom])
+			}
+		case html.EndTagToken:
+			tok := z.Token()
+			switch tok.DataAtom {
+			case atom.Script, atom.Style, atom.Head, atom.Title:
+				skip = max(skip-1, 0)
+			case atom.Pre:
+				w.breakLine(2)
+				w.pre = max(w.pre-1, 0)
+			case atom.A:
+				if len(links) == 0 {
+					break
+				}
+				link := links[len(links)-1]
+				links = links[:len(links)-1]
+				shown := linkTarget(link.href)
+				if shown == "" {
+					break
+				}
+				tracked := shown
+				if track != nil {
+					if url, ok := track(link.href); ok {
+						tracked = url
+					}
+				}
+				if linkText := string(w.b[link.start:]); strings.TrimSpace(linkText) != shown {
+					w.space = true
+					w.write("(" + tracked + ")")
+				} else {
+					// The link text is the URL, which is what readers of
+					// the text follow.
+					w.b = append(w.b[:len(w.b)-len(shown)], tracked...)
+				}
+			default:
+				w.breakLine(blockBreaks[tok.DataAtom])
+			}
+		}
+	}
+}
+
+// blockBreaks maps block elements to the line breaks around them.
+var blockBreaks = map[atom.Atom]int{
+	atom.P:          2,
+	atom.H1:         2,
+	atom.H2:         2,
+	atom.H3:         2,
+	atom.H4:         2,
+	atom.H5:         2,
+	atom.H6:         2,
+	atom.Blockquote: 2,
+	atom.Table:      2,
+	atom.Ul:         2,
+	atom.Ol:         2,
+	atom.Hr:         2,
+	atom.Div:        1,
+	atom.Tr:         1,
+	atom.Section:    1,
+	atom.Article:    1,
+	atom.Header:     1,
+	atom.Footer:     1,
+	atom.Dt:         1,
+	atom.Dd:         1,
+}
+
+type textLink struct {
+	href  string
+	start int
+}
+
+// linkTarget returns what the text of a link shows of its href: the address
+// of mailto links, nothing for links within the email.
+func linkTarget(href string) string {
+	if href == "" || strings.HasPrefix(href, "#") {
+		return ""
+	}
+	if address, ok := strings.CutPrefix(href, "mailto:"); ok {
+		return address
+	}
+	return href
+}
+
+// textWriter collapses whitespace the way browsers do, and holds back line
+// breaks and spaces until more text follows.
+type textWriter struct {
+	b        []byte
+	newlines int
+	space    bool
+	pre      int
+}
+
+func (w *textWriter) text(s string) {
+	if w.pre > 0 {
+		w.write(s)
+		return
+	}
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		w.space = w.space || s != ""
+		return
+	}
+	if strings.TrimLeft(s, " \t\r\n\f") != s {
+		w.space = true
+	}
+	w.write(strings.Join(fields, " "))
+	w.space = strings.TrimRight(s, " \t\r\n\f") != s
+}
+
+func (w *textWriter) write(s string) {
+	if len(w.b) > 0 {
+		if w.newlines > 0 {
+			w.b = append(w.b, strings.Repeat("\n", w.newlines)...)
+		} else if w.space {
+			w.b = append(w.b, ' ')
+		}
+	}
+	w.newlines, w.space = 0, false
+	w.b = append(w.b, s...)
+}
+
+func (w *textWriter) breakLine(n int) {
+	w.newlines = max(w.newlines, n)
+}
+
+func (w *textWriter) String() string {
+	return string(w.b)
+}
+
+func attr(tok html.Token, name string) string {
+	for _, a := range tok.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"plain text", "Hello there", "Hello there"},
+		{"whitespace collapses", "Hello\n   there,\n\tJane ", "Hello there, Jane"},
+		{"paragraphs", "<p>Hi Jane,</p>\n<p>How are you?</p>", "Hi Jane,\n\nHow are you?"},
+		{"line breaks", "Best,<br>Sales<br/>Acme", "Best,\nSales\nAcme"},
+		{"entities", "<p>Acme &amp; Co &lt;3</p>", "Acme & Co <3"},
+		{"inline elements", "Hi <b>Jane</b>, <i>welcome</i>!", "Hi Jane, welcome!"},
+		{"lists", "<p>Steps:</p><ul><li>Sign up</li><li>Invite <b>your</b> team</li></ul><p>Done</p>", "Steps:\n\n- Sign up\n- Invite your team\n\nDone"},
+		{"links", `Read <a href="https://example.com/docs">the docs</a>.`, "Read the docs (https://example.com/docs)."},
+		{"links showing their URL", `<a href="https://example.com">https://example.com</a>`, "https://example.com"},
+		{"mailto links", `<a href="mailto:jane@example.com">jane@example.com</a> or <a href="mailto:sales@example.com">Sales</a>`, "jane@example.com or Sales (sales@example.com)"},
+		{"anchors", `<a href="#top">Top</a>`, "Top"},
+		{"hidden elements", "<head><title>Hi</title><style>p { color: red }</style></head><p>Body</p><script>alert(1)</script>", "Body"},
+		{"images", `<img src="logo.png" alt="Acme"> <img src="pixel.gif">news`, "Acme news"},
+		{"tables", "<table><tr><td>Plan</td><td>Pro</td></tr><tr><td>Seats</td><td>12</td></tr></table>", "Plan Pro\nSeats 12"},
+		{"preformatted text", "<pre>a  b\n  c</pre>", "a  b\n  c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Text(tt.content))
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Package schedule computes when emails may be sent under a sending schedule:
+// a time zone, the weekdays and hours emails go out on and the holidays they
+// do not. Times are worked out on the wall clock of the time zone, so that a
+// window of 9 to 17 o'clock stays put across daylight saving time changes.
+package schedule
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	// The API and the scheduler run from scratch images without a zoneinfo
+	// database.
+	_ "time/tzdata"
+)
+
+// Schedule restricts when emails are sent. Location is required, StartHour
+// has to be before EndHour.
+type Schedule struct {
+	Location *time.Location
+	// Weekdays are the days emails are sent on. All days are when it is
+	// empty.
+	Weekdays []time.Weekday
+	// StartHour and EndHour bound the hours emails are sent in, from StartHour
+	// o'clock up to but excluding EndHour o'clock. An EndHour of 24 is the end
+	// of the day.
+	StartHour int
+	EndHour   int
+	// Holidays are dates in Location no emails are sent on.
+	Holidays []Date
+}
+
+// Anytime sends emails whenever they are due.
+var Anytime = Schedule{Location: time.UTC, EndHour: 24}
+
+// Date is a calendar day.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in the location of t.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+func (d Date) Weekday() time.Weekday {
+	return d.wall(0).Weekday()
+}
+
+// next returns the day after d.
+func (d Date) next() Date {
+	return DateOf(d.wall(24))
+}
+
+// wall returns the wall clock time of hour o'clock on d, as a time in UTC.
+func (d Date) wall(hour int) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, hour, 0, 0, 0, time.UTC)
+}
+
+// Sends reports whether emails are sent on day.
+func (s Schedule) Sends(day Date) bool {
+	if len(s.Weekdays) > 0 && !slices.Contains(s.Weekdays, day.Weekday()) {
+		return false
+	}
+	return !slices.Contains(s.Holidays, day)
+}
+
+// Next returns the earliest time at or after t at which emails are sent.
+func (s Schedule) Next(t time.Time) time.Time {
+	for day := DateOf(t.In(s.Location)); ; day = day.next() {
+		if !s.Sends(day) {
+			continue
+		}
+		// The window is empty when the clocks skip over all of it.
+		start, end := s.at(day.wall(s.StartHour)), s.at(day.wall(s.EndHour))
+		if !t.Before(end) || !start.Before(end) {
+			continue
+		}
+		if t.Before(start) {
+			return start
+		}
+		return t
+	}
+}
+
+// After returns when an email that is due the given number of days after t is
+// sent. Only days emails are sent on count, so on a schedule of weekdays an
+// email due two days after a Friday is sent on Tuesday. It keeps the time of
+// day of t where the sending hours allow it, regardless of daylight saving
+// time changes in between.
+func (s Schedule) After(t time.Time, days int) time.Time {
+	if days <= 0 {
+		return s.Next(t)
+	}
+
+	local := t.In(s.Location)
+	day := DateOf(local)
+	for range days {
+		day = day.next()
+		for !s.Sends(day) {
+			day = day.next()
+		}
+	}
+
+	hour, minute, second := local.Clock()
+	wall := day.wall(hour).Add(time.Duration(minute)*time.Minute + time.Duration(second)*time.Second + time.Duration(local.Nanosecond()))
+	return s.Next(s.at(wall))
+}
+
+// at returns the earliest time whose wall clock in Location is at or after
+// wall, which is given in UTC. When the clocks skip over wall, that is the
+// moment they do.
+func (s Schedule) at(wall time.Time) time.Time {
+	t := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), s.Location)
+	// time.Date resolves a wall clock the clocks skip over to a time before or
+	// after the change, depending on the time zone.
+	switch clock := wallClock(t); {
+	case clock.Before(wall):
+		_, t = t.ZoneBounds()
+	case clock.After(wall):
+		t, _ = t.ZoneBounds()
+	}
+	return t
+}
+
+// wallClock returns the wall clock time of t in its location, as a time in
+// UTC.
+func wallClock(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	return time.Date(year, month, day, hour, minute, second, t.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,223 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func location(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func assertTime(t *testing.T, want, got time.Time) {
+	t.Helper()
+	assert.True(t, want.Equal(got), "want %s, got %s", want, got.In(want.Location()))
+}
+
+var workdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+func TestNext(t *testing.T) {
+	berlin := location(t, "Europe/Berlin")
+	s := Schedule{
+		Location:  berlin,
+		Weekdays:  workdays,
+		StartHour: 9,
+		EndHour:   17,
+		Holidays:  []Date{{2025, time.May, 1}},
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want time.Time
+	}{
+		{
+			name: "within the window",
+			t:    time.Date(2025, 3, 4, 10, 15, 0, 0, berlin),
+			want: time.Date(2025, 3, 4, 10, 15, 0, 0, berlin),
+		},
+		{
+			name: "before the window",
+			t:    time.Date(2025, 3, 4, 6, 0, 0, 0, berlin),
+			want: time.Date(2025, 3, 4, 9, 0, 0, 0, berlin),
+		},
+		{
+			name: "the end hour is excluded",
+			t:    time.Date(2025, 3, 4, 17, 0, 0, 0, berlin),
+			want: time.Date(2025, 3, 5, 9, 0, 0, 0, berlin),
+		},
+		{
+			name: "friday evening waits for monday",
+			t:    time.Date(2025, 3, 7, 18, 0, 0, 0, berlin),
+			want: time.Date(2025, 3, 10, 9, 0, 0, 0, berlin),
+		},
+		{
+			name: "skips holidays",
+			t:    time.Date(2025, 4, 30, 20, 0, 0, 0, berlin),
+			want: time.Date(2025, 5, 2, 9, 0, 0, 0, berlin),
+		},
+		{
+			name: "uses the date in the time zone",
+			// Friday 23:30 in UTC is Saturday in Berlin.
+			t:    time.Date(2025, 3, 7, 23, 30, 0, 0, time.UTC),
+			want: time.Date(2025, 3, 10, 9, 0, 0, 0, berlin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertTime(t, tt.want, s.Next(tt.t))
+		})
+	}
+}
+
+func TestNextAnytime(t *testing.T) {
+	now := time.Date(2025, 12, 25, 3, 4, 5, 6, time.UTC)
+	assertTime(t, now, Anytime.Next(now))
+}
+
+func TestNextEndOfDay(t *testing.T) {
+	s := Schedule{Location: time.UTC, StartHour: 20, EndHour: 24}
+	late := time.Date(2025, 3, 4, 23, 59, 0, 0, time.UTC)
+	assertTime(t, late, s.Next(late))
+	assertTime(t, time.Date(2025, 3, 5, 20, 0, 0, 0, time.UTC), s.Next(time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestNextDST(t *testing.T) {
+	newYork := location(t, "America/New_York")
+
+	t.Run("window opening in the spring forward gap opens when the clocks change", func(t *testing.T) {
+		// On 9 March 2025 the clocks go from 2:00 EST straight to 3:00 EDT.
+		s := Schedule{Location: newYork, StartHour: 2, EndHour: 4}
+		got := s.Next(time.Date(2025, 3, 9, 0, 30, 0, 0, newYork))
+		assertTime(t, time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC), got)
+		assert.Equal(t, 3, got.In(newYork).Hour())
+	})
+
+	t.Run("window keeps its wall clock hours after spring forward", func(t *testing.T) {
+		s := Schedule{Location: newYork, StartHour: 9, EndHour: 17}
+		assertTime(t, time.Date(2025, 3, 8, 14, 0, 0, 0, time.UTC), s.Next(time.Date(2025, 3, 8, 5, 0, 0, 0, time.UTC)))
+		assertTime(t, time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC), s.Next(time.Date(2025, 3, 10, 5, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("the repeated hour of fall back is within the window", func(t *testing.T) {
+		// On 2 November 2025 the clocks go from 2:00 EDT back to 1:00 EST.
+		s := Schedule{Location: newYork, StartHour: 1, EndHour: 2}
+		first := time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC)
+		second := time.Date(2025, 11, 2, 6, 30, 0, 0, time.UTC)
+		assertTime(t, first, s.Next(first))
+		assertTime(t, second, s.Next(second))
+		assertTime(t, time.Date(2025, 11, 3, 6, 0, 0, 0, time.UTC), s.Next(time.Date(2025, 11, 2, 7, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("a skipped day is not sent on", func(t *testing.T) {
+		// Samoa skipped 30 December 2011 when it moved across the date line.
+		apia := location(t, "Pacific/Apia")
+		s := Schedule{Location: apia, StartHour: 9, EndHour: 17}
+		got := s.Next(time.Date(2011, 12, 29, 18, 0, 0, 0, apia))
+		assertTime(t, time.Date(2011, 12, 31, 9, 0, 0, 0, apia), got)
+	})
+}
+
+func TestAfter(t *testing.T) {
+	berlin := location(t, "Europe/Berlin")
+	s := Schedule{
+		Location:  berlin,
+		Weekdays:  workdays,
+		StartHour: 9,
+		EndHour:   17,
+		Holidays:  []Date{{2025, time.December, 25}, {2025, time.December, 26}},
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		days int
+		want time.Time
+	}{
+		{
+			name: "no delay waits for the window",
+			t:    time.Date(2025, 3, 4, 18, 0, 0, 0, berlin),
+			want: time.Date(2025, 3, 5, 9, 0, 0, 0, berlin),
+		},
+		{
+			name: "keeps the time of day",
+			t:    time.Date(2025, 3, 4, 10, 30, 0, 0, berlin),
+			days: 2,
+			want: time.Date(2025, 3, 6, 10, 30, 0, 0, berlin),
+		},
+		{
+			name: "counts only days that are sent on",
+			t:    time.Date(2025, 3, 7, 10, 30, 0, 0, berlin),
+			days: 2,
+			want: time.Date(2025, 3, 11, 10, 30, 0, 0, berlin),
+		},
+		{
+			name: "holidays do not count",
+			t:    time.Date(2025, 12, 24, 11, 0, 0, 0, berlin),
+			days: 1,
+			want: time.Date(2025, 12, 29, 11, 0, 0, 0, berlin),
+		},
+		{
+			name: "a time outside the window moves into it",
+			t:    time.Date(2025, 3, 4, 20, 0, 0, 0, berlin),
+			days: 1,
+			want: time.Date(2025, 3, 6, 9, 0, 0, 0, berlin),
+		},
+		{
+			name: "a weekend start counts from the next day sent on",
+			t:    time.Date(2025, 3, 8, 10, 0, 0, 0, berlin),
+			days: 1,
+			want: time.Date(2025, 3, 10, 10, 0, 0, 0, berlin),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertTime(t, tt.want, s.After(tt.t, tt.days))
+		})
+	}
+}
+
+func TestAfterDST(t *testing.T) {
+	newYork := location(t, "America/New_York")
+	s := Schedule{Location: newYork, EndHour: 24}
+
+	t.Run("keeps the wall clock across spring forward", func(t *testing.T) {
+		got := s.After(time.Date(2025, 3, 8, 10, 0, 0, 0, newYork), 1)
+		assertTime(t, time.Date(2025, 3, 9, 10, 0, 0, 0, newYork), got)
+		assert.Equal(t, 23*time.Hour, got.Sub(time.Date(2025, 3, 8, 10, 0, 0, 0, newYork)))
+	})
+
+	t.Run("keeps the wall clock across fall back", func(t *testing.T) {
+		got := s.After(time.Date(2025, 11, 1, 10, 0, 0, 0, newYork), 1)
+		assert.Equal(t, 25*time.Hour, got.Sub(time.Date(2025, 11, 1, 10, 0, 0, 0, newYork)))
+	})
+
+	t.Run("a time of day the clocks skip becomes the change", func(t *testing.T) {
+		got := s.After(time.Date(2025, 3, 8, 2, 30, 0, 0, newYork), 1)
+		assertTime(t, time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC), got)
+	})
+
+	t.Run("a repeated time of day is its first occurrence", func(t *testing.T) {
+		got := s.After(time.Date(2025, 11, 1, 1, 30, 0, 0, newYork), 1)
+		assertTime(t, time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC), got)
+	})
+
+	t.Run("anytime matches calendar days in UTC", func(t *testing.T) {
+		sent := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+		assertTime(t, sent.AddDate(0, 0, 3), Anytime.After(sent, 3))
+	})
+}
+
+func TestDate(t *testing.T) {
+	d := Date{2024, time.February, 28}
+	assert.Equal(t, Date{2024, time.February, 29}, d.next())
+	assert.Equal(t, Date{2025, time.January, 1}, Date{2024, time.December, 31}.next())
+	assert.Equal(t, time.Wednesday, d.Weekday())
+	assert.Equal(t, "2024-02-28", d.String())
+}
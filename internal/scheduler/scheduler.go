@@ -0,0 +1,252 @@
+// Package scheduler turns the steps of active enrollments that have fallen due
+// into send jobs, moves the enrollments on to their next step and publishes
+// the jobs to the send queue.
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/enrollment"
+	"github.com/pirellik/sequence-api/internal/queue"
+)
+
+// SendQueue is the name of the queue send jobs are published to.
+const SendQueue = "send_jobs"
+
+// SendJobMessage is the body of the messages published to SendQueue.
+type SendJobMessage struct {
+	SendJobID uuid.UUID `json:"sendJobId"`
+}
+
+type Options struct {
+	// Interval is how long the scheduler waits after a batch that did not
+	// fill up before polling again.
+	Interval time.Duration
+	// BatchSize is how many enrollments or send jobs are handled in one
+	// transaction.
+	BatchSize int
+}
+
+// Scheduler is safe to run as several replicas against the same database:
+// due enrollments are locked with SKIP LOCKED, so each is handled by exactly
+// one of them.
+type Scheduler struct {
+	db    *pgxpool.Pool
+	queue queue.Queue
+	opts  Options
+	now   func() time.Time
+}
+
+func New(db *pgxpool.Pool, q queue.Queue, opts Options) *Scheduler {
+	return &Scheduler{db: db, queue: q, opts: opts, now: time.Now}
+}
+
+// Run schedules due steps and publishes their send jobs until ctx is done.
+// Full batches are followed by the next one right away so that a backlog is
+// worked off quickly.
+func (s *Scheduler) Run(ctx context.Context) {
+	for {
+		if !s.runOnce(ctx) {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.opts.Interval):
+			}
+		} else if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// runOnce reports whether there may be more work waiting.
+func (s *Scheduler) runOnce(ctx context.Context) bool {
+	scheduled, err := s.Tick(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "scheduling due steps", "err", err)
+	} else if scheduled > 0 {
+		slog.DebugContext(ctx, "scheduled due steps", "count", scheduled)
+	}
+
+	published, err := s.Publish(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "publishing send jobs", "err", err)
+	} else if published > 0 {
+		slog.DebugContext(ctx, "published send jobs", "count", published)
+	}
+
+	return scheduled == s.opts.BatchSize || published == s.opts.BatchSize
+}
+
+// Tick handles one batch of due enrollments and returns how many of them it
+// scheduled. Enrollments held back by their send schedule do not count.
+func (s *Scheduler) Tick(ctx context.Context) (int, error) {
+	now := s.now()
+
+	var scheduled int
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		due, err := q.LockDueEnrollments(ctx, &models.LockDueEnrollmentsParams{
+			Now:   pgtype.Timestamptz{Time: now, Valid: true},
+			Limit: int32(s.opts.BatchSize),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, e := range due {
+			moved, err := schedule(ctx, q, e, now)
+			if err != nil {
+				return fmt.Errorf("scheduling enrollment %s: %w", e.ID, err)
+			}
+			if moved {
+				scheduled++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return scheduled, nil
+}
+
+// Publish enqueues one batch of send jobs that have not been published yet and
+// returns how many it published. Jobs are marked in the transaction that
+// locked them, so a job is published again if the transaction fails after the
+// message was enqueued; consumers have to tolerate duplicates.
+func (s *Scheduler) Publish(ctx context.Context) (int, error) {
+	var published int
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		ids, err := q.LockUnpublishedSendJobs(ctx, int32(s.opts.BatchSize))
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		for _, id := range ids {
+			body, err := json.Marshal(SendJobMessage{SendJobID: id})
+			if err != nil {
+				return err
+			}
+			if _, err := s.queue.Enqueue(ctx, body, 0); err != nil {
+				return err
+			}
+		}
+
+		published = len(ids)
+		return q.MarkSendJobsEnqueued(ctx, ids)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
+
+// schedule creates the send job of the current step of e and points e at the
+// step after it, or completes e when it was the last step. It reports false
+// when e is held back by its send schedule instead.
+func schedule(ctx context.Context, q *models.Queries, e *models.Enrollment, now time.Time) (bool, error) {
+	if !e.CurrentStepID.Valid {
+		return true, q.CompleteEnrollment(ctx, e.ID)
+	}
+
+	sendSchedule, err := enrollment.SendSchedule(ctx, q, e.SequenceID, e.ContactID)
+	if err != nil {
+		return false, fmt.Errorf("getting send schedule: %w", err)
+	}
+
+	// Enrollments that fall due outside of the schedule, because it changed or
+	// the scheduler fell behind, wait until the schedule allows sending.
+	if next := sendSchedule.Next(now); next.After(now) {
+		return false, q.AdvanceEnrollment(ctx, &models.AdvanceEnrollmentParams{
+			ID:            e.ID,
+			CurrentStepID: e.CurrentStepID,
+			NextSendAt:    pgtype.Timestamptz{Time: next, Valid: true},
+		})
+	}
+
+	step, err := q.GetSequenceStepByID(ctx, &models.GetSequenceStepByIDParams{
+		ID:         e.CurrentStepID.Bytes,
+		SequenceID: e.SequenceID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	mailboxID, err := pickMailbox(ctx, q, e.SequenceID, now)
+	if err != nil {
+		return false, fmt.Errorf("picking mailbox: %w", err)
+	}
+
+	// A step that was scheduled already comes up again when it is moved behind
+	// the current one; the unique index keeps it from being sent twice.
+	_, err = q.CreateSendJob(ctx, &models.CreateSendJobParams{
+		EnrollmentID: e.ID,
+		StepID:       e.CurrentStepID,
+		MailboxID:    mailboxID,
+		EmailSubject: step.EmailSubject,
+		EmailContent: step.EmailContent,
+		ScheduledAt:  e.NextSendAt,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	next, err := q.GetNextSequenceStep(ctx, &models.GetNextSequenceStepParams{
+		SequenceID: e.SequenceID,
+		Ordering:   step.Ordering,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return true, q.CompleteEnrollment(ctx, e.ID)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// Delays count from when the previous step is sent, which is about now
+	// even if the scheduler fell behind or the enrollment was paused.
+	return true, q.AdvanceEnrollment(ctx, &models.AdvanceEnrollmentParams{
+		ID:            e.ID,
+		CurrentStepID: pgtype.UUID{Bytes: next.ID, Valid: true},
+		NextSendAt:    pgtype.Timestamptz{Time: enrollment.NextSendAt(now, next, sendSchedule), Valid: true},
+	})
+}
+
+// pickMailbox returns the mailbox the next send job of a sequence is sent
+// from, or an invalid UUID when the sequence has no mailboxes and the default
+// sender is used. Least-used rotation counts the send jobs of the current UTC
+// day.
+func pickMailbox(ctx context.Context, q *models.Queries, sequenceID uuid.UUID, now time.Time) (pgtype.UUID, error) {
+	id, err := q.PickSequenceMailbox(ctx, &models.PickSequenceMailboxParams{
+		SequenceID: sequenceID,
+		Since:      pgtype.Timestamptz{Time: now.UTC().Truncate(24 * time.Hour), Valid: true},
+	})
+	if err == nil {
+		return pgtype.UUID{Bytes: id, Valid: true}, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return pgtype.UUID{}, err
+	}
+
+	// Either there are no mailboxes or other replicas hold all of them. In the
+	// latter case any of them will do.
+	ids, err := q.GetSequenceMailboxIDs(ctx, sequenceID)
+	if err != nil || len(ids) == 0 {
+		return pgtype.UUID{}, err
+	}
+	return pgtype.UUID{Bytes: ids[0], Valid: true}, nil
+}
@@ -0,0 +1,303 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/enrollment"
+	"github.com/pirellik/sequence-api/internal/mailbox"
+	"github.com/pirellik/sequence-api/internal/queue"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sequenceID   = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	firstStepID  = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	secondStepID = uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	janeID       = uuid.MustParse("00000000-0000-0000-0000-000000000010")
+	johnID       = uuid.MustParse("00000000-0000-0000-0000-000000000011")
+	salesID      = uuid.MustParse("00000000-0000-0000-0000-000000000020")
+	supportID    = uuid.MustParse("00000000-0000-0000-0000-000000000021")
+)
+
+func countSendJobs(t *testing.T, pool *pgxpool.Pool, enrollmentID uuid.UUID) int {
+	t.Helper()
+	var count int
+	err := pool.QueryRow(context.Background(), "SELECT COUNT(*) FROM send_jobs WHERE enrollment_id = $1", enrollmentID).Scan(&count)
+	require.NoError(t, err)
+	return count
+}
+
+func TestScheduler(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+
+	enrolled, err := enrollment.NewService(pool).Enroll(ctx, sequenceID, janeID)
+	require.NoError(t, err)
+
+	clock := enrolled.NextSendAt.Time.Add(-time.Minute)
+	s := New(pool, queue.NewMemory(), Options{BatchSize: 10})
+	s.now = func() time.Time { return clock }
+
+	t.Run("nothing is due yet", func(t *testing.T) {
+		scheduled, err := s.Tick(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, scheduled)
+	})
+
+	t.Run("schedules the first step", func(t *testing.T) {
+		clock = enrolled.NextSendAt.Time
+
+		scheduled, err := s.Tick(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, scheduled)
+		assert.Equal(t, 1, countSendJobs(t, pool, enrolled.ID))
+
+		var subject string
+		err = pool.QueryRow(ctx, "SELECT email_subject FROM send_jobs WHERE step_id = $1", firstStepID).Scan(&subject)
+		require.NoError(t, err)
+		assert.Equal(t, "Initial Subject", subject)
+
+		e, err := models.New(pool).GetEnrollmentByID(ctx, enrolled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, secondStepID, uuid.UUID(e.CurrentStepID.Bytes))
+		assert.Equal(t, clock.AddDate(0, 0, 2).UnixMicro(), e.NextSendAt.Time.UnixMicro())
+	})
+
+	t.Run("completes after the last step", func(t *testing.T) {
+		clock = clock.AddDate(0, 0, 2)
+
+		scheduled, err := s.Tick(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, scheduled)
+		assert.Equal(t, 2, countSendJobs(t, pool, enrolled.ID))
+
+		e, err := models.New(pool).GetEnrollmentByID(ctx, enrolled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.EnrollmentStateCompleted, e.State)
+		assert.False(t, e.CurrentStepID.Valid)
+		assert.False(t, e.NextSendAt.Valid)
+	})
+
+	t.Run("skips paused enrollments", func(t *testing.T) {
+		svc := enrollment.NewService(pool)
+		john, err := svc.Enroll(ctx, sequenceID, johnID)
+		require.NoError(t, err)
+		_, err = svc.Pause(ctx, john.ID)
+		require.NoError(t, err)
+
+		clock = clock.AddDate(0, 0, 10)
+		scheduled, err := s.Tick(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, scheduled)
+		assert.Zero(t, countSendJobs(t, pool, john.ID))
+	})
+}
+
+func TestSchedulerReplicas(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+
+	svc := enrollment.NewService(pool)
+	result, err := svc.EnrollMany(ctx, sequenceID, []uuid.UUID{janeID, johnID})
+	require.NoError(t, err)
+	require.Len(t, result.Enrolled, 2)
+
+	clock := time.Now().AddDate(0, 0, 1)
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		total int
+	)
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s := New(pool, queue.NewMemory(), Options{BatchSize: 1})
+			s.now = func() time.Time { return clock }
+			scheduled, err := s.Tick(ctx)
+			assert.NoError(t, err)
+
+			mu.Lock()
+			total += scheduled
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, total)
+	for _, e := range result.Enrolled {
+		assert.Equal(t, 1, countSendJobs(t, pool, e.ID))
+	}
+}
+
+func TestPublish(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+
+	enrolled, err := enrollment.NewService(pool).Enroll(ctx, sequenceID, janeID)
+	require.NoError(t, err)
+
+	sendQueue := queue.NewMemory()
+	s := New(pool, sendQueue, Options{BatchSize: 10})
+	s.now = func() time.Time { return enrolled.NextSendAt.Time }
+
+	scheduled, err := s.Tick(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, scheduled)
+
+	published, err := s.Publish(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+
+	published, err = s.Publish(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, published, "jobs are published once")
+
+	received, err := sendQueue.Receive(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+
+	var msg SendJobMessage
+	require.NoError(t, json.Unmarshal(received[0].Body, &msg))
+
+	var enrollmentID uuid.UUID
+	err = pool.QueryRow(ctx, "SELECT enrollment_id FROM send_jobs WHERE id = $1", msg.SendJobID).Scan(&enrollmentID)
+	require.NoError(t, err)
+	assert.Equal(t, enrolled.ID, enrollmentID)
+}
+
+// jobMailboxes returns the mailboxes of the send jobs of the given step.
+func jobMailboxes(t *testing.T, pool *pgxpool.Pool, stepID uuid.UUID) []uuid.UUID {
+	t.Helper()
+	rows, err := pool.Query(context.Background(), "SELECT mailbox_id FROM send_jobs WHERE step_id = $1", stepID)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		require.NoError(t, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(t, rows.Err())
+	return ids
+}
+
+func TestSchedulerMailboxRotation(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+
+	mailboxes := mailbox.NewService(pool, nil)
+	_, err := mailboxes.SetSequenceMailboxes(ctx, sequenceID, mailbox.SequenceMailboxes{
+		Rotation:   models.MailboxRotationRoundRobin,
+		MailboxIDs: []uuid.UUID{salesID, supportID},
+	})
+	require.NoError(t, err)
+
+	_, err = enrollment.NewService(pool).EnrollMany(ctx, sequenceID, []uuid.UUID{janeID, johnID})
+	require.NoError(t, err)
+
+	// Send jobs are counted by their creation time in the database, so the
+	// scheduler runs on the real clock with the enrollments made due.
+	due := func() {
+		_, err := pool.Exec(ctx, "UPDATE enrollments SET next_send_at = NOW() WHERE state = 'active'")
+		require.NoError(t, err)
+	}
+	s := New(pool, queue.NewMemory(), Options{BatchSize: 10})
+
+	t.Run("round robin takes turns", func(t *testing.T) {
+		due()
+		scheduled, err := s.Tick(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, scheduled)
+
+		assert.ElementsMatch(t, []uuid.UUID{salesID, supportID}, jobMailboxes(t, pool, firstStepID))
+	})
+
+	t.Run("least used picks the idlest mailbox", func(t *testing.T) {
+		_, err := mailboxes.SetSequenceMailboxes(ctx, sequenceID, mailbox.SequenceMailboxes{
+			Rotation:   models.MailboxRotationLeastUsed,
+			MailboxIDs: []uuid.UUID{salesID, supportID},
+		})
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, "UPDATE send_jobs SET mailbox_id = $1", supportID)
+		require.NoError(t, err)
+
+		due()
+		scheduled, err := s.Tick(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 2, scheduled)
+
+		// Support has two jobs today, so sales gets both new ones.
+		assert.Equal(t, []uuid.UUID{salesID, salesID}, jobMailboxes(t, pool, secondStepID))
+	})
+}
+
+// nextSendAt returns when the enrollment of a contact is due next.
+func nextSendAt(t *testing.T, pool *pgxpool.Pool, contactID uuid.UUID) time.Time {
+	t.Helper()
+	var next time.Time
+	err := pool.QueryRow(context.Background(), "SELECT next_send_at FROM enrollments WHERE contact_id = $1", contactID).Scan(&next)
+	require.NoError(t, err)
+	return next
+}
+
+func TestSchedulerSendSchedule(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+
+	_, err := sequence.NewService(pool, nil).SetSendSchedule(ctx, sequenceID, sequence.SendSchedule{
+		TimeZone:           "Europe/Berlin",
+		Weekdays:           []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		StartHour:          9,
+		EndHour:            17,
+		UseContactTimeZone: true,
+	})
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, "UPDATE contacts SET time_zone = 'America/New_York' WHERE id = $1", johnID)
+	require.NoError(t, err)
+
+	_, err = enrollment.NewService(pool).EnrollMany(ctx, sequenceID, []uuid.UUID{janeID, johnID})
+	require.NoError(t, err)
+
+	// Saturday 8 March 2025.
+	clock := time.Date(2025, 3, 8, 12, 0, 0, 0, time.UTC)
+	_, err = pool.Exec(ctx, "UPDATE enrollments SET next_send_at = $1", clock)
+	require.NoError(t, err)
+
+	s := New(pool, queue.NewMemory(), Options{BatchSize: 10})
+	s.now = func() time.Time { return clock }
+
+	t.Run("holds back steps outside of the schedule", func(t *testing.T) {
+		scheduled, err := s.Tick(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, scheduled)
+		assert.Empty(t, jobMailboxes(t, pool, firstStepID))
+
+		// Monday 9:00 in Berlin, and in New York for John, whose clocks have
+		// moved to daylight saving time on Sunday.
+		assert.Equal(t, time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), nextSendAt(t, pool, janeID).UTC())
+		assert.Equal(t, time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC), nextSendAt(t, pool, johnID).UTC())
+	})
+
+	t.Run("sends within the schedule", func(t *testing.T) {
+		clock = time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+
+		scheduled, err := s.Tick(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, scheduled, "it is still night in New York")
+
+		// The second step is two days after the first.
+		assert.Equal(t, time.Date(2025, 3, 12, 8, 0, 0, 0, time.UTC), nextSendAt(t, pool, janeID).UTC())
+	})
+}
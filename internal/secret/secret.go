@@ -0,0 +1,63 @@
+// Package secret encrypts credentials before they are stored in the database.
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var ErrMalformed = errors.New("malformed ciphertext")
+
+// Box encrypts and authenticates values with AES-256-GCM. Every value gets a
+// random nonce, which is stored in front of the ciphertext.
+type Box struct {
+	aead cipher.AEAD
+}
+
+// NewBox returns a box using key, the base64 encoding of 32 random bytes.
+func NewBox(key string) (*Box, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decoding key: %w", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes long, got %d", len(raw))
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Box{aead: aead}, nil
+}
+
+// Seal encrypts plaintext and binds it to associatedData, usually the ID of
+// the row it is stored in, so that it cannot be copied onto another row. Open
+// needs the same associatedData.
+func (b *Box) Seal(plaintext, associatedData []byte) ([]byte, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return b.aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+func (b *Box) Open(ciphertext, associatedData []byte) ([]byte, error) {
+	size := b.aead.NonceSize()
+	if len(ciphertext) < size {
+		return nil, ErrMalformed
+	}
+	plaintext, err := b.aead.Open(nil, ciphertext[:size], ciphertext[size:], associatedData)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	return plaintext, nil
+}
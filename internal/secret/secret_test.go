@@ -0,0 +1,68 @@
+package secret
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newKey(t *testing.T) string {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func TestBox(t *testing.T) {
+	box, err := NewBox(newKey(t))
+	require.NoError(t, err)
+
+	sealed, err := box.Seal([]byte("hunter2"), []byte("mailbox"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), "hunter2")
+
+	again, err := box.Seal([]byte("hunter2"), []byte("mailbox"))
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "every value gets its own nonce")
+
+	opened, err := box.Open(sealed, []byte("mailbox"))
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", string(opened))
+
+	t.Run("rejects tampered values", func(t *testing.T) {
+		tampered := append([]byte(nil), sealed...)
+		tampered[len(tampered)-1] ^= 1
+		_, err := box.Open(tampered, []byte("mailbox"))
+		assert.ErrorIs(t, err, ErrMalformed)
+
+		_, err = box.Open([]byte("short"), []byte("mailbox"))
+		assert.ErrorIs(t, err, ErrMalformed)
+	})
+
+	t.Run("rejects other associated data", func(t *testing.T) {
+		_, err := box.Open(sealed, []byte("other mailbox"))
+		assert.ErrorIs(t, err, ErrMalformed)
+	})
+
+	t.Run("rejects other keys", func(t *testing.T) {
+		other, err := NewBox(newKey(t))
+		require.NoError(t, err)
+		_, err = other.Open(sealed, []byte("mailbox"))
+		assert.ErrorIs(t, err, ErrMalformed)
+	})
+}
+
+func TestNewBox(t *testing.T) {
+	_, err := NewBox("not base64!")
+	assert.Error(t, err)
+
+	_, err = NewBox(base64.StdEncoding.EncodeToString([]byte("too short")))
+	assert.Error(t, err)
+
+	_, err = NewBox("")
+	assert.Error(t, err)
+}
@@ -0,0 +1,31 @@
+package sender
+
+import (
+	"errors"
+	"net/textproto"
+)
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as a failure that retrying cannot fix.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err is a failure that retrying cannot fix: one
+// marked with Permanent or a 5xx reply of an SMTP server. Everything else,
+// including 4xx replies and network errors, is considered transient.
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	if errors.As(err, &permanent) {
+		return true
+	}
+
+	var reply *textproto.Error
+	return errors.As(err, &reply) && reply.Code >= 500 && reply.Code < 600
+}
@@ -0,0 +1,83 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	_ Sender = (*File)(nil)
+	_ Sender = (*Memory)(nil)
+)
+
+// File writes every email as an .eml file into a directory instead of sending
+// it, for local runs.
+type File struct {
+	dir string
+}
+
+func NewFile(dir string) *File {
+	return &File{dir: dir}
+}
+
+func (f *File) Send(_ context.Context, email *Email) error {
+	now := time.Now()
+	msg, err := message(email, now)
+	if err != nil {
+		return fmt.Errorf("formatting message: %w", err)
+	}
+
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return fmt.Errorf("creating outbox: %w", err)
+	}
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405"), uuid.New())
+	if err := os.WriteFile(filepath.Join(f.dir, name), msg, 0o644); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	return nil
+}
+
+// Memory keeps sent emails in memory, for tests.
+type Memory struct {
+	mu   sync.Mutex
+	sent []*Email
+	err  error
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Send(_ context.Context, email *Email) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	sent := *email
+	m.sent = append(m.sent, &sent)
+	return nil
+}
+
+// Fail makes Send return err from now on, or succeed again if err is nil.
+func (m *Memory) Fail(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.err = err
+}
+
+// Sent returns the emails sent so far.
+func (m *Memory) Sent() []*Email {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*Email(nil), m.sent...)
+}
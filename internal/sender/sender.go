@@ -0,0 +1,84 @@
+// Package sender delivers rendered emails.
+package sender
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Email struct {
+	From    string
+	To      string
+	Subject string
+	HTML    string
+	// Text is the plain-text alternative of HTML. It is left out when empty.
+	Text string
+}
+
+type Sender interface {
+	// Send delivers email. It returns when the email has been handed over to
+	// the next hop, which may still reject it later.
+	Send(ctx context.Context, email *Email) error
+}
+
+// message formats email as an RFC 5322 message.
+func message(email *Email, now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	header := textproto.MIMEHeader{}
+	header.Set("From", email.From)
+	header.Set("To", email.To)
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	header.Set("Date", now.Format(time.RFC1123Z))
+	header.Set("Message-ID", fmt.Sprintf("<%s@sequence-api>", uuid.New()))
+	header.Set("MIME-Version", "1.0")
+
+	if email.Text == "" {
+		header.Set("Content-Type", "text/html; charset=UTF-8")
+		header.Set("Content-Transfer-Encoding", "8bit")
+		writeHeader(&buf, header)
+		buf.WriteString(email.HTML)
+		return buf.Bytes(), nil
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain; charset=UTF-8", email.Text},
+		{"text/html; charset=UTF-8", email.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"8bit"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	header.Set("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+	writeHeader(&buf, header)
+	buf.Write(body.Bytes())
+	return buf.Bytes(), nil
+}
+
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	for _, name := range []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "Content-Transfer-Encoding"} {
+		if value := header.Get(name); value != "" {
+			fmt.Fprintf(buf, "%s: %s\r\n", name, value)
+		}
+	}
+	buf.WriteString("\r\n")
+}
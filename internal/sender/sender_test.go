@@ -0,0 +1,205 @@
+package sender
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http/httptest"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessage(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	t.Run("html only", func(t *testing.T) {
+		raw, err := message(&Email{
+			From:    "Sales <sales@example.com>",
+			To:      "jane@example.com",
+			Subject: "Hello Jäne",
+			HTML:    "<p>Hi</p>",
+		}, now)
+		require.NoError(t, err)
+
+		msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+		require.NoError(t, err)
+		assert.Equal(t, "Sales <sales@example.com>", msg.Header.Get("From"))
+		assert.Equal(t, "jane@example.com", msg.Header.Get("To"))
+		assert.Equal(t, "Sat, 01 Mar 2025 09:30:00 +0000", msg.Header.Get("Date"))
+		assert.NotEmpty(t, msg.Header.Get("Message-ID"))
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Hello Jäne", subject)
+
+		body, err := io.ReadAll(msg.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "<p>Hi</p>", string(body))
+	})
+
+	t.Run("with text alternative", func(t *testing.T) {
+		raw, err := message(&Email{
+			From:    "sales@example.com",
+			To:      "jane@example.com",
+			Subject: "Hello",
+			HTML:    "<p>Hi</p>",
+			Text:    "Hi",
+		}, now)
+		require.NoError(t, err)
+
+		msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+		require.NoError(t, err)
+		mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		parts := multipart.NewReader(msg.Body, params["boundary"])
+		var contents []string
+		for {
+			part, err := parts.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			content, err := io.ReadAll(part)
+			require.NoError(t, err)
+			contents = append(contents, part.Header.Get("Content-Type")+": "+string(content))
+		}
+		assert.Equal(t, []string{
+			"text/plain; charset=UTF-8: Hi",
+			"text/html; charset=UTF-8: <p>Hi</p>",
+		}, contents)
+	})
+}
+
+func TestFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	email := &Email{From: "sales@example.com", To: "jane@example.com", Subject: "Hello", HTML: "<p>Hi</p>"}
+
+	require.NoError(t, NewFile(dir).Send(context.Background(), email))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.True(t, strings.HasSuffix(files[0].Name(), ".eml"))
+
+	raw, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "To: jane@example.com\r\n")
+}
+
+func TestMemory(t *testing.T) {
+	m := NewMemory()
+	ctx := context.Background()
+
+	require.NoError(t, m.Send(ctx, &Email{To: "jane@example.com"}))
+	m.Fail(assert.AnError)
+	assert.ErrorIs(t, m.Send(ctx, &Email{To: "john@example.com"}), assert.AnError)
+
+	sent := m.Sent()
+	require.Len(t, sent, 1)
+	assert.Equal(t, "jane@example.com", sent[0].To)
+}
+
+// smtpServer accepts one SMTP session on a local port, replying to each
+// command with replies[command] or 250, and returns its port. The session
+// starts with a TLS handshake when tlsConfig is set.
+func smtpServer(t *testing.T, tlsConfig *tls.Config, replies map[string]string) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	if tlsConfig != nil {
+		l = tls.NewListener(l, tlsConfig)
+	}
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		text := textproto.NewConn(conn)
+		_ = text.PrintfLine("220 localhost")
+		for {
+			line, err := text.ReadLine()
+			if err != nil {
+				return
+			}
+			command, _, _ := strings.Cut(line, " ")
+			if command == "DATA" {
+				_ = text.PrintfLine("354 go ahead")
+				if _, err := text.ReadDotBytes(); err != nil {
+					return
+				}
+			}
+			reply, ok := replies[command]
+			if !ok {
+				reply = "250 ok"
+			}
+			_ = text.PrintfLine("%s", reply)
+		}
+	}()
+
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func TestSMTP(t *testing.T) {
+	email := &Email{From: "sales@example.com", To: "jane@example.com", Subject: "Hello", HTML: "<p>Hi</p>"}
+
+	t.Run("sends the email", func(t *testing.T) {
+		port := smtpServer(t, nil, map[string]string{"QUIT": "221 bye"})
+		assert.NoError(t, NewSMTP(SMTPOptions{Host: "127.0.0.1", Port: port}).Send(context.Background(), email))
+	})
+
+	t.Run("ignores errors after the message is accepted", func(t *testing.T) {
+		port := smtpServer(t, nil, map[string]string{"QUIT": "421 closing"})
+		assert.NoError(t, NewSMTP(SMTPOptions{Host: "127.0.0.1", Port: port}).Send(context.Background(), email))
+	})
+
+	t.Run("connects with implicit tls", func(t *testing.T) {
+		// httptest comes with a certificate for 127.0.0.1.
+		https := httptest.NewTLSServer(nil)
+		defer https.Close()
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(https.Certificate())
+
+		port := smtpServer(t, https.TLS, map[string]string{"QUIT": "221 bye"})
+		smtp := NewSMTP(SMTPOptions{Host: "127.0.0.1", Port: port, ImplicitTLS: true})
+		smtp.rootCAs = rootCAs
+		assert.NoError(t, smtp.Send(context.Background(), email))
+	})
+
+	t.Run("uses implicit tls on port 465", func(t *testing.T) {
+		assert.True(t, NewSMTP(SMTPOptions{Host: "smtp.example.com", Port: ImplicitTLSPort}).opts.ImplicitTLS)
+		assert.False(t, NewSMTP(SMTPOptions{Host: "smtp.example.com", Port: 587}).opts.ImplicitTLS)
+	})
+
+	t.Run("fails when the message is rejected", func(t *testing.T) {
+		port := smtpServer(t, nil, map[string]string{"DATA": "554 rejected", "QUIT": "221 bye"})
+		err := NewSMTP(SMTPOptions{Host: "127.0.0.1", Port: port}).Send(context.Background(), email)
+		assert.True(t, IsPermanent(err))
+	})
+}
+
+func TestIsPermanent(t *testing.T) {
+	assert.True(t, IsPermanent(Permanent(errors.New("bad address"))))
+	assert.True(t, IsPermanent(fmt.Errorf("setting recipient: %w", &textproto.Error{Code: 550, Msg: "no such user"})))
+	assert.False(t, IsPermanent(fmt.Errorf("setting recipient: %w", &textproto.Error{Code: 451, Msg: "try again later"})))
+	assert.False(t, IsPermanent(errors.New("connection refused")))
+	assert.False(t, IsPermanent(context.DeadlineExceeded))
+}
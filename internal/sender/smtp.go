@@ -0,0 +1,132 @@
+package sender
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+var _ Sender = (*SMTP)(nil)
+
+// ImplicitTLSPort is the port of SMTP submission over TLS, where servers
+// expect a TLS handshake before any SMTP command.
+const ImplicitTLSPort = 465
+
+type SMTPOptions struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// ImplicitTLS connects with TLS from the start instead of upgrading the
+	// connection with STARTTLS. NewSMTP sets it for ImplicitTLSPort.
+	ImplicitTLS bool
+}
+
+// SMTP sends emails through an SMTP server, upgrading the connection with
+// STARTTLS when the server offers it, unless it is connected with TLS from
+// the start.
+type SMTP struct {
+	opts SMTPOptions
+	// rootCAs verifies the certificate of the server, the system roots are
+	// used when it is nil.
+	rootCAs *x509.CertPool
+}
+
+func NewSMTP(opts SMTPOptions) *SMTP {
+	if opts.Port == ImplicitTLSPort {
+		opts.ImplicitTLS = true
+	}
+	return &SMTP{opts: opts}
+}
+
+func (s *SMTP) Send(ctx context.Context, email *Email) error {
+	from, err := mail.ParseAddress(email.From)
+	if err != nil {
+		return Permanent(fmt.Errorf("parsing sender address: %w", err))
+	}
+	to, err := mail.ParseAddress(email.To)
+	if err != nil {
+		return Permanent(fmt.Errorf("parsing recipient address: %w", err))
+	}
+
+	msg, err := message(email, time.Now())
+	if err != nil {
+		return Permanent(fmt.Errorf("formatting message: %w", err))
+	}
+
+	addr := net.JoinHostPort(s.opts.Host, strconv.Itoa(s.opts.Port))
+	conn, err := s.dial(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("connecting to %s: %w", addr, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	// Unblock the SMTP exchange when ctx is cancelled before it finishes.
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, s.opts.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("starting smtp session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok && !s.opts.ImplicitTLS {
+		if err := client.StartTLS(s.tlsConfig()); err != nil {
+			return fmt.Errorf("starting tls: %w", err)
+		}
+	}
+	if s.opts.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.opts.Username, s.opts.Password, s.opts.Host)); err != nil {
+			return fmt.Errorf("authenticating: %w", err)
+		}
+	}
+
+	if err := client.Mail(from.Address); err != nil {
+		return fmt.Errorf("setting sender: %w", err)
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return fmt.Errorf("setting recipient: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("starting data: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("writing message: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("finishing message: %w", err)
+	}
+
+	// The server has taken the message once DATA is finished, so failing to
+	// end the session must not fail the send, or the email would be sent again.
+	if err := client.Quit(); err != nil {
+		slog.WarnContext(ctx, "ending smtp session", "host", s.opts.Host, "err", err)
+	}
+	return nil
+}
+
+// dial connects to addr, with a TLS handshake first when the server expects
+// implicit TLS.
+func (s *SMTP) dial(ctx context.Context, addr string) (net.Conn, error) {
+	if s.opts.ImplicitTLS {
+		dialer := tls.Dialer{Config: s.tlsConfig()}
+		return dialer.DialContext(ctx, "tcp", addr)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", addr)
+}
+
+func (s *SMTP) tlsConfig() *tls.Config {
+	return &tls.Config{ServerName: s.opts.Host, RootCAs: s.rootCAs}
+}
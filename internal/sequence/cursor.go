@@ -0,0 +1,59 @@
+package sequence
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/paging"
+)
+
+var ErrInvalidCursor = paging.ErrInvalidCursor
+
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByName      SortField = "name"
+)
+
+// cursor points at the last sequence of a page. It is handed out to clients
+// as an opaque base64 string and is only valid for the sort it was issued for.
+type cursor struct {
+	Sort       SortField `json:"s"`
+	Descending bool      `json:"d"`
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"n,omitempty"`
+	CreatedAt  time.Time `json:"c,omitzero"`
+}
+
+func newCursor(sequence *models.Sequence, sort SortField, descending bool) cursor {
+	c := cursor{
+		Sort:       sort,
+		Descending: descending,
+		ID:         sequence.ID,
+	}
+	switch sort {
+	case SortByName:
+		c.Name = sequence.Name
+	default:
+		c.CreatedAt = sequence.CreatedAt.Time
+	}
+	return c
+}
+
+func (c cursor) encode() string {
+	return paging.Encode(c)
+}
+
+func decodeCursor(s string, sort SortField, descending bool) (*cursor, error) {
+	var c cursor
+	if err := paging.Decode(s, &c); err != nil {
+		return nil, err
+	}
+	if c.Sort != sort || c.Descending != descending || c.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
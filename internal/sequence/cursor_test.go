@@ -0,0 +1,50 @@
+package sequence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	sequence := &models.Sequence{
+		ID:        uuid.New(),
+		Name:      "Test Sequence",
+		CreatedAt: pgtype.Timestamptz{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+
+	t.Run("round trip by created at", func(t *testing.T) {
+		encoded := newCursor(sequence, SortByCreatedAt, true).encode()
+
+		decoded, err := decodeCursor(encoded, SortByCreatedAt, true)
+		require.NoError(t, err)
+		assert.Equal(t, sequence.ID, decoded.ID)
+		assert.True(t, sequence.CreatedAt.Time.Equal(decoded.CreatedAt))
+	})
+
+	t.Run("round trip by name", func(t *testing.T) {
+		encoded := newCursor(sequence, SortByName, false).encode()
+
+		decoded, err := decodeCursor(encoded, SortByName, false)
+		require.NoError(t, err)
+		assert.Equal(t, sequence.ID, decoded.ID)
+		assert.Equal(t, sequence.Name, decoded.Name)
+	})
+
+	t.Run("rejects mismatched sort", func(t *testing.T) {
+		encoded := newCursor(sequence, SortByName, false).encode()
+
+		_, err := decodeCursor(encoded, SortByName, true)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("rejects garbage", func(t *testing.T) {
+		_, err := decodeCursor("not a cursor!", SortByCreatedAt, false)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
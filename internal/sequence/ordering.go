@@ -0,0 +1,108 @@
+package sequence
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/models"
+)
+
+var ErrInvalidPosition = errors.New("invalid step position")
+
+func invalidPosition(field, message string) error {
+	return fmt.Errorf("%w: %w", ErrInvalidPosition, apperr.Validation(apperr.FieldError{
+		Field:   field,
+		Message: message,
+	}))
+}
+
+// Position describes where a step should be placed within its sequence.
+// Leaving both fields empty places the step at the end.
+type Position struct {
+	AfterStepID  *uuid.UUID
+	BeforeStepID *uuid.UUID
+}
+
+// splitSteps separates the step with the given ID from the rest of steps. The
+// returned step is nil when steps does not contain it.
+func splitSteps(steps []*models.SequenceStep, id uuid.UUID) (*models.SequenceStep, []*models.SequenceStep) {
+	var found *models.SequenceStep
+	others := make([]*models.SequenceStep, 0, len(steps))
+	for _, step := range steps {
+		if step.ID == id {
+			found = step
+			continue
+		}
+		others = append(others, step)
+	}
+	return found, others
+}
+
+const unknownStep = "must reference another step of the same sequence"
+
+// insertIndex returns the index the step should occupy in steps, which must
+// be sorted by ordering and must not contain the step being placed.
+func insertIndex(steps []*models.SequenceStep, pos Position) (int, error) {
+	indexOf := func(id uuid.UUID) int {
+		for i, step := range steps {
+			if step.ID == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	switch {
+	case pos.AfterStepID != nil && pos.BeforeStepID != nil:
+		after, before := indexOf(*pos.AfterStepID), indexOf(*pos.BeforeStepID)
+		if after < 0 {
+			return 0, invalidPosition("afterStepId", unknownStep)
+		}
+		if before < 0 {
+			return 0, invalidPosition("beforeStepId", unknownStep)
+		}
+		if after+1 != before {
+			return 0, invalidPosition("beforeStepId", "must directly follow afterStepId")
+		}
+		return before, nil
+	case pos.AfterStepID != nil:
+		after := indexOf(*pos.AfterStepID)
+		if after < 0 {
+			return 0, invalidPosition("afterStepId", unknownStep)
+		}
+		return after + 1, nil
+	case pos.BeforeStepID != nil:
+		before := indexOf(*pos.BeforeStepID)
+		if before < 0 {
+			return 0, invalidPosition("beforeStepId", unknownStep)
+		}
+		return before, nil
+	default:
+		return len(steps), nil
+	}
+}
+
+// orderingAt returns an ordering value that sorts between steps[idx-1] and
+// steps[idx]. It reports false once the REAL column no longer has precision
+// to represent a value between the two neighbours, in which case the steps
+// have to be renumbered first.
+func orderingAt(steps []*models.SequenceStep, idx int) (float32, bool) {
+	switch {
+	case len(steps) == 0:
+		return 0, true
+	case idx == 0:
+		first := steps[0].Ordering
+		ordering := first - 1
+		return ordering, ordering < first
+	case idx == len(steps):
+		last := steps[len(steps)-1].Ordering
+		ordering := last + 1
+		return ordering, ordering > last
+	default:
+		prev, next := steps[idx-1].Ordering, steps[idx].Ordering
+		ordering := prev + (next-prev)/2
+		return ordering, ordering > prev && ordering < next
+	}
+}
@@ -0,0 +1,101 @@
+package sequence
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInsertIndex(t *testing.T) {
+	steps := []*models.SequenceStep{
+		{ID: uuid.New(), Ordering: 0},
+		{ID: uuid.New(), Ordering: 1},
+		{ID: uuid.New(), Ordering: 2},
+	}
+	unknown := uuid.New()
+
+	tests := []struct {
+		name    string
+		pos     Position
+		want    int
+		wantErr bool
+	}{
+		{name: "append", pos: Position{}, want: 3},
+		{name: "after first", pos: Position{AfterStepID: &steps[0].ID}, want: 1},
+		{name: "after last", pos: Position{AfterStepID: &steps[2].ID}, want: 3},
+		{name: "before first", pos: Position{BeforeStepID: &steps[0].ID}, want: 0},
+		{name: "between neighbours", pos: Position{AfterStepID: &steps[0].ID, BeforeStepID: &steps[1].ID}, want: 1},
+		{name: "between non neighbours", pos: Position{AfterStepID: &steps[0].ID, BeforeStepID: &steps[2].ID}, wantErr: true},
+		{name: "unknown after", pos: Position{AfterStepID: &unknown}, wantErr: true},
+		{name: "unknown before", pos: Position{BeforeStepID: &unknown}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := insertIndex(steps, tt.pos)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPosition)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOrderingAt(t *testing.T) {
+	steps := []*models.SequenceStep{
+		{Ordering: 0},
+		{Ordering: 1},
+	}
+
+	t.Run("empty sequence", func(t *testing.T) {
+		ordering, ok := orderingAt(nil, 0)
+		assert.True(t, ok)
+		assert.Equal(t, float32(0), ordering)
+	})
+
+	t.Run("before first", func(t *testing.T) {
+		ordering, ok := orderingAt(steps, 0)
+		assert.True(t, ok)
+		assert.Equal(t, float32(-1), ordering)
+	})
+
+	t.Run("between", func(t *testing.T) {
+		ordering, ok := orderingAt(steps, 1)
+		assert.True(t, ok)
+		assert.Equal(t, float32(0.5), ordering)
+	})
+
+	t.Run("after last", func(t *testing.T) {
+		ordering, ok := orderingAt(steps, 2)
+		assert.True(t, ok)
+		assert.Equal(t, float32(2), ordering)
+	})
+
+	t.Run("precision exhausted", func(t *testing.T) {
+		prev := float32(1)
+		crowded := []*models.SequenceStep{
+			{Ordering: prev},
+			{Ordering: math.Nextafter32(prev, 2)},
+		}
+		_, ok := orderingAt(crowded, 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("repeated halving eventually needs renumbering", func(t *testing.T) {
+		crowded := []*models.SequenceStep{{Ordering: 1}, {Ordering: 2}}
+		for range 100 {
+			ordering, ok := orderingAt(crowded, 1)
+			if !ok {
+				return
+			}
+			crowded[1].Ordering = ordering
+		}
+		t.Fatal("expected precision to run out")
+	})
+}
@@ -0,0 +1,101 @@
+package sequence
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/render"
+	"github.com/pirellik/sequence-api/internal/template"
+)
+
+// Preview selects the values a step is previewed with. Variables set values
+// by variable path, such as "custom.company", over those of the contact, the
+// sender and the sequence.
+type Preview struct {
+	ContactID *uuid.UUID
+	Variables map[string]any
+}
+
+// PreviewStep renders a step the way the worker sends it, except that
+// variables without a value render empty and are listed in the result. The
+// sender is a mailbox of the sequence, if it has any. Links are rewritten if
+// the sequence tracks clicks, to tracking URLs that do not belong to any send
+// and are not redirected.
+func (s *Service) PreviewStep(ctx context.Context, sequenceID, stepID uuid.UUID, preview Preview) (*render.Email, error) {
+	var (
+		step *models.SequenceStep
+		vars render.Variables
+		opts render.Options
+	)
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		sequence, err := q.GetSequenceByID(ctx, sequenceID)
+		if err != nil {
+			return db.NotFound(err, apperr.ErrSequenceNotFound)
+		}
+		vars.Sequence = render.Sequence{Name: sequence.Name}
+		if s.tracking != nil && sequence.ClickTrackingEnabled {
+			opts.TrackLink = func(url string) string {
+				return s.tracking.Click(uuid.Nil, url)
+			}
+		}
+
+		step, err = q.GetSequenceStepByID(ctx, &models.GetSequenceStepByIDParams{ID: stepID, SequenceID: sequenceID})
+		if err != nil {
+			return db.NotFound(err, apperr.ErrStepNotFound)
+		}
+
+		mailboxIDs, err := q.GetSequenceMailboxIDs(ctx, sequenceID)
+		if err != nil {
+			return err
+		}
+		if len(mailboxIDs) > 0 {
+			mailbox, err := q.GetMailboxByID(ctx, mailboxIDs[0])
+			if err != nil {
+				return err
+			}
+			vars.Sender = &render.Sender{Name: mailbox.FromName, Email: mailbox.FromAddress}
+		}
+
+		if preview.ContactID != nil {
+			contact, err := q.GetContactByID(ctx, *preview.ContactID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.Validation(apperr.FieldError{Field: "contactId", Message: "must reference an existing contact"})
+			}
+			if err != nil {
+				return err
+			}
+			vars.Contact = &render.Contact{
+				Email:        contact.Email,
+				FirstName:    contact.FirstName,
+				LastName:     contact.LastName,
+				TimeZone:     contact.TimeZone,
+				CustomFields: contact.CustomFields,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := vars.Data()
+	for path, value := range preview.Variables {
+		data.Set(path, value)
+	}
+
+	email, err := render.Render(render.Step{Subject: step.EmailSubject, Content: step.EmailContent}, data, opts)
+	var templateErr *template.Error
+	if errors.As(err, &templateErr) {
+		// Steps saved before their templates were validated may not parse.
+		return nil, apperr.Validation(apperr.FieldError{Field: "stepId", Message: err.Error()})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return email, nil
+}
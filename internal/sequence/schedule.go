@@ -0,0 +1,109 @@
+package sequence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/schedule"
+)
+
+// SendSchedule is when the emails of a sequence go out. TimeZone is an IANA
+// time zone name.
+type SendSchedule struct {
+	TimeZone  string
+	Weekdays  []time.Weekday
+	StartHour int
+	EndHour   int
+	Holidays  []schedule.Date
+	// UseContactTimeZone applies the hours and holidays in the time zone of
+	// each contact that has one instead of TimeZone.
+	UseContactTimeZone bool
+}
+
+func sendScheduleFromDB(sequence *models.Sequence) *SendSchedule {
+	weekdays := make([]time.Weekday, len(sequence.SendWeekdays))
+	for i, day := range sequence.SendWeekdays {
+		weekdays[i] = time.Weekday(day)
+	}
+	holidays := make([]schedule.Date, len(sequence.SendHolidays))
+	for i, day := range sequence.SendHolidays {
+		holidays[i] = schedule.DateOf(day.Time)
+	}
+	return &SendSchedule{
+		TimeZone:           sequence.SendTimeZone,
+		Weekdays:           weekdays,
+		StartHour:          int(sequence.SendStartHour),
+		EndHour:            int(sequence.SendEndHour),
+		Holidays:           holidays,
+		UseContactTimeZone: sequence.UseContactTimeZone,
+	}
+}
+
+func (s *Service) GetSendSchedule(ctx context.Context, sequenceID uuid.UUID) (*SendSchedule, error) {
+	var sendSchedule *SendSchedule
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		sequence, err := q.GetSequenceByID(ctx, sequenceID)
+		if err != nil {
+			return db.NotFound(err, apperr.ErrSequenceNotFound)
+		}
+
+		sendSchedule = sendScheduleFromDB(sequence)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sendSchedule, nil
+}
+
+// SetSendSchedule replaces the sending schedule of a sequence. Steps that are
+// due already keep their due time, but are held back until the schedule
+// allows sending them.
+func (s *Service) SetSendSchedule(ctx context.Context, sequenceID uuid.UUID, sendSchedule SendSchedule) (*SendSchedule, error) {
+	weekdays := make([]int32, len(sendSchedule.Weekdays))
+	for i, day := range sendSchedule.Weekdays {
+		weekdays[i] = int32(day)
+	}
+	holidays := make([]pgtype.Date, len(sendSchedule.Holidays))
+	for i, day := range sendSchedule.Holidays {
+		holidays[i] = pgtype.Date{Time: time.Date(day.Year, day.Month, day.Day, 0, 0, 0, 0, time.UTC), Valid: true}
+	}
+
+	var updated *SendSchedule
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if err := lockSequence(ctx, q, sequenceID); err != nil {
+			return err
+		}
+
+		err := q.SetSendSchedule(ctx, &models.SetSendScheduleParams{
+			ID:                 sequenceID,
+			SendTimeZone:       sendSchedule.TimeZone,
+			SendWeekdays:       weekdays,
+			SendStartHour:      int32(sendSchedule.StartHour),
+			SendEndHour:        int32(sendSchedule.EndHour),
+			SendHolidays:       holidays,
+			UseContactTimeZone: sendSchedule.UseContactTimeZone,
+		})
+		if err != nil {
+			return err
+		}
+
+		sequence, err := q.GetSequenceByID(ctx, sequenceID)
+		if err != nil {
+			return err
+		}
+		updated = sendScheduleFromDB(sequence)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
@@ -2,18 +2,40 @@ package sequence
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db"
 	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/tracking"
 )
 
 type Service struct {
 	db *pgxpool.Pool
+	// tracking rewrites the links of previews, which are not rewritten when
+	// it is nil.
+	tracking *tracking.URLs
 }
 
-func NewService(db *pgxpool.Pool) *Service {
-	return &Service{db: db}
+type ListSequencesParams struct {
+	Name       *string
+	Sort       SortField
+	Descending bool
+	Cursor     *string
+	Limit      int
+}
+
+type SequencePage struct {
+	Sequences  []*models.Sequence
+	Steps      map[uuid.UUID][]*models.SequenceStep
+	NextCursor *string
+}
+
+func NewService(db *pgxpool.Pool, tracking *tracking.URLs) *Service {
+	return &Service{db: db, tracking: tracking}
 }
 
 func (s *Service) CreateSequence(
@@ -21,113 +43,604 @@ func (s *Service) CreateSequence(
 	sequence *models.Sequence,
 	steps []*models.SequenceStep,
 ) (*models.Sequence, []*models.SequenceStep, error) {
-	q := models.New(s.db)
-	id, err := q.CreateSequence(ctx, &models.CreateSequenceParams{
-		Name:                 sequence.Name,
-		OpenTrackingEnabled:  sequence.OpenTrackingEnabled,
-		ClickTrackingEnabled: sequence.ClickTrackingEnabled,
+	var (
+		created      *models.Sequence
+		createdSteps []*models.SequenceStep
+	)
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		id, err := q.CreateSequence(ctx, &models.CreateSequenceParams{
+			Name:                 sequence.Name,
+			OpenTrackingEnabled:  sequence.OpenTrackingEnabled,
+			ClickTrackingEnabled: sequence.ClickTrackingEnabled,
+		})
+		if err != nil {
+			return err
+		}
+
+		for i, step := range steps {
+			_, err = q.CreateSequenceStep(ctx, &models.CreateSequenceStepParams{
+				SequenceID:            id,
+				EmailSubject:          step.EmailSubject,
+				EmailContent:          step.EmailContent,
+				DaysAfterPreviousStep: step.DaysAfterPreviousStep,
+				Ordering:              float32(i),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if err := checkSteps(ctx, q, id); err != nil {
+			return err
+		}
+
+		created, createdSteps, err = getSequence(ctx, q, id)
+		return err
 	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	for i, step := range steps {
-		_, err = q.CreateSequenceStep(ctx, &models.CreateSequenceStepParams{
-			SequenceID:            id,
-			EmailSubject:          step.EmailSubject,
-			EmailContent:          step.EmailContent,
-			DaysAfterPreviousStep: step.DaysAfterPreviousStep,
-			Ordering:              float32(i),
-		})
-		if err != nil {
-			return nil, nil, err
-		}
-	}
+	return created, createdSteps, nil
+}
 
-	created, err := q.GetSequenceByID(ctx, id)
+func (s *Service) GetSequence(ctx context.Context, id uuid.UUID) (*models.Sequence, []*models.SequenceStep, error) {
+	var (
+		sequence *models.Sequence
+		steps    []*models.SequenceStep
+	)
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		var err error
+		sequence, steps, err = getSequence(ctx, q, id)
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	steps, err = q.GetSequenceStepsBySequenceID(ctx, id)
+	return sequence, steps, nil
+}
+
+func getSequence(ctx context.Context, q *models.Queries, id uuid.UUID) (*models.Sequence, []*models.SequenceStep, error) {
+	sequence, err := q.GetSequenceByID(ctx, id)
+	if err != nil {
+		return nil, nil, db.NotFound(err, apperr.ErrSequenceNotFound)
+	}
+
+	steps, err := q.GetSequenceStepsBySequenceID(ctx, id)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return created, steps, nil
+	return sequence, steps, nil
+}
+
+func (s *Service) ListSequences(ctx context.Context, params ListSequencesParams) (*SequencePage, error) {
+	if params.Sort == "" {
+		params.Sort = SortByCreatedAt
+	}
+
+	var after *cursor
+	if params.Cursor != nil {
+		c, err := decodeCursor(*params.Cursor, params.Sort, params.Descending)
+		if err != nil {
+			return nil, err
+		}
+		after = c
+	}
+
+	var name pgtype.Text
+	if params.Name != nil {
+		name = pgtype.Text{String: db.EscapeLike(*params.Name), Valid: true}
+	}
+
+	// One extra row tells us whether there is a next page.
+	limit := int32(params.Limit) + 1
+
+	page := &SequencePage{}
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		var (
+			sequences []*models.Sequence
+			err       error
+		)
+		switch params.Sort {
+		case SortByName:
+			p := models.ListSequencesByNameParams{
+				Name:       name,
+				Descending: params.Descending,
+				Limit:      limit,
+			}
+			if after != nil {
+				p.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+				p.CursorName = pgtype.Text{String: after.Name, Valid: true}
+			}
+			sequences, err = q.ListSequencesByName(ctx, &p)
+		default:
+			p := models.ListSequencesByCreatedAtParams{
+				Name:       name,
+				Descending: params.Descending,
+				Limit:      limit,
+			}
+			if after != nil {
+				p.CursorID = pgtype.UUID{Bytes: after.ID, Valid: true}
+				p.CursorCreatedAt = pgtype.Timestamptz{Time: after.CreatedAt, Valid: true}
+			}
+			sequences, err = q.ListSequencesByCreatedAt(ctx, &p)
+		}
+		if err != nil {
+			return err
+		}
+
+		page.Steps = make(map[uuid.UUID][]*models.SequenceStep, len(sequences))
+		if len(sequences) > params.Limit {
+			sequences = sequences[:params.Limit]
+			next := newCursor(sequences[len(sequences)-1], params.Sort, params.Descending).encode()
+			page.NextCursor = &next
+		}
+		page.Sequences = sequences
+
+		if len(sequences) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(sequences))
+		for i, sequence := range sequences {
+			ids[i] = sequence.ID
+		}
+		steps, err := q.GetSequenceStepsBySequenceIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, step := range steps {
+			page.Steps[step.SequenceID] = append(page.Steps[step.SequenceID], step)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// SequenceUpdate lists the sequence fields to change. Nil fields keep their
+// current value. A non-nil Steps replaces the step list of the sequence, see
+// syncSteps.
+type SequenceUpdate struct {
+	Name                 *string
+	OpenTrackingEnabled  *bool
+	ClickTrackingEnabled *bool
+	Steps                []*models.SequenceStep
+	// Version, when set, must match the stored version of the sequence.
+	Version *int32
 }
 
 func (s *Service) UpdateSequence(
 	ctx context.Context,
 	id uuid.UUID,
-	openTrackingEnabled, clickTrackingEnabled *bool,
+	update SequenceUpdate,
 ) (*models.Sequence, []*models.SequenceStep, error) {
-	q := models.New(s.db)
-	sequence, err := q.GetSequenceByID(ctx, id)
+	var (
+		updated *models.Sequence
+		steps   []*models.SequenceStep
+	)
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if err := lockSequence(ctx, q, id); err != nil {
+			return err
+		}
+
+		sequence, err := q.GetSequenceByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(update.Version, sequence.Version); err != nil {
+			return err
+		}
+
+		params := models.UpdateSequenceParams{
+			ID:                   id,
+			Name:                 sequence.Name,
+			OpenTrackingEnabled:  sequence.OpenTrackingEnabled,
+			ClickTrackingEnabled: sequence.ClickTrackingEnabled,
+		}
+
+		if update.Name != nil {
+			params.Name = *update.Name
+		}
+		if update.OpenTrackingEnabled != nil {
+			params.OpenTrackingEnabled = *update.OpenTrackingEnabled
+		}
+		if update.ClickTrackingEnabled != nil {
+			params.ClickTrackingEnabled = *update.ClickTrackingEnabled
+		}
+
+		err = q.UpdateSequence(ctx, &params)
+		if err != nil {
+			return err
+		}
+
+		if update.Steps != nil {
+			if err := syncSteps(ctx, q, id, update.Steps); err != nil {
+				return err
+			}
+			if err := checkSteps(ctx, q, id); err != nil {
+				return err
+			}
+		}
+
+		updated, steps, err = getSequence(ctx, q, id)
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	params := models.UpdateSequenceParams{
-		ID:                   id,
-		OpenTrackingEnabled:  sequence.OpenTrackingEnabled,
-		ClickTrackingEnabled: sequence.ClickTrackingEnabled,
+	return updated, steps, nil
+}
+
+// syncSteps makes the step list of the sequence match steps. Steps with an ID
+// update the existing step, steps without one are created and existing steps
+// that are left out are deleted. The steps are ordered as given.
+func syncSteps(ctx context.Context, q *models.Queries, sequenceID uuid.UUID, steps []*models.SequenceStep) error {
+	current, err := q.GetSequenceStepsBySequenceID(ctx, sequenceID)
+	if err != nil {
+		return err
 	}
 
-	if openTrackingEnabled != nil {
-		params.OpenTrackingEnabled = *openTrackingEnabled
+	existing := make(map[uuid.UUID]bool, len(current))
+	for _, step := range current {
+		existing[step.ID] = true
 	}
-	if clickTrackingEnabled != nil {
-		params.ClickTrackingEnabled = *clickTrackingEnabled
+
+	var fields []apperr.FieldError
+	kept := make(map[uuid.UUID]bool, len(steps))
+	for i, step := range steps {
+		if step.ID == uuid.Nil {
+			continue
+		}
+		field := fmt.Sprintf("steps[%d].id", i)
+		switch {
+		case !existing[step.ID]:
+			fields = append(fields, apperr.FieldError{Field: field, Message: "must reference a step of the sequence"})
+		case kept[step.ID]:
+			fields = append(fields, apperr.FieldError{Field: field, Message: "must not be repeated"})
+		}
+		kept[step.ID] = true
+	}
+	if len(fields) > 0 {
+		return apperr.Validation(fields...)
 	}
 
-	err = q.UpdateSequence(ctx, &params)
-	if err != nil {
-		return nil, nil, err
+	// current is in sequence order, so enrollments waiting for several
+	// removed steps in a row end up at the first step that is kept.
+	for _, step := range current {
+		if kept[step.ID] {
+			continue
+		}
+		if err := q.AdvanceEnrollmentsPastStep(ctx, step.ID); err != nil {
+			return err
+		}
+		_, err := q.DeleteSequenceStep(ctx, &models.DeleteSequenceStepParams{
+			ID:         step.ID,
+			SequenceID: sequenceID,
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	updated, err := q.GetSequenceByID(ctx, id)
-	if err != nil {
-		return nil, nil, err
+	for i, step := range steps {
+		if step.ID == uuid.Nil {
+			_, err := q.CreateSequenceStep(ctx, &models.CreateSequenceStepParams{
+				SequenceID:            sequenceID,
+				EmailSubject:          step.EmailSubject,
+				EmailContent:          step.EmailContent,
+				DaysAfterPreviousStep: step.DaysAfterPreviousStep,
+				Ordering:              float32(i),
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		_, err := q.UpdateSequenceStep(ctx, &models.UpdateSequenceStepParams{
+			ID:                    step.ID,
+			SequenceID:            sequenceID,
+			EmailSubject:          step.EmailSubject,
+			EmailContent:          step.EmailContent,
+			DaysAfterPreviousStep: step.DaysAfterPreviousStep,
+			Ordering:              float32(i),
+		})
+		if err != nil {
+			return err
+		}
 	}
 
-	steps, err := q.GetSequenceStepsBySequenceID(ctx, id)
+	return nil
+}
+
+// DeleteSequence archives the sequence, hiding it from listings. With hard set
+// the sequence and all of its steps are removed permanently instead.
+func (s *Service) DeleteSequence(ctx context.Context, id uuid.UUID, hard bool) error {
+	return db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if !hard {
+			rows, err := q.ArchiveSequence(ctx, id)
+			if err != nil {
+				return err
+			}
+			if rows == 0 {
+				return apperr.ErrSequenceNotFound
+			}
+			return nil
+		}
+
+		if err := q.DeleteSequenceStepsBySequenceID(ctx, id); err != nil {
+			return err
+		}
+
+		rows, err := q.DeleteSequence(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return apperr.ErrSequenceNotFound
+		}
+		return nil
+	})
+}
+
+func (s *Service) RestoreSequence(ctx context.Context, id uuid.UUID) (*models.Sequence, []*models.SequenceStep, error) {
+	var (
+		restored *models.Sequence
+		steps    []*models.SequenceStep
+	)
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		rows, err := q.RestoreSequence(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return apperr.ErrSequenceNotFound
+		}
+
+		restored, steps, err = getSequence(ctx, q, id)
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return updated, steps, nil
+	return restored, steps, nil
 }
 
-func (s *Service) UpdateSequenceStep(
+// CreateSequenceStep adds a step to an existing sequence at the given
+// position.
+func (s *Service) CreateSequenceStep(
 	ctx context.Context,
-	sequenceID, stepID uuid.UUID,
-	emailSubject, emailContent *string,
+	sequenceID uuid.UUID,
+	step *models.SequenceStep,
+	pos Position,
 ) (*models.SequenceStep, error) {
-	q := models.New(s.db)
-	step, err := q.GetSequenceStepByID(ctx, stepID)
+	var created *models.SequenceStep
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if err := lockSequence(ctx, q, sequenceID); err != nil {
+			return err
+		}
+
+		steps, err := q.GetSequenceStepsBySequenceID(ctx, sequenceID)
+		if err != nil {
+			return err
+		}
+
+		ordering, err := placeStep(ctx, q, sequenceID, steps, pos)
+		if err != nil {
+			return err
+		}
+
+		id, err := q.CreateSequenceStep(ctx, &models.CreateSequenceStepParams{
+			SequenceID:            sequenceID,
+			EmailSubject:          step.EmailSubject,
+			EmailContent:          step.EmailContent,
+			DaysAfterPreviousStep: step.DaysAfterPreviousStep,
+			Ordering:              ordering,
+		})
+		if err != nil {
+			return err
+		}
+		if err := checkSteps(ctx, q, sequenceID); err != nil {
+			return err
+		}
+
+		if err := q.IncrementSequenceVersion(ctx, sequenceID); err != nil {
+			return err
+		}
+
+		created, err = q.GetSequenceStepByID(ctx, &models.GetSequenceStepByIDParams{
+			ID:         id,
+			SequenceID: sequenceID,
+		})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	params := models.UpdateSequenceStepParams{
-		ID:           stepID,
-		EmailSubject: step.EmailSubject,
-		EmailContent: step.EmailContent,
+	return created, nil
+}
+
+// MoveSequenceStep moves a step to the given position within its sequence
+// and returns the reordered sequence.
+func (s *Service) MoveSequenceStep(
+	ctx context.Context,
+	sequenceID, stepID uuid.UUID,
+	pos Position,
+) (*models.Sequence, []*models.SequenceStep, error) {
+	var (
+		moved *models.Sequence
+		steps []*models.SequenceStep
+	)
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if err := lockSequence(ctx, q, sequenceID); err != nil {
+			return err
+		}
+
+		current, err := q.GetSequenceStepsBySequenceID(ctx, sequenceID)
+		if err != nil {
+			return err
+		}
+
+		step, others := splitSteps(current, stepID)
+		if step == nil {
+			return apperr.ErrStepNotFound
+		}
+
+		ordering, err := placeStep(ctx, q, sequenceID, others, pos)
+		if err != nil {
+			return err
+		}
+
+		err = q.UpdateSequenceStepOrdering(ctx, &models.UpdateSequenceStepOrderingParams{
+			ID:         stepID,
+			SequenceID: sequenceID,
+			Ordering:   ordering,
+		})
+		if err != nil {
+			return err
+		}
+		if err := checkSteps(ctx, q, sequenceID); err != nil {
+			return err
+		}
+
+		if err := q.IncrementSequenceVersion(ctx, sequenceID); err != nil {
+			return err
+		}
+
+		moved, steps, err = getSequence(ctx, q, sequenceID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
 	}
-	if emailSubject != nil {
-		params.EmailSubject = *emailSubject
+
+	return moved, steps, nil
+}
+
+// placeStep returns the ordering for a step placed at pos among steps,
+// renumbering the steps first when there is no room left between the
+// neighbours.
+func placeStep(
+	ctx context.Context,
+	q *models.Queries,
+	sequenceID uuid.UUID,
+	steps []*models.SequenceStep,
+	pos Position,
+) (float32, error) {
+	idx, err := insertIndex(steps, pos)
+	if err != nil {
+		return 0, err
 	}
-	if emailContent != nil {
-		params.EmailContent = *emailContent
+
+	if ordering, ok := orderingAt(steps, idx); ok {
+		return ordering, nil
 	}
 
-	err = q.UpdateSequenceStep(ctx, &params)
-	if err != nil {
-		return nil, err
+	for i, step := range steps {
+		step.Ordering = float32(i)
+		err := q.UpdateSequenceStepOrdering(ctx, &models.UpdateSequenceStepOrderingParams{
+			ID:         step.ID,
+			SequenceID: sequenceID,
+			Ordering:   step.Ordering,
+		})
+		if err != nil {
+			return 0, err
+		}
 	}
 
-	updated, err := q.GetSequenceStepByID(ctx, stepID)
+	ordering, _ := orderingAt(steps, idx)
+	return ordering, nil
+}
+
+// StepUpdate lists the step fields to change. Nil fields keep their current
+// value, so a full replacement sets every field.
+type StepUpdate struct {
+	EmailSubject          *string
+	EmailContent          *string
+	DaysAfterPreviousStep *int32
+	// Position moves the step within its sequence when set.
+	Position *Position
+	// Version, when set, must match the stored version of the step.
+	Version *int32
+}
+
+func (s *Service) UpdateSequenceStep(
+	ctx context.Context,
+	sequenceID, stepID uuid.UUID,
+	update StepUpdate,
+) (*models.SequenceStep, error) {
+	var updated *models.SequenceStep
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if err := lockSequence(ctx, q, sequenceID); err != nil {
+			return err
+		}
+
+		current, err := q.GetSequenceStepsBySequenceID(ctx, sequenceID)
+		if err != nil {
+			return err
+		}
+
+		step, others := splitSteps(current, stepID)
+		if step == nil {
+			return apperr.ErrStepNotFound
+		}
+		if err := checkVersion(update.Version, step.Version); err != nil {
+			return err
+		}
+
+		params := models.UpdateSequenceStepParams{
+			ID:                    stepID,
+			SequenceID:            sequenceID,
+			EmailSubject:          step.EmailSubject,
+			EmailContent:          step.EmailContent,
+			DaysAfterPreviousStep: step.DaysAfterPreviousStep,
+			Ordering:              step.Ordering,
+		}
+		if update.EmailSubject != nil {
+			params.EmailSubject = *update.EmailSubject
+		}
+		if update.EmailContent != nil {
+			params.EmailContent = *update.EmailContent
+		}
+		if update.DaysAfterPreviousStep != nil {
+			params.DaysAfterPreviousStep = *update.DaysAfterPreviousStep
+		}
+		if update.Position != nil {
+			params.Ordering, err = placeStep(ctx, q, sequenceID, others, *update.Position)
+			if err != nil {
+				return err
+			}
+		}
+
+		rows, err := q.UpdateSequenceStep(ctx, &params)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return apperr.ErrStepNotFound
+		}
+		if err := checkSteps(ctx, q, sequenceID); err != nil {
+			return err
+		}
+
+		if err := q.IncrementSequenceVersion(ctx, sequenceID); err != nil {
+			return err
+		}
+
+		updated, err = q.GetSequenceStepByID(ctx, &models.GetSequenceStepByIDParams{
+			ID:         stepID,
+			SequenceID: sequenceID,
+		})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -136,10 +649,67 @@ func (s *Service) UpdateSequenceStep(
 }
 
 func (s *Service) DeleteSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID) error {
-	err := models.New(s.db).DeleteSequenceStep(ctx, stepID)
+	return db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if err := lockSequence(ctx, q, sequenceID); err != nil {
+			return err
+		}
+		if err := q.AdvanceEnrollmentsPastStep(ctx, stepID); err != nil {
+			return err
+		}
+
+		rows, err := q.DeleteSequenceStep(ctx, &models.DeleteSequenceStepParams{
+			ID:         stepID,
+			SequenceID: sequenceID,
+		})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return apperr.ErrStepNotFound
+		}
+		if err := checkSteps(ctx, q, sequenceID); err != nil {
+			return err
+		}
+		return q.IncrementSequenceVersion(ctx, sequenceID)
+	})
+}
+
+// checkSteps enforces the rules for the step list of a sequence, which every
+// change of its steps must keep: there is at least one step, and the first
+// step is not delayed. It runs after the change, in the same transaction, so
+// that a breaking change is rolled back.
+func checkSteps(ctx context.Context, q *models.Queries, sequenceID uuid.UUID) error {
+	steps, err := q.GetSequenceStepsBySequenceID(ctx, sequenceID)
 	if err != nil {
 		return err
 	}
+	if len(steps) == 0 {
+		return apperr.Validation(apperr.FieldError{Field: "steps", Message: "must contain at least one step"})
+	}
+	if steps[0].DaysAfterPreviousStep != 0 {
+		return apperr.Validation(apperr.FieldError{Field: "steps[0].daysAfterPreviousStep", Message: "must be 0 for the first step"})
+	}
+	return nil
+}
 
+// lockSequence locks the sequence for a change, which archived sequences do
+// not accept until they are restored.
+func lockSequence(ctx context.Context, q *models.Queries, id uuid.UUID) error {
+	sequence, err := q.LockSequence(ctx, id)
+	if err != nil {
+		return db.NotFound(err, apperr.ErrSequenceNotFound)
+	}
+	if sequence.ArchivedAt.Valid {
+		return apperr.ErrSequenceArchived
+	}
+	return nil
+}
+
+// checkVersion fails with ErrVersionMismatch when the caller expects a version
+// other than the stored one.
+func checkVersion(expected *int32, stored int32) error {
+	if expected != nil && *expected != stored {
+		return apperr.ErrVersionMismatch
+	}
 	return nil
 }
@@ -2,104 +2,46 @@ package sequence
 
 import (
 	"context"
-	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
-	"github.com/go-testfixtures/testfixtures/v3"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
 	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/render"
+	"github.com/pirellik/sequence-api/internal/schedule"
+	"github.com/pirellik/sequence-api/internal/tracking"
 	"github.com/pirellik/sequence-api/pkg/pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/testcontainers/testcontainers-go"
-	"github.com/testcontainers/testcontainers-go/modules/postgres"
-	"github.com/testcontainers/testcontainers-go/wait"
 )
 
-type testDB struct {
-	container *postgres.PostgresContainer
-	pool      *pgxpool.Pool
-}
-
-func setupTestDB(t *testing.T) *testDB {
-	ctx := context.Background()
-
-	pgContainer, err := postgres.Run(ctx,
-		"postgres:17.5",
-		postgres.WithDatabase("testdb"),
-		postgres.WithUsername("test"),
-		postgres.WithPassword("test"),
-		testcontainers.WithWaitStrategy(
-			wait.ForLog("database system is ready to accept connections").
-				WithOccurrence(2).
-				WithStartupTimeout(5*time.Second),
-		),
-	)
-	require.NoError(t, err)
-
-	connString, err := pgContainer.ConnectionString(ctx, "sslmode=disable")
-	require.NoError(t, err)
-
-	pool, err := pgxpool.New(ctx, connString)
-	require.NoError(t, err)
-
-	err = db.MigrateUp(connString)
-	require.NoError(t, err)
-
-	// Setup testfixtures
-	conn, err := pool.Acquire(ctx)
-	require.NoError(t, err)
-	defer conn.Release()
-
-	sqlDB, err := sql.Open("postgres", connString)
-	require.NoError(t, err)
-
-	fixtures, err := testfixtures.New(
-		testfixtures.Database(sqlDB),
-		testfixtures.Dialect("postgres"),
-		testfixtures.Directory("../db/fixtures"),
-	)
-	require.NoError(t, err)
-
-	err = fixtures.Load()
-	require.NoError(t, err)
-
-	return &testDB{
-		container: pgContainer,
-		pool:      pool,
-	}
-}
-
-func (db *testDB) cleanup(t *testing.T) {
-	db.pool.Close()
-	err := db.container.Terminate(context.Background())
-	require.NoError(t, err)
-}
-
 func TestCreateSequence(t *testing.T) {
-	db := setupTestDB(t)
-	defer db.cleanup(t)
+	pool := dbtest.New(t, "../db/fixtures")
 
-	service := NewService(db.pool)
+	service := NewService(pool, nil)
 	ctx := context.Background()
 
-	t.Run("valid sequence without steps", func(t *testing.T) {
-		sequence, steps, err := service.CreateSequence(ctx, &models.Sequence{
-			Name:                 "New Test Sequence",
-			OpenTrackingEnabled:  true,
-			ClickTrackingEnabled: true,
-		}, nil)
+	t.Run("sequence without steps", func(t *testing.T) {
+		_, _, err := service.CreateSequence(ctx, &models.Sequence{Name: "Empty Sequence"}, nil)
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+
+		page, err := service.ListSequences(ctx, ListSequencesParams{Name: pointer.To("Empty Sequence"), Limit: 10})
 		require.NoError(t, err)
-		require.NotNil(t, sequence)
-		assert.Equal(t, "New Test Sequence", sequence.Name)
-		assert.True(t, sequence.OpenTrackingEnabled)
-		assert.True(t, sequence.ClickTrackingEnabled)
-		assert.Empty(t, steps)
+		assert.Empty(t, page.Sequences)
+	})
+
+	t.Run("delayed first step", func(t *testing.T) {
+		_, _, err := service.CreateSequence(ctx, &models.Sequence{Name: "Delayed Sequence"}, []*models.SequenceStep{
+			{EmailSubject: "Step 1", EmailContent: "Content 1", DaysAfterPreviousStep: 1},
+		})
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "steps[0].daysAfterPreviousStep", Message: "must be 0 for the first step"},
+		}, validationErr.Fields)
 	})
 
 	t.Run("valid sequence with steps", func(t *testing.T) {
@@ -111,7 +53,7 @@ func TestCreateSequence(t *testing.T) {
 			{
 				EmailSubject:          "Step 1",
 				EmailContent:          "Content 1",
-				DaysAfterPreviousStep: 1,
+				DaysAfterPreviousStep: 0,
 			},
 			{
 				EmailSubject:          "Step 2",
@@ -130,57 +72,220 @@ func TestCreateSequence(t *testing.T) {
 		assert.Equal(t, "Content 1", steps[0].EmailContent)
 		assert.Equal(t, "Content 2", steps[1].EmailContent)
 	})
+
+	t.Run("failing step rolls back the sequence", func(t *testing.T) {
+		_, _, err := service.CreateSequence(ctx, &models.Sequence{
+			Name: "Partial Sequence",
+		}, []*models.SequenceStep{
+			{
+				EmailSubject:          "Step 1",
+				EmailContent:          "Content 1",
+				DaysAfterPreviousStep: 0,
+			},
+			{
+				EmailSubject:          strings.Repeat("x", 256),
+				EmailContent:          "Content 2",
+				DaysAfterPreviousStep: 1,
+			},
+		})
+		require.Error(t, err)
+
+		page, err := service.ListSequences(ctx, ListSequencesParams{Name: pointer.To("Partial"), Limit: 10})
+		require.NoError(t, err)
+		assert.Empty(t, page.Sequences)
+	})
+}
+
+func TestGetSequence(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+
+	t.Run("existing sequence", func(t *testing.T) {
+		sequence, steps, err := service.GetSequence(ctx, uuid.MustParse("00000000-0000-0000-0000-000000000001"))
+		require.NoError(t, err)
+		assert.Equal(t, "Test Sequence", sequence.Name)
+		require.Len(t, steps, 2)
+		assert.Equal(t, "Initial Subject", steps[0].EmailSubject)
+		assert.Equal(t, "Second Subject", steps[1].EmailSubject)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, _, err := service.GetSequence(ctx, uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestListSequences(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+
+	t.Run("sorted by name", func(t *testing.T) {
+		page, err := service.ListSequences(ctx, ListSequencesParams{Sort: SortByName, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, page.Sequences, 2)
+		assert.Equal(t, "Another Test Sequence", page.Sequences[0].Name)
+		assert.Equal(t, "Test Sequence", page.Sequences[1].Name)
+		assert.Len(t, page.Steps[page.Sequences[1].ID], 2)
+		assert.Empty(t, page.Steps[page.Sequences[0].ID])
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("paginates with cursor", func(t *testing.T) {
+		params := ListSequencesParams{Sort: SortByCreatedAt, Descending: true, Limit: 1}
+
+		first, err := service.ListSequences(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, first.Sequences, 1)
+		require.NotNil(t, first.NextCursor)
+
+		params.Cursor = first.NextCursor
+		second, err := service.ListSequences(ctx, params)
+		require.NoError(t, err)
+		require.Len(t, second.Sequences, 1)
+		assert.NotEqual(t, first.Sequences[0].ID, second.Sequences[0].ID)
+		assert.Nil(t, second.NextCursor)
+	})
+
+	t.Run("filters by name", func(t *testing.T) {
+		page, err := service.ListSequences(ctx, ListSequencesParams{Name: pointer.To("another"), Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, page.Sequences, 1)
+		assert.Equal(t, "Another Test Sequence", page.Sequences[0].Name)
+	})
+
+	t.Run("rejects cursor issued for another sort", func(t *testing.T) {
+		page, err := service.ListSequences(ctx, ListSequencesParams{Sort: SortByName, Limit: 1})
+		require.NoError(t, err)
+		require.NotNil(t, page.NextCursor)
+
+		_, err = service.ListSequences(ctx, ListSequencesParams{Sort: SortByCreatedAt, Cursor: page.NextCursor, Limit: 1})
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestDeleteSequence(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	t.Run("archive hides sequence from listing", func(t *testing.T) {
+		err := service.DeleteSequence(ctx, sequenceID, false)
+		require.NoError(t, err)
+
+		archived, steps, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.True(t, archived.ArchivedAt.Valid)
+		assert.Len(t, steps, 2)
+
+		page, err := service.ListSequences(ctx, ListSequencesParams{Limit: 10})
+		require.NoError(t, err)
+		for _, sequence := range page.Sequences {
+			assert.NotEqual(t, sequenceID, sequence.ID)
+		}
+	})
+
+	t.Run("archived sequence rejects changes", func(t *testing.T) {
+		_, _, err := service.UpdateSequence(ctx, sequenceID, SequenceUpdate{
+			Name: pointer.To("Renamed"),
+		})
+		assert.ErrorIs(t, err, apperr.ErrSequenceArchived)
+
+		_, err = service.CreateSequenceStep(ctx, sequenceID, &models.SequenceStep{
+			EmailSubject: "Subject",
+			EmailContent: "Content",
+		}, Position{})
+		assert.ErrorIs(t, err, apperr.ErrSequenceArchived)
+
+		err = service.DeleteSequenceStep(ctx, sequenceID, uuid.MustParse("00000000-0000-0000-0000-000000000004"))
+		assert.ErrorIs(t, err, apperr.ErrSequenceArchived)
+
+		_, steps, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Len(t, steps, 2)
+	})
+
+	t.Run("restore brings sequence back", func(t *testing.T) {
+		restored, steps, err := service.RestoreSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.False(t, restored.ArchivedAt.Valid)
+		assert.Len(t, steps, 2)
+	})
+
+	t.Run("hard delete removes steps", func(t *testing.T) {
+		err := service.DeleteSequence(ctx, sequenceID, true)
+		require.NoError(t, err)
+
+		_, _, err = service.GetSequence(ctx, sequenceID)
+		assert.Error(t, err)
+
+		steps, err := models.New(pool).GetSequenceStepsBySequenceID(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Empty(t, steps)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		assert.Error(t, service.DeleteSequence(ctx, uuid.New(), false))
+		assert.Error(t, service.DeleteSequence(ctx, uuid.New(), true))
+
+		_, _, err := service.RestoreSequence(ctx, uuid.New())
+		assert.Error(t, err)
+	})
 }
 
 func TestUpdateSequence(t *testing.T) {
-	db := setupTestDB(t)
-	defer db.cleanup(t)
+	pool := dbtest.New(t, "../db/fixtures")
 
-	service := NewService(db.pool)
+	service := NewService(pool, nil)
 	ctx := context.Background()
 
 	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
 
 	tests := []struct {
-		name                 string
-		sequenceID           uuid.UUID
-		openTrackingEnabled  *bool
-		clickTrackingEnabled *bool
-		wantErr              bool
+		name       string
+		sequenceID uuid.UUID
+		update     SequenceUpdate
+		wantErr    bool
 	}{
 		{
-			name:                 "update open tracking",
-			sequenceID:           sequenceID,
-			openTrackingEnabled:  pointer.To(false),
-			clickTrackingEnabled: nil,
-			wantErr:              false,
+			name:       "update open tracking",
+			sequenceID: sequenceID,
+			update:     SequenceUpdate{OpenTrackingEnabled: pointer.To(false)},
 		},
 		{
-			name:                 "update click tracking",
-			sequenceID:           sequenceID,
-			openTrackingEnabled:  nil,
-			clickTrackingEnabled: pointer.To(false),
-			wantErr:              false,
+			name:       "update click tracking",
+			sequenceID: sequenceID,
+			update:     SequenceUpdate{ClickTrackingEnabled: pointer.To(false)},
 		},
 		{
-			name:                 "update both",
-			sequenceID:           sequenceID,
-			openTrackingEnabled:  pointer.To(false),
-			clickTrackingEnabled: pointer.To(false),
-			wantErr:              false,
+			name:       "update both",
+			sequenceID: sequenceID,
+			update: SequenceUpdate{
+				OpenTrackingEnabled:  pointer.To(false),
+				ClickTrackingEnabled: pointer.To(false),
+			},
 		},
 		{
-			name:                 "not found",
-			sequenceID:           uuid.New(),
-			openTrackingEnabled:  nil,
-			clickTrackingEnabled: nil,
-			wantErr:              true,
+			name:       "rename",
+			sequenceID: sequenceID,
+			update:     SequenceUpdate{Name: pointer.To("Renamed Sequence")},
+		},
+		{
+			name:       "not found",
+			sequenceID: uuid.New(),
+			wantErr:    true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			updated, _, err := service.UpdateSequence(ctx, tt.sequenceID, tt.openTrackingEnabled, tt.clickTrackingEnabled)
+			updated, _, err := service.UpdateSequence(ctx, tt.sequenceID, tt.update)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -189,92 +294,806 @@ func TestUpdateSequence(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, updated)
 
-			if tt.openTrackingEnabled != nil {
-				assert.Equal(t, *tt.openTrackingEnabled, updated.OpenTrackingEnabled)
+			if tt.update.Name != nil {
+				assert.Equal(t, *tt.update.Name, updated.Name)
+			}
+			if tt.update.OpenTrackingEnabled != nil {
+				assert.Equal(t, *tt.update.OpenTrackingEnabled, updated.OpenTrackingEnabled)
 			}
-			if tt.clickTrackingEnabled != nil {
-				assert.Equal(t, *tt.clickTrackingEnabled, updated.ClickTrackingEnabled)
+			if tt.update.ClickTrackingEnabled != nil {
+				assert.Equal(t, *tt.update.ClickTrackingEnabled, updated.ClickTrackingEnabled)
 			}
 		})
 	}
 }
 
+func TestUpdateSequenceSteps(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	firstStepID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	secondStepID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	t.Run("rejects foreign and repeated step IDs", func(t *testing.T) {
+		_, _, err := service.UpdateSequence(ctx, sequenceID, SequenceUpdate{
+			Name: pointer.To("Not Renamed"),
+			Steps: []*models.SequenceStep{
+				{ID: uuid.New(), EmailSubject: "Subject", EmailContent: "Content"},
+				{ID: firstStepID, EmailSubject: "Subject", EmailContent: "Content"},
+				{ID: firstStepID, EmailSubject: "Subject", EmailContent: "Content"},
+			},
+		})
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "steps[0].id", Message: "must reference a step of the sequence"},
+			{Field: "steps[2].id", Message: "must not be repeated"},
+		}, validationErr.Fields)
+
+		sequence, steps, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, "Test Sequence", sequence.Name)
+		assert.Len(t, steps, 2)
+	})
+
+	t.Run("rejects a delayed first step", func(t *testing.T) {
+		_, _, err := service.UpdateSequence(ctx, sequenceID, SequenceUpdate{
+			Steps: []*models.SequenceStep{
+				{ID: secondStepID, EmailSubject: "Second", EmailContent: "Content", DaysAfterPreviousStep: 2},
+				{ID: firstStepID, EmailSubject: "First", EmailContent: "Content"},
+			},
+		})
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+
+		_, steps, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, firstStepID, steps[0].ID, "the change is rolled back")
+	})
+
+	t.Run("updates, inserts and deletes steps", func(t *testing.T) {
+		_, steps, err := service.UpdateSequence(ctx, sequenceID, SequenceUpdate{
+			Steps: []*models.SequenceStep{
+				{EmailSubject: "New First", EmailContent: "New Content"},
+				{ID: secondStepID, EmailSubject: "Edited", EmailContent: "Edited Content", DaysAfterPreviousStep: 4},
+				{EmailSubject: "New Last", EmailContent: "New Content", DaysAfterPreviousStep: 1},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, steps, 3)
+
+		assert.Equal(t, "New First", steps[0].EmailSubject)
+		assert.Equal(t, secondStepID, steps[1].ID)
+		assert.Equal(t, "Edited", steps[1].EmailSubject)
+		assert.Equal(t, int32(4), steps[1].DaysAfterPreviousStep)
+		assert.Equal(t, "New Last", steps[2].EmailSubject)
+
+		for _, step := range steps {
+			assert.NotEqual(t, firstStepID, step.ID)
+		}
+	})
+}
+
+func TestCreateSequenceStep(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	firstStepID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	secondStepID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	newStep := func(subject string) *models.SequenceStep {
+		return &models.SequenceStep{
+			EmailSubject:          subject,
+			EmailContent:          "Content",
+			DaysAfterPreviousStep: 1,
+		}
+	}
+
+	subjects := func(t *testing.T) []string {
+		_, steps, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		result := make([]string, len(steps))
+		for i, step := range steps {
+			result[i] = step.EmailSubject
+		}
+		return result
+	}
+
+	t.Run("append", func(t *testing.T) {
+		created, err := service.CreateSequenceStep(ctx, sequenceID, newStep("Last"), Position{})
+		require.NoError(t, err)
+		assert.Equal(t, sequenceID, created.SequenceID)
+		assert.Equal(t, []string{"Initial Subject", "Second Subject", "Last"}, subjects(t))
+	})
+
+	t.Run("insert between", func(t *testing.T) {
+		_, err := service.CreateSequenceStep(ctx, sequenceID, newStep("Middle"), Position{
+			AfterStepID:  &firstStepID,
+			BeforeStepID: &secondStepID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Initial Subject", "Middle", "Second Subject", "Last"}, subjects(t))
+	})
+
+	t.Run("insert first", func(t *testing.T) {
+		_, err := service.CreateSequenceStep(ctx, sequenceID, newStep("Delayed"), Position{BeforeStepID: &firstStepID})
+		assert.ErrorIs(t, err, apperr.ErrValidation, "the first step must not be delayed")
+
+		first := newStep("First")
+		first.DaysAfterPreviousStep = 0
+		_, err = service.CreateSequenceStep(ctx, sequenceID, first, Position{BeforeStepID: &firstStepID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"First", "Initial Subject", "Middle", "Second Subject", "Last"}, subjects(t))
+	})
+
+	t.Run("renumbers when precision runs out", func(t *testing.T) {
+		// Halving the gap between 1 and 2 exhausts float32 precision well
+		// before 40 inserts.
+		for range 40 {
+			_, err := service.CreateSequenceStep(ctx, sequenceID, newStep("Crowded"), Position{AfterStepID: &secondStepID})
+			require.NoError(t, err)
+		}
+
+		got := subjects(t)
+		require.Len(t, got, 45)
+		assert.Equal(t, "Second Subject", got[3])
+		assert.Equal(t, "Crowded", got[4])
+		assert.Equal(t, "Last", got[len(got)-1])
+
+		_, steps, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		for i := 1; i < len(steps); i++ {
+			assert.Less(t, steps[i-1].Ordering, steps[i].Ordering)
+		}
+	})
+
+	t.Run("unknown anchor step", func(t *testing.T) {
+		_, err := service.CreateSequenceStep(ctx, sequenceID, newStep("Orphan"), Position{AfterStepID: pointer.To(uuid.New())})
+		assert.ErrorIs(t, err, ErrInvalidPosition)
+	})
+
+	t.Run("sequence not found", func(t *testing.T) {
+		_, err := service.CreateSequenceStep(ctx, uuid.New(), newStep("Orphan"), Position{})
+		assert.Error(t, err)
+	})
+}
+
+func TestMoveSequenceStep(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	firstStepID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	secondStepID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	t.Run("move to front", func(t *testing.T) {
+		_, _, err := service.MoveSequenceStep(ctx, sequenceID, secondStepID, Position{BeforeStepID: &firstStepID})
+		assert.ErrorIs(t, err, apperr.ErrValidation, "the second step is delayed")
+
+		_, err = service.UpdateSequenceStep(ctx, sequenceID, secondStepID, StepUpdate{DaysAfterPreviousStep: pointer.To(int32(0))})
+		require.NoError(t, err)
+
+		_, steps, err := service.MoveSequenceStep(ctx, sequenceID, secondStepID, Position{BeforeStepID: &firstStepID})
+		require.NoError(t, err)
+		require.Len(t, steps, 2)
+		assert.Equal(t, secondStepID, steps[0].ID)
+		assert.Equal(t, firstStepID, steps[1].ID)
+	})
+
+	t.Run("move to end", func(t *testing.T) {
+		_, steps, err := service.MoveSequenceStep(ctx, sequenceID, secondStepID, Position{})
+		require.NoError(t, err)
+		require.Len(t, steps, 2)
+		assert.Equal(t, firstStepID, steps[0].ID)
+		assert.Equal(t, secondStepID, steps[1].ID)
+	})
+
+	t.Run("relative to itself", func(t *testing.T) {
+		_, _, err := service.MoveSequenceStep(ctx, sequenceID, secondStepID, Position{AfterStepID: &secondStepID})
+		assert.ErrorIs(t, err, ErrInvalidPosition)
+	})
+
+	t.Run("step not found", func(t *testing.T) {
+		_, _, err := service.MoveSequenceStep(ctx, sequenceID, uuid.New(), Position{})
+		assert.Error(t, err)
+	})
+}
+
 func TestUpdateSequenceStep(t *testing.T) {
-	db := setupTestDB(t)
-	defer db.cleanup(t)
+	pool := dbtest.New(t, "../db/fixtures")
 
-	service := NewService(db.pool)
+	service := NewService(pool, nil)
 	ctx := context.Background()
 
 	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherSequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
 	stepID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	secondStepID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
 
 	tests := []struct {
-		name         string
-		stepID       uuid.UUID
-		emailSubject *string
-		emailContent *string
-		wantErr      bool
+		name       string
+		sequenceID uuid.UUID
+		stepID     uuid.UUID
+		update     StepUpdate
+		wantErr    error
 	}{
 		{
-			name:         "update subject",
-			stepID:       stepID,
-			emailSubject: pointer.To("New Subject"),
-			emailContent: nil,
-			wantErr:      false,
+			name:       "update subject",
+			sequenceID: sequenceID,
+			stepID:     stepID,
+			update:     StepUpdate{EmailSubject: pointer.To("New Subject")},
 		},
 		{
-			name:         "update content",
-			stepID:       stepID,
-			emailSubject: nil,
-			emailContent: pointer.To("New Content"),
-			wantErr:      false,
+			name:       "update content",
+			sequenceID: sequenceID,
+			stepID:     stepID,
+			update:     StepUpdate{EmailContent: pointer.To("New Content")},
 		},
 		{
-			name:         "not found",
-			stepID:       uuid.New(),
-			emailSubject: nil,
-			emailContent: nil,
-			wantErr:      true,
+			name:       "update delay",
+			sequenceID: sequenceID,
+			stepID:     secondStepID,
+			update:     StepUpdate{DaysAfterPreviousStep: pointer.To(int32(7))},
+		},
+		{
+			name:       "delay the first step",
+			sequenceID: sequenceID,
+			stepID:     stepID,
+			update:     StepUpdate{DaysAfterPreviousStep: pointer.To(int32(3))},
+			wantErr:    apperr.ErrValidation,
+		},
+		{
+			name:       "replace and move",
+			sequenceID: sequenceID,
+			stepID:     secondStepID,
+			update: StepUpdate{
+				EmailSubject:          pointer.To("Replaced Subject"),
+				EmailContent:          pointer.To("Replaced Content"),
+				DaysAfterPreviousStep: pointer.To(int32(0)),
+				Position:              &Position{BeforeStepID: &stepID},
+			},
+		},
+		{
+			name:       "invalid position",
+			sequenceID: sequenceID,
+			stepID:     stepID,
+			update:     StepUpdate{Position: &Position{AfterStepID: pointer.To(uuid.New())}},
+			wantErr:    ErrInvalidPosition,
+		},
+		{
+			name:       "step of another sequence",
+			sequenceID: otherSequenceID,
+			stepID:     stepID,
+			update:     StepUpdate{EmailSubject: pointer.To("Hijacked Subject")},
+			wantErr:    apperr.ErrStepNotFound,
+		},
+		{
+			name:       "not found",
+			sequenceID: sequenceID,
+			stepID:     uuid.New(),
+			wantErr:    apperr.ErrStepNotFound,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			updated, err := service.UpdateSequenceStep(ctx, sequenceID, tt.stepID, tt.emailSubject, tt.emailContent)
-			if tt.wantErr {
-				assert.Error(t, err)
+			_, before, err := service.GetSequence(ctx, tt.sequenceID)
+			require.NoError(t, err)
+
+			updated, err := service.UpdateSequenceStep(ctx, tt.sequenceID, tt.stepID, tt.update)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
 				return
 			}
 
-			assert.NoError(t, err)
-			assert.NotNil(t, updated)
+			require.NoError(t, err)
+			require.NotNil(t, updated)
 
-			if tt.emailSubject != nil {
-				assert.Equal(t, *tt.emailSubject, updated.EmailSubject)
+			if tt.update.EmailSubject != nil {
+				assert.Equal(t, *tt.update.EmailSubject, updated.EmailSubject)
+			}
+			if tt.update.EmailContent != nil {
+				assert.Equal(t, *tt.update.EmailContent, updated.EmailContent)
 			}
-			if tt.emailContent != nil {
-				assert.Equal(t, *tt.emailContent, updated.EmailContent)
+			if tt.update.DaysAfterPreviousStep != nil {
+				assert.Equal(t, *tt.update.DaysAfterPreviousStep, updated.DaysAfterPreviousStep)
+			}
+
+			_, after, err := service.GetSequence(ctx, tt.sequenceID)
+			require.NoError(t, err)
+			require.Len(t, after, len(before))
+			if tt.update.Position == nil {
+				for i := range before {
+					assert.Equal(t, before[i].ID, after[i].ID)
+				}
+			} else {
+				assert.Equal(t, tt.stepID, after[0].ID)
 			}
 		})
 	}
 }
 
 func TestDeleteSequenceStep(t *testing.T) {
-	db := setupTestDB(t)
-	defer db.cleanup(t)
+	pool := dbtest.New(t, "../db/fixtures")
 
-	service := NewService(db.pool)
+	service := NewService(pool, nil)
 	ctx := context.Background()
 
 	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherSequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
 	stepID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	secondStepID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	q := models.New(pool)
+
+	t.Run("step of another sequence", func(t *testing.T) {
+		err := service.DeleteSequenceStep(ctx, otherSequenceID, stepID)
+		assert.Error(t, err)
+
+		// Verify step was left untouched
+		_, err = q.GetSequenceStepByID(ctx, &models.GetSequenceStepByIDParams{ID: stepID, SequenceID: sequenceID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("leaving a delayed first step", func(t *testing.T) {
+		err := service.DeleteSequenceStep(ctx, sequenceID, stepID)
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+
+		_, err = q.GetSequenceStepByID(ctx, &models.GetSequenceStepByIDParams{ID: stepID, SequenceID: sequenceID})
+		assert.NoError(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		err := service.DeleteSequenceStep(ctx, sequenceID, secondStepID)
+		require.NoError(t, err)
+
+		// Verify step was deleted
+		_, err = q.GetSequenceStepByID(ctx, &models.GetSequenceStepByIDParams{ID: secondStepID, SequenceID: sequenceID})
+		assert.Error(t, err) // Should get an error as the step no longer exists
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		err := service.DeleteSequenceStep(ctx, sequenceID, secondStepID)
+		assert.Error(t, err)
+	})
+
+	t.Run("only step", func(t *testing.T) {
+		err := service.DeleteSequenceStep(ctx, sequenceID, stepID)
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+	})
+}
+
+func TestVersionCheck(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	stepID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	t.Run("step update", func(t *testing.T) {
+		updated, err := service.UpdateSequenceStep(ctx, sequenceID, stepID, StepUpdate{
+			EmailSubject: pointer.To("First Writer"),
+			Version:      pointer.To(int32(1)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), updated.Version)
+
+		_, err = service.UpdateSequenceStep(ctx, sequenceID, stepID, StepUpdate{
+			EmailSubject: pointer.To("Second Writer"),
+			Version:      pointer.To(int32(1)),
+		})
+		assert.ErrorIs(t, err, apperr.ErrVersionMismatch)
+
+		_, steps, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, "First Writer", steps[0].EmailSubject)
+	})
+
+	t.Run("step changes bump the sequence version", func(t *testing.T) {
+		sequence, _, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), sequence.Version)
+
+		_, _, err = service.UpdateSequence(ctx, sequenceID, SequenceUpdate{
+			Name:    pointer.To("Stale Name"),
+			Version: pointer.To(int32(1)),
+		})
+		assert.ErrorIs(t, err, apperr.ErrVersionMismatch)
 
-	err := service.DeleteSequenceStep(ctx, sequenceID, stepID)
+		updated, _, err := service.UpdateSequence(ctx, sequenceID, SequenceUpdate{
+			Name:    pointer.To("Fresh Name"),
+			Version: pointer.To(int32(2)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), updated.Version)
+	})
+
+	t.Run("moves and schedules bump versions", func(t *testing.T) {
+		secondStepID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+		_, err := service.UpdateSequenceStep(ctx, sequenceID, secondStepID, StepUpdate{DaysAfterPreviousStep: pointer.To(int32(0))})
+		require.NoError(t, err)
+
+		_, steps, err := service.MoveSequenceStep(ctx, sequenceID, secondStepID, Position{BeforeStepID: &stepID})
+		require.NoError(t, err)
+		assert.Equal(t, secondStepID, steps[0].ID)
+		assert.Equal(t, int32(3), steps[0].Version)
+
+		_, err = service.SetSendSchedule(ctx, sequenceID, SendSchedule{TimeZone: "UTC", Weekdays: []time.Weekday{time.Monday}, EndHour: 24})
+		require.NoError(t, err)
+		sequence, _, err := service.GetSequence(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(6), sequence.Version)
+	})
+}
+
+func TestNotFound(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	otherSequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	stepID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	missingID := uuid.New()
+
+	step := &models.SequenceStep{EmailSubject: "Subject", EmailContent: "Content"}
+
+	tests := []struct {
+		name    string
+		call    func() error
+		wantErr error
+	}{
+		{
+			name: "get sequence",
+			call: func() error {
+				_, _, err := service.GetSequence(ctx, missingID)
+				return err
+			},
+			wantErr: apperr.ErrSequenceNotFound,
+		},
+		{
+			name: "update sequence",
+			call: func() error {
+				_, _, err := service.UpdateSequence(ctx, missingID, SequenceUpdate{OpenTrackingEnabled: pointer.To(true)})
+				return err
+			},
+			wantErr: apperr.ErrSequenceNotFound,
+		},
+		{
+			name: "archive sequence",
+			call: func() error {
+				return service.DeleteSequence(ctx, missingID, false)
+			},
+			wantErr: apperr.ErrSequenceNotFound,
+		},
+		{
+			name: "hard delete sequence",
+			call: func() error {
+				return service.DeleteSequence(ctx, missingID, true)
+			},
+			wantErr: apperr.ErrSequenceNotFound,
+		},
+		{
+			name: "restore sequence",
+			call: func() error {
+				_, _, err := service.RestoreSequence(ctx, missingID)
+				return err
+			},
+			wantErr: apperr.ErrSequenceNotFound,
+		},
+		{
+			name: "create step in missing sequence",
+			call: func() error {
+				_, err := service.CreateSequenceStep(ctx, missingID, step, Position{})
+				return err
+			},
+			wantErr: apperr.ErrSequenceNotFound,
+		},
+		{
+			name: "move step in missing sequence",
+			call: func() error {
+				_, _, err := service.MoveSequenceStep(ctx, missingID, stepID, Position{})
+				return err
+			},
+			wantErr: apperr.ErrSequenceNotFound,
+		},
+		{
+			name: "move missing step",
+			call: func() error {
+				_, _, err := service.MoveSequenceStep(ctx, sequenceID, missingID, Position{})
+				return err
+			},
+			wantErr: apperr.ErrStepNotFound,
+		},
+		{
+			name: "move step of another sequence",
+			call: func() error {
+				_, _, err := service.MoveSequenceStep(ctx, otherSequenceID, stepID, Position{})
+				return err
+			},
+			wantErr: apperr.ErrStepNotFound,
+		},
+		{
+			name: "update missing step",
+			call: func() error {
+				_, err := service.UpdateSequenceStep(ctx, sequenceID, missingID, StepUpdate{EmailSubject: pointer.To("Subject")})
+				return err
+			},
+			wantErr: apperr.ErrStepNotFound,
+		},
+		{
+			name: "update step of another sequence",
+			call: func() error {
+				_, err := service.UpdateSequenceStep(ctx, otherSequenceID, stepID, StepUpdate{EmailSubject: pointer.To("Subject")})
+				return err
+			},
+			wantErr: apperr.ErrStepNotFound,
+		},
+		{
+			name: "delete missing step",
+			call: func() error {
+				return service.DeleteSequenceStep(ctx, sequenceID, missingID)
+			},
+			wantErr: apperr.ErrStepNotFound,
+		},
+		{
+			name: "delete step of another sequence",
+			call: func() error {
+				return service.DeleteSequenceStep(ctx, otherSequenceID, stepID)
+			},
+			wantErr: apperr.ErrStepNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.call(), tt.wantErr)
+		})
+	}
+}
+
+func TestSendSchedule(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+	t.Run("defaults to sending anytime in UTC", func(t *testing.T) {
+		got, err := service.GetSendSchedule(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, &SendSchedule{
+			TimeZone: "UTC",
+			Weekdays: []time.Weekday{
+				time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+			},
+			EndHour:  24,
+			Holidays: []schedule.Date{},
+		}, got)
+	})
+
+	t.Run("replaces the schedule", func(t *testing.T) {
+		want := SendSchedule{
+			TimeZone:           "Europe/Berlin",
+			Weekdays:           []time.Weekday{time.Monday, time.Wednesday},
+			StartHour:          9,
+			EndHour:            17,
+			Holidays:           []schedule.Date{{Year: 2025, Month: time.December, Day: 25}},
+			UseContactTimeZone: true,
+		}
+		got, err := service.SetSendSchedule(ctx, sequenceID, want)
+		require.NoError(t, err)
+		assert.Equal(t, &want, got)
+
+		got, err = service.GetSendSchedule(ctx, sequenceID)
+		require.NoError(t, err)
+		assert.Equal(t, &want, got)
+	})
+
+	t.Run("unknown sequence", func(t *testing.T) {
+		_, err := service.SetSendSchedule(ctx, uuid.New(), SendSchedule{TimeZone: "UTC", EndHour: 24})
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+
+		_, err = service.GetSendSchedule(ctx, uuid.New())
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
+}
+
+func TestPreviewStep(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	stepID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	janeID := uuid.MustParse("00000000-0000-0000-0000-000000000010")
+
+	_, err := pool.Exec(ctx, `UPDATE sequence_steps SET email_subject = $1, email_content = $2 WHERE id = $3`,
+		"{{sequence.name}} for {{custom.company}}",
+		`<p>Hi {{contact.firstName}},</p><p><a href="https://example.com">Visit us</a></p><p>{{sender.name}}</p>`,
+		stepID)
 	require.NoError(t, err)
 
-	// Verify step was deleted
-	q := models.New(db.pool)
-	_, err = q.GetSequenceStepByID(ctx, stepID)
-	assert.Error(t, err) // Should get an error as the step no longer exists
+	t.Run("renders the step for a contact", func(t *testing.T) {
+		email, err := service.PreviewStep(ctx, sequenceID, stepID, Preview{ContactID: &janeID})
+		require.NoError(t, err)
+		assert.Equal(t, "Test Sequence for Acme", email.Subject)
+		assert.Equal(t, `<p>Hi Jane,</p><p><a href="https://example.com">Visit us</a></p><p></p>`, email.HTML)
+		assert.Equal(t, "Hi Jane,\n\nVisit us (https://example.com)", email.Text)
+		assert.Equal(t, []render.Link{{URL: "https://example.com"}}, email.Links)
+		assert.Equal(t, []string{"sender.name"}, email.Missing, "the sequence has no mailboxes")
+	})
+
+	t.Run("variables override the contact", func(t *testing.T) {
+		email, err := service.PreviewStep(ctx, sequenceID, stepID, Preview{
+			ContactID: &janeID,
+			Variables: map[string]any{"custom.company": "Globex", "sender.name": "Sales"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Test Sequence for Globex", email.Subject)
+		assert.Empty(t, email.Missing)
+	})
+
+	t.Run("renders without a contact", func(t *testing.T) {
+		_, err := pool.Exec(ctx, `INSERT INTO sequence_mailboxes (sequence_id, mailbox_id) VALUES ($1, '00000000-0000-0000-0000-000000000020')`, sequenceID)
+		require.NoError(t, err)
+
+		email, err := service.PreviewStep(ctx, sequenceID, stepID, Preview{})
+		require.NoError(t, err)
+		assert.Equal(t, "Test Sequence for ", email.Subject)
+		assert.Contains(t, email.HTML, "<p>Sales</p>", "the sender is a mailbox of the sequence")
+		assert.Equal(t, []string{"custom.company", "contact.firstName"}, email.Missing)
+	})
+
+	t.Run("tracks links of sequences that track clicks", func(t *testing.T) {
+		signer, err := tracking.NewSigner("LPngzWfXY0Ja7l1Ba4S7fmRbqgmtsCscytI3K2z88ZA=")
+		require.NoError(t, err)
+		urls := &tracking.URLs{BaseURL: "https://api.example.com", Signer: signer}
+
+		email, err := NewService(pool, urls).PreviewStep(ctx, sequenceID, stepID, Preview{ContactID: &janeID})
+		require.NoError(t, err)
+		tracked := urls.Click(uuid.Nil, "https://example.com")
+		assert.Equal(t, []render.Link{{URL: "https://example.com", TrackingURL: tracked}}, email.Links)
+		assert.Contains(t, email.HTML, `href="`+tracked+`"`)
+	})
+
+	t.Run("unknown contact", func(t *testing.T) {
+		id := uuid.New()
+		_, err := service.PreviewStep(ctx, sequenceID, stepID, Preview{ContactID: &id})
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+	})
+
+	t.Run("unknown step", func(t *testing.T) {
+		_, err := service.PreviewStep(ctx, sequenceID, uuid.New(), Preview{})
+		assert.ErrorIs(t, err, apperr.ErrStepNotFound)
+	})
+
+	t.Run("unknown sequence", func(t *testing.T) {
+		_, err := service.PreviewStep(ctx, uuid.New(), stepID, Preview{})
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
+}
+
+func TestGetStats(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+
+	service := NewService(pool, nil)
+	ctx := context.Background()
+	sequenceID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	step1 := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	step2 := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+	day := time.Date(2025, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	enrollments := map[string]uuid.UUID{}
+	for name, contactID := range map[string]string{
+		"jane": "00000000-0000-0000-0000-000000000010",
+		"john": "00000000-0000-0000-0000-000000000011",
+	} {
+		var id uuid.UUID
+		err := pool.QueryRow(ctx, `INSERT INTO enrollments (sequence_id, contact_id) VALUES ($1, $2) RETURNING id`,
+			sequenceID, contactID).Scan(&id)
+		require.NoError(t, err)
+		enrollments[name] = id
+	}
+	createJob := func(enrollmentID, stepID uuid.UUID) uuid.UUID {
+		var id uuid.UUID
+		err := pool.QueryRow(ctx, `
+			INSERT INTO send_jobs (enrollment_id, step_id, email_subject, email_content, scheduled_at)
+			VALUES ($1, $2, 'Hi', '<p>Hi</p>', $3) RETURNING id`,
+			enrollmentID, stepID, day).Scan(&id)
+		require.NoError(t, err)
+		return id
+	}
+	exec := func(sql string, args ...any) {
+		_, err := pool.Exec(ctx, sql, args...)
+		require.NoError(t, err)
+	}
+
+	// Jane gets the first step on the second attempt, opens it twice and
+	// clicks a link. Apple Mail Privacy Protection opens it as well.
+	delivered := createJob(enrollments["jane"], step1)
+	exec(`INSERT INTO sends (send_job_id, attempt, status, error, started_at, finished_at) VALUES ($1, 1, 'failed', 'timeout', $2, $2)`, delivered, day)
+	exec(`INSERT INTO sends (send_job_id, attempt, status, started_at, finished_at) VALUES ($1, 2, 'sent', $2, $2)`, delivered, day.Add(time.Hour))
+	for _, event := range []struct {
+		typ, automated string
+	}{{"open", ""}, {"open", ""}, {"open", "apple_mpp"}, {"click", ""}} {
+		exec(`INSERT INTO tracking_events (send_job_id, type, automated, created_at) VALUES ($1, $2, $3, $4)`,
+			delivered, event.typ, event.automated, day.Add(24*time.Hour))
+	}
+
+	// The first step bounces for John.
+	bounced := createJob(enrollments["john"], step1)
+	exec(`INSERT INTO sends (send_job_id, attempt, status, error, started_at, finished_at) VALUES ($1, 1, 'failed', '550 no such user', $2, $2)`, bounced, day)
+	exec(`INSERT INTO dead_letters (send_job_id, attempts, error, created_at) VALUES ($1, 1, '550 no such user', $2)`, bounced, day)
+
+	// Jane gets the second step two days later.
+	later := createJob(enrollments["jane"], step2)
+	exec(`INSERT INTO sends (send_job_id, attempt, status, started_at, finished_at) VALUES ($1, 1, 'sent', $2, $2)`, later, day.Add(48*time.Hour))
+
+	// John's second step has not been sent yet, so it counts nowhere.
+	createJob(enrollments["john"], step2)
+
+	t.Run("counts everything", func(t *testing.T) {
+		stats, err := service.GetStats(ctx, sequenceID, StatsFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, &Stats{
+			Total: Counts{Sent: 3, Delivered: 2, Bounced: 1, Opened: 1, Opens: 2, Clicked: 1, Clicks: 1},
+			Steps: []StepStats{
+				{StepID: step1, Counts: Counts{Sent: 2, Delivered: 1, Bounced: 1, Opened: 1, Opens: 2, Clicked: 1, Clicks: 1}},
+				{StepID: step2, Counts: Counts{Sent: 1, Delivered: 1}},
+			},
+		}, stats)
+	})
+
+	t.Run("includes automated events", func(t *testing.T) {
+		stats, err := service.GetStats(ctx, sequenceID, StatsFilter{IncludeAutomated: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), stats.Total.Opened)
+		assert.Equal(t, int64(3), stats.Total.Opens)
+	})
+
+	t.Run("filters by time", func(t *testing.T) {
+		stats, err := service.GetStats(ctx, sequenceID, StatsFilter{
+			From: pointer.To(day.Add(12 * time.Hour)),
+			To:   pointer.To(day.Add(36 * time.Hour)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &Stats{
+			Total: Counts{Opened: 1, Opens: 2, Clicked: 1, Clicks: 1},
+			Steps: []StepStats{
+				{StepID: step1, Counts: Counts{Opened: 1, Opens: 2, Clicked: 1, Clicks: 1}},
+				{StepID: step2},
+			},
+		}, stats)
+	})
+
+	t.Run("counts deleted steps in the total", func(t *testing.T) {
+		require.NoError(t, service.DeleteSequenceStep(ctx, sequenceID, step2))
+
+		stats, err := service.GetStats(ctx, sequenceID, StatsFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), stats.Total.Sent)
+		assert.Len(t, stats.Steps, 1)
+	})
+
+	t.Run("unknown sequence", func(t *testing.T) {
+		_, err := service.GetStats(ctx, uuid.New(), StatsFilter{})
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
+}
+
+func TestCountsRates(t *testing.T) {
+	counts := Counts{Sent: 4, Delivered: 2, Bounced: 2, Opened: 1, Clicked: 2, Replied: 1, Unsubscribed: 1}
+	assert.Equal(t, Rates{Delivery: 0.5, Open: 0.5, Click: 1, Reply: 0.5, Bounce: 0.5, Unsubscribe: 0.5}, counts.Rates())
+	assert.Equal(t, Rates{}, Counts{}.Rates(), "rates of no emails are zero")
 }
@@ -0,0 +1,157 @@
+package sequence
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+)
+
+// StatsFilter selects what the stats of a sequence count. From and To bound
+// the time range, either may be nil to leave it open. Events that look
+// machine-made, such as opens by Apple Mail Privacy Protection, are only
+// counted with IncludeAutomated.
+type StatsFilter struct {
+	From             *time.Time
+	To               *time.Time
+	IncludeAutomated bool
+}
+
+// Counts is what happened to the emails of a sequence within a time range.
+// An email is sent when the worker first tries to send it, delivered when the
+// mail server accepts it and bounced when the worker gives up on it. Opened
+// and Clicked count emails, Opens and Clicks every time they were opened or
+// clicked.
+//
+// Replies and unsubscribes are not recorded yet, Replied and Unsubscribed are
+// always zero.
+type Counts struct {
+	Sent         int64
+	Delivered    int64
+	Opened       int64
+	Opens        int64
+	Clicked      int64
+	Clicks       int64
+	Replied      int64
+	Bounced      int64
+	Unsubscribed int64
+}
+
+func (c *Counts) add(other Counts) {
+	c.Sent += other.Sent
+	c.Delivered += other.Delivered
+	c.Opened += other.Opened
+	c.Opens += other.Opens
+	c.Clicked += other.Clicked
+	c.Clicks += other.Clicks
+	c.Replied += other.Replied
+	c.Bounced += other.Bounced
+	c.Unsubscribed += other.Unsubscribed
+}
+
+// Rates are counts as fractions of the emails sent or delivered: delivery and
+// bounces of the emails sent, the rest of the emails delivered. Rates of no
+// emails are zero.
+type Rates struct {
+	Delivery    float64
+	Open        float64
+	Click       float64
+	Reply       float64
+	Bounce      float64
+	Unsubscribe float64
+}
+
+func (c Counts) Rates() Rates {
+	return Rates{
+		Delivery:    rate(c.Delivered, c.Sent),
+		Open:        rate(c.Opened, c.Delivered),
+		Click:       rate(c.Clicked, c.Delivered),
+		Reply:       rate(c.Replied, c.Delivered),
+		Bounce:      rate(c.Bounced, c.Sent),
+		Unsubscribe: rate(c.Unsubscribed, c.Delivered),
+	}
+}
+
+func rate(n, of int64) float64 {
+	if of == 0 {
+		return 0
+	}
+	return float64(n) / float64(of)
+}
+
+type StepStats struct {
+	StepID uuid.UUID
+	Counts
+}
+
+// Stats are the counts of a sequence, in total and for each of its steps in
+// order. The total includes emails of steps that have been deleted since.
+type Stats struct {
+	Total Counts
+	Steps []StepStats
+}
+
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func (s *Service) GetStats(ctx context.Context, sequenceID uuid.UUID, filter StatsFilter) (*Stats, error) {
+	stats := &Stats{}
+	err := db.InTx(ctx, s.db, func(q *models.Queries) error {
+		if _, err := q.GetSequenceByID(ctx, sequenceID); err != nil {
+			return db.NotFound(err, apperr.ErrSequenceNotFound)
+		}
+
+		steps, err := q.GetSequenceStepsBySequenceID(ctx, sequenceID)
+		if err != nil {
+			return err
+		}
+
+		rows, err := q.GetSequenceStats(ctx, &models.GetSequenceStatsParams{
+			SequenceID:       sequenceID,
+			From:             timestamptz(filter.From),
+			To:               timestamptz(filter.To),
+			IncludeAutomated: filter.IncludeAutomated,
+		})
+		if err != nil {
+			return err
+		}
+
+		byStep := make(map[uuid.UUID]Counts, len(rows))
+		for _, row := range rows {
+			counts := Counts{
+				Sent:         row.Sent,
+				Delivered:    row.Delivered,
+				Opened:       row.UniqueOpens,
+				Opens:        row.Opens,
+				Clicked:      row.UniqueClicks,
+				Clicks:       row.Clicks,
+				Replied:      row.Replied,
+				Bounced:      row.Bounced,
+				Unsubscribed: row.Unsubscribed,
+			}
+			stats.Total.add(counts)
+			if row.StepID.Valid {
+				byStep[row.StepID.Bytes] = counts
+			}
+		}
+
+		stats.Steps = make([]StepStats, len(steps))
+		for i, step := range steps {
+			stats.Steps[i] = StepStats{StepID: step.ID, Counts: byStep[step.ID]}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/contact"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+func ContactFromDB(contact *models.Contact) openapi.Contact {
+	// custom_fields only ever holds objects written by contactFromInput.
+	fields := openapi.CustomFields{}
+	_ = json.Unmarshal(contact.CustomFields, &fields)
+
+	return openapi.Contact{
+		Id:           contact.ID,
+		Email:        contact.Email,
+		FirstName:    contact.FirstName,
+		LastName:     contact.LastName,
+		CustomFields: fields,
+		TimeZone:     lo.EmptyableToPtr(contact.TimeZone),
+		CreatedAt:    &contact.CreatedAt.Time,
+		UpdatedAt:    &contact.UpdatedAt.Time,
+	}
+}
+
+func contactFromInput(input *openapi.ContactInput) (*models.Contact, error) {
+	fields := []byte("{}")
+	if input.CustomFields != nil {
+		var err error
+		if fields, err = json.Marshal(*input.CustomFields); err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.Contact{
+		Email:        input.Email,
+		FirstName:    lo.FromPtr(input.FirstName),
+		LastName:     lo.FromPtr(input.LastName),
+		CustomFields: fields,
+		TimeZone:     lo.FromPtr(input.TimeZone),
+	}, nil
+}
+
+func (s *StrictHandler) GetContact(ctx context.Context, request openapi.GetContactRequestObject) (openapi.GetContactResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid contact ID")
+	}
+
+	found, err := s.contacts.GetContact(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get contact")
+	}
+
+	return openapi.GetContact200JSONResponse(ContactFromDB(found)), nil
+}
+
+func (s *StrictHandler) ListContacts(ctx context.Context, request openapi.ListContactsRequestObject) (openapi.ListContactsResponseObject, error) {
+	params := contact.ListContactsParams{
+		Query:  request.Params.Q,
+		Cursor: request.Params.Cursor,
+		Limit:  defaultListLimit,
+	}
+
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > maxListLimit {
+			return nil, ErrBadRequest("Invalid limit")
+		}
+		params.Limit = *request.Params.Limit
+	}
+
+	page, err := s.contacts.ListContacts(ctx, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list contacts")
+	}
+
+	return openapi.ListContacts200JSONResponse{
+		Items: lo.Map(page.Contacts, func(contact *models.Contact, _ int) openapi.Contact {
+			return ContactFromDB(contact)
+		}),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (s *StrictHandler) CreateContact(ctx context.Context, request openapi.CreateContactRequestObject) (openapi.CreateContactResponseObject, error) {
+	if err := validateContact(request.Body); err != nil {
+		return nil, err
+	}
+
+	input, err := contactFromInput(request.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to encode custom fields")
+	}
+
+	created, err := s.contacts.CreateContact(ctx, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create contact")
+	}
+
+	return openapi.CreateContact201JSONResponse(ContactFromDB(created)), nil
+}
+
+func (s *StrictHandler) UpdateContact(ctx context.Context, request openapi.UpdateContactRequestObject) (openapi.UpdateContactResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid contact ID")
+	}
+
+	if err := validateContact(request.Body); err != nil {
+		return nil, err
+	}
+
+	input, err := contactFromInput(request.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to encode custom fields")
+	}
+
+	updated, err := s.contacts.UpdateContact(ctx, id, input)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to update contact")
+	}
+
+	return openapi.UpdateContact200JSONResponse(ContactFromDB(updated)), nil
+}
+
+func (s *StrictHandler) DeleteContact(ctx context.Context, request openapi.DeleteContactRequestObject) (openapi.DeleteContactResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid contact ID")
+	}
+
+	if err := s.contacts.DeleteContact(ctx, id); err != nil {
+		return nil, errors.Wrap(err, "Failed to delete contact")
+	}
+
+	return openapi.DeleteContact204Response{}, nil
+}
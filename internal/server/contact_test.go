@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/contact"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestContactFromDB(t *testing.T) {
+	now := time.Now()
+	contact := &models.Contact{
+		ID:           uuid.New(),
+		Email:        "jane@example.com",
+		FirstName:    "Jane",
+		LastName:     "Doe",
+		CustomFields: []byte(`{"company":"Acme","seats":12}`),
+		TimeZone:     "Europe/Prague",
+		CreatedAt:    pgtype.Timestamptz{Time: now, Valid: true},
+		UpdatedAt:    pgtype.Timestamptz{Time: now, Valid: true},
+	}
+
+	result := ContactFromDB(contact)
+
+	assert.Equal(t, contact.ID, result.Id)
+	assert.Equal(t, contact.Email, result.Email)
+	assert.Equal(t, contact.FirstName, result.FirstName)
+	assert.Equal(t, contact.LastName, result.LastName)
+	assert.Equal(t, openapi.CustomFields{"company": "Acme", "seats": float64(12)}, result.CustomFields)
+	assert.Equal(t, pointer.To("Europe/Prague"), result.TimeZone)
+	assert.Equal(t, &now, result.CreatedAt)
+
+	contact.TimeZone = ""
+	assert.Nil(t, ContactFromDB(contact).TimeZone, "unknown time zones are left out")
+}
+
+func TestCreateContact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockContactService(ctrl)
+	handler := &StrictHandler{contacts: mockService}
+	ctx := context.Background()
+
+	t.Run("successful creation", func(t *testing.T) {
+		request := openapi.CreateContactRequestObject{
+			Body: &openapi.ContactInput{
+				Email:        "jane@example.com",
+				FirstName:    pointer.To("Jane"),
+				CustomFields: &openapi.CustomFields{"company": "Acme"},
+			},
+		}
+
+		mockService.EXPECT().
+			CreateContact(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, c *models.Contact) (*models.Contact, error) {
+				assert.Equal(t, "jane@example.com", c.Email)
+				assert.Equal(t, "Jane", c.FirstName)
+				assert.Empty(t, c.LastName)
+				assert.JSONEq(t, `{"company":"Acme"}`, string(c.CustomFields))
+				c.ID = uuid.New()
+				return c, nil
+			})
+
+		response, err := handler.CreateContact(ctx, request)
+		require.NoError(t, err)
+
+		result := response.(openapi.CreateContact201JSONResponse)
+		assert.Equal(t, "jane@example.com", result.Email)
+		assert.Equal(t, openapi.CustomFields{"company": "Acme"}, result.CustomFields)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		request := openapi.CreateContactRequestObject{
+			Body: &openapi.ContactInput{
+				Email:    "Jane <jane@example.com>",
+				TimeZone: pointer.To("Europe/Atlantis"),
+				CustomFields: &openapi.CustomFields{
+					"1st":     "x",
+					"address": map[string]any{"city": "Prague"},
+				},
+			},
+		}
+
+		response, err := handler.CreateContact(ctx, request)
+		assert.Nil(t, response)
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "email", Message: "must be a valid email address"},
+			{Field: "customFields.1st", Message: "must start with a letter or underscore and contain only letters, digits and underscores"},
+			{Field: "customFields.address", Message: "must be a string, number, boolean or null"},
+			{Field: "timeZone", Message: "must be an IANA time zone"},
+		}, validationErr.Fields)
+	})
+
+	t.Run("handles taken email", func(t *testing.T) {
+		request := openapi.CreateContactRequestObject{
+			Body: &openapi.ContactInput{Email: "jane@example.com"},
+		}
+
+		mockService.EXPECT().
+			CreateContact(ctx, gomock.Any()).
+			Return(nil, apperr.ErrEmailTaken)
+
+		response, err := handler.CreateContact(ctx, request)
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrEmailTaken)
+		assert.Equal(t, 409, toAPIError(err).Code)
+	})
+}
+
+func TestListContacts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockContactService(ctrl)
+	handler := &StrictHandler{contacts: mockService}
+	ctx := context.Background()
+
+	t.Run("passes the filter and returns the next cursor", func(t *testing.T) {
+		mockService.EXPECT().
+			ListContacts(ctx, contact.ListContactsParams{Query: pointer.To("jane"), Limit: 10}).
+			Return(&contact.ContactPage{
+				Contacts:   []*models.Contact{{ID: uuid.New(), Email: "jane@example.com"}},
+				NextCursor: pointer.To("next"),
+			}, nil)
+
+		response, err := handler.ListContacts(ctx, openapi.ListContactsRequestObject{
+			Params: openapi.ListContactsParams{Q: pointer.To("jane"), Limit: pointer.To(10)},
+		})
+		require.NoError(t, err)
+
+		result := response.(openapi.ListContacts200JSONResponse)
+		assert.Len(t, result.Items, 1)
+		assert.Equal(t, openapi.CustomFields{}, result.Items[0].CustomFields)
+		assert.Equal(t, pointer.To("next"), result.NextCursor)
+	})
+
+	t.Run("rejects invalid limit", func(t *testing.T) {
+		response, err := handler.ListContacts(ctx, openapi.ListContactsRequestObject{
+			Params: openapi.ListContactsParams{Limit: pointer.To(0)},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateContact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockContactService(ctrl)
+	handler := &StrictHandler{contacts: mockService}
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("replaces the contact", func(t *testing.T) {
+		mockService.EXPECT().
+			UpdateContact(ctx, id, gomock.Any()).
+			DoAndReturn(func(_ context.Context, id uuid.UUID, c *models.Contact) (*models.Contact, error) {
+				assert.JSONEq(t, `{}`, string(c.CustomFields))
+				c.ID = id
+				return c, nil
+			})
+
+		response, err := handler.UpdateContact(ctx, openapi.UpdateContactRequestObject{
+			Id:   id.String(),
+			Body: &openapi.ContactInput{Email: "jane@example.com"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, id, response.(openapi.UpdateContact200JSONResponse).Id)
+	})
+
+	t.Run("handles not found", func(t *testing.T) {
+		mockService.EXPECT().
+			UpdateContact(ctx, id, gomock.Any()).
+			Return(nil, apperr.ErrContactNotFound)
+
+		response, err := handler.UpdateContact(ctx, openapi.UpdateContactRequestObject{
+			Id:   id.String(),
+			Body: &openapi.ContactInput{Email: "jane@example.com"},
+		})
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrContactNotFound)
+	})
+
+	t.Run("rejects invalid ID", func(t *testing.T) {
+		response, err := handler.UpdateContact(ctx, openapi.UpdateContactRequestObject{
+			Id:   "invalid",
+			Body: &openapi.ContactInput{Email: "jane@example.com"},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteContact(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockContactService(ctrl)
+	handler := &StrictHandler{contacts: mockService}
+	ctx := context.Background()
+	id := uuid.New()
+
+	mockService.EXPECT().DeleteContact(ctx, id).Return(nil)
+
+	response, err := handler.DeleteContact(ctx, openapi.DeleteContactRequestObject{Id: id.String()})
+	require.NoError(t, err)
+	assert.IsType(t, openapi.DeleteContact204Response{}, response)
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/deadletter"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+func DeadLetterFromDB(letter *models.DeadLetter) openapi.DeadLetter {
+	return openapi.DeadLetter{
+		Id:        letter.ID,
+		SendJobId: letter.SendJobID,
+		Attempts:  int(letter.Attempts),
+		Error:     letter.Error,
+		CreatedAt: letter.CreatedAt.Time,
+	}
+}
+
+func SendJobFromDB(job *models.SendJob) openapi.SendJob {
+	result := openapi.SendJob{
+		Id:           job.ID,
+		EnrollmentId: job.EnrollmentID,
+		EmailSubject: job.EmailSubject,
+		EmailContent: job.EmailContent,
+		ScheduledAt:  job.ScheduledAt.Time,
+	}
+	if job.StepID.Valid {
+		result.StepId = lo.ToPtr(uuid.UUID(job.StepID.Bytes))
+	}
+	return result
+}
+
+func SendFromDB(send *models.Send) openapi.Send {
+	result := openapi.Send{
+		Attempt:   int(send.Attempt),
+		Status:    openapi.SendStatus(send.Status),
+		StartedAt: send.StartedAt.Time,
+	}
+	if send.FinishedAt.Valid {
+		result.FinishedAt = &send.FinishedAt.Time
+	}
+	if send.Error.Valid {
+		result.Error = &send.Error.String
+	}
+	return result
+}
+
+func (s *StrictHandler) ListDeadLetters(ctx context.Context, request openapi.ListDeadLettersRequestObject) (openapi.ListDeadLettersResponseObject, error) {
+	params := deadletter.ListDeadLettersParams{
+		Cursor: request.Params.Cursor,
+		Limit:  defaultListLimit,
+	}
+
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > maxListLimit {
+			return nil, ErrBadRequest("Invalid limit")
+		}
+		params.Limit = *request.Params.Limit
+	}
+
+	page, err := s.deadLetters.ListDeadLetters(ctx, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list dead letters")
+	}
+
+	return openapi.ListDeadLetters200JSONResponse{
+		Items: lo.Map(page.DeadLetters, func(letter *models.DeadLetter, _ int) openapi.DeadLetter {
+			return DeadLetterFromDB(letter)
+		}),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+func (s *StrictHandler) GetDeadLetter(ctx context.Context, request openapi.GetDeadLetterRequestObject) (openapi.GetDeadLetterResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid dead letter ID")
+	}
+
+	details, err := s.deadLetters.GetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get dead letter")
+	}
+
+	return openapi.GetDeadLetter200JSONResponse{
+		DeadLetter: DeadLetterFromDB(details.DeadLetter),
+		SendJob:    SendJobFromDB(details.Job),
+		Sends: lo.Map(details.Sends, func(send *models.Send, _ int) openapi.Send {
+			return SendFromDB(send)
+		}),
+	}, nil
+}
+
+func (s *StrictHandler) RequeueDeadLetter(ctx context.Context, request openapi.RequeueDeadLetterRequestObject) (openapi.RequeueDeadLetterResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid dead letter ID")
+	}
+
+	if err := s.deadLetters.Requeue(ctx, id); err != nil {
+		return nil, errors.Wrap(err, "Failed to requeue dead letter")
+	}
+
+	return openapi.RequeueDeadLetter202Response{}, nil
+}
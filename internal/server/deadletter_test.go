@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/deadletter"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDeadLetters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockDeadLetterService(ctrl)
+	handler := &StrictHandler{deadLetters: mockService}
+	ctx := context.Background()
+
+	t.Run("returns a page", func(t *testing.T) {
+		next := "next"
+		letter := &models.DeadLetter{ID: uuid.New(), SendJobID: uuid.New(), Attempts: 5, Error: "421 try again later"}
+		mockService.EXPECT().
+			ListDeadLetters(ctx, deadletter.ListDeadLettersParams{Limit: defaultListLimit}).
+			Return(&deadletter.DeadLetterPage{DeadLetters: []*models.DeadLetter{letter}, NextCursor: &next}, nil)
+
+		response, err := handler.ListDeadLetters(ctx, openapi.ListDeadLettersRequestObject{})
+		require.NoError(t, err)
+
+		result := response.(openapi.ListDeadLetters200JSONResponse)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, letter.SendJobID, result.Items[0].SendJobId)
+		assert.Equal(t, 5, result.Items[0].Attempts)
+		assert.Equal(t, &next, result.NextCursor)
+	})
+
+	t.Run("rejects invalid limit", func(t *testing.T) {
+		limit := 0
+		response, err := handler.ListDeadLetters(ctx, openapi.ListDeadLettersRequestObject{
+			Params: openapi.ListDeadLettersParams{Limit: &limit},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDeadLetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockDeadLetterService(ctrl)
+	handler := &StrictHandler{deadLetters: mockService}
+	ctx := context.Background()
+	id, jobID := uuid.New(), uuid.New()
+
+	t.Run("returns the job and its sends", func(t *testing.T) {
+		now := time.Now()
+		mockService.EXPECT().GetDeadLetter(ctx, id).Return(&deadletter.Details{
+			DeadLetter: &models.DeadLetter{ID: id, SendJobID: jobID, Attempts: 1, Error: "550 no such user"},
+			Job:        &models.SendJob{ID: jobID, EmailSubject: "Hello", ScheduledAt: pgtype.Timestamptz{Time: now, Valid: true}},
+			Sends: []*models.Send{{
+				Attempt:    1,
+				Status:     models.SendStatusFailed,
+				Error:      pgtype.Text{String: "550 no such user", Valid: true},
+				StartedAt:  pgtype.Timestamptz{Time: now, Valid: true},
+				FinishedAt: pgtype.Timestamptz{Time: now, Valid: true},
+			}},
+		}, nil)
+
+		response, err := handler.GetDeadLetter(ctx, openapi.GetDeadLetterRequestObject{Id: id.String()})
+		require.NoError(t, err)
+
+		result := response.(openapi.GetDeadLetter200JSONResponse)
+		assert.Equal(t, id, result.DeadLetter.Id)
+		assert.Equal(t, "Hello", result.SendJob.EmailSubject)
+		assert.Nil(t, result.SendJob.StepId)
+		require.Len(t, result.Sends, 1)
+		assert.Equal(t, openapi.SendStatusFailed, result.Sends[0].Status)
+		assert.Equal(t, "550 no such user", *result.Sends[0].Error)
+		assert.Equal(t, now, *result.Sends[0].FinishedAt)
+	})
+
+	t.Run("handles not found", func(t *testing.T) {
+		mockService.EXPECT().GetDeadLetter(ctx, id).Return(nil, apperr.ErrDeadLetterNotFound)
+
+		response, err := handler.GetDeadLetter(ctx, openapi.GetDeadLetterRequestObject{Id: id.String()})
+		assert.Nil(t, response)
+		assert.Equal(t, 404, toAPIError(err).Code)
+	})
+}
+
+func TestRequeueDeadLetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockDeadLetterService(ctrl)
+	handler := &StrictHandler{deadLetters: mockService}
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("requeues", func(t *testing.T) {
+		mockService.EXPECT().Requeue(ctx, id).Return(nil)
+
+		response, err := handler.RequeueDeadLetter(ctx, openapi.RequeueDeadLetterRequestObject{Id: id.String()})
+		require.NoError(t, err)
+		assert.IsType(t, openapi.RequeueDeadLetter202Response{}, response)
+	})
+
+	t.Run("rejects invalid ID", func(t *testing.T) {
+		response, err := handler.RequeueDeadLetter(ctx, openapi.RequeueDeadLetterRequestObject{Id: "invalid"})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+	})
+}
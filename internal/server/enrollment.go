@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+func EnrollmentFromDB(enrollment *models.Enrollment) openapi.Enrollment {
+	result := openapi.Enrollment{
+		Id:         enrollment.ID,
+		SequenceId: enrollment.SequenceID,
+		ContactId:  enrollment.ContactID,
+		State:      openapi.EnrollmentState(enrollment.State),
+		CreatedAt:  &enrollment.CreatedAt.Time,
+		UpdatedAt:  &enrollment.UpdatedAt.Time,
+	}
+	if enrollment.CurrentStepID.Valid {
+		result.CurrentStepId = lo.ToPtr(uuid.UUID(enrollment.CurrentStepID.Bytes))
+	}
+	if enrollment.NextSendAt.Valid {
+		result.NextSendAt = &enrollment.NextSendAt.Time
+	}
+	return result
+}
+
+func (s *StrictHandler) CreateEnrollment(ctx context.Context, request openapi.CreateEnrollmentRequestObject) (openapi.CreateEnrollmentResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	enrolled, err := s.enrollments.Enroll(ctx, sequenceID, request.Body.ContactId)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to enroll contact")
+	}
+
+	return openapi.CreateEnrollment201JSONResponse(EnrollmentFromDB(enrolled)), nil
+}
+
+func (s *StrictHandler) CreateEnrollments(ctx context.Context, request openapi.CreateEnrollmentsRequestObject) (openapi.CreateEnrollmentsResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	if err := validateBulkEnrollment(request.Body); err != nil {
+		return nil, err
+	}
+
+	result, err := s.enrollments.EnrollMany(ctx, sequenceID, request.Body.ContactIds)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to enroll contacts")
+	}
+
+	skipped := result.Skipped
+	if skipped == nil {
+		skipped = []uuid.UUID{}
+	}
+
+	return openapi.CreateEnrollments200JSONResponse{
+		Items: lo.Map(result.Enrolled, func(enrollment *models.Enrollment, _ int) openapi.Enrollment {
+			return EnrollmentFromDB(enrollment)
+		}),
+		SkippedContactIds: skipped,
+	}, nil
+}
+
+func (s *StrictHandler) GetEnrollment(ctx context.Context, request openapi.GetEnrollmentRequestObject) (openapi.GetEnrollmentResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid enrollment ID")
+	}
+
+	found, err := s.enrollments.GetEnrollment(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get enrollment")
+	}
+
+	return openapi.GetEnrollment200JSONResponse(EnrollmentFromDB(found)), nil
+}
+
+func (s *StrictHandler) PauseEnrollment(ctx context.Context, request openapi.PauseEnrollmentRequestObject) (openapi.PauseEnrollmentResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid enrollment ID")
+	}
+
+	paused, err := s.enrollments.Pause(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to pause enrollment")
+	}
+
+	return openapi.PauseEnrollment200JSONResponse(EnrollmentFromDB(paused)), nil
+}
+
+func (s *StrictHandler) ResumeEnrollment(ctx context.Context, request openapi.ResumeEnrollmentRequestObject) (openapi.ResumeEnrollmentResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid enrollment ID")
+	}
+
+	resumed, err := s.enrollments.Resume(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to resume enrollment")
+	}
+
+	return openapi.ResumeEnrollment200JSONResponse(EnrollmentFromDB(resumed)), nil
+}
+
+func (s *StrictHandler) StopEnrollment(ctx context.Context, request openapi.StopEnrollmentRequestObject) (openapi.StopEnrollmentResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid enrollment ID")
+	}
+
+	stopped, err := s.enrollments.Stop(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to stop enrollment")
+	}
+
+	return openapi.StopEnrollment200JSONResponse(EnrollmentFromDB(stopped)), nil
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/enrollment"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEnrollmentFromDB(t *testing.T) {
+	now := time.Now()
+	stepID := uuid.New()
+	enrollment := &models.Enrollment{
+		ID:            uuid.New(),
+		SequenceID:    uuid.New(),
+		ContactID:     uuid.New(),
+		State:         models.EnrollmentStateActive,
+		CurrentStepID: pgtype.UUID{Bytes: stepID, Valid: true},
+		NextSendAt:    pgtype.Timestamptz{Time: now, Valid: true},
+	}
+
+	result := EnrollmentFromDB(enrollment)
+	assert.Equal(t, enrollment.ID, result.Id)
+	assert.Equal(t, openapi.EnrollmentState("active"), result.State)
+	assert.Equal(t, &stepID, result.CurrentStepId)
+	assert.Equal(t, &now, result.NextSendAt)
+
+	enrollment.State = models.EnrollmentStateCompleted
+	enrollment.CurrentStepID = pgtype.UUID{}
+	enrollment.NextSendAt = pgtype.Timestamptz{}
+	result = EnrollmentFromDB(enrollment)
+	assert.Nil(t, result.CurrentStepId)
+	assert.Nil(t, result.NextSendAt)
+}
+
+func TestCreateEnrollment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockEnrollmentService(ctrl)
+	handler := &StrictHandler{enrollments: mockService}
+	ctx := context.Background()
+	sequenceID, contactID := uuid.New(), uuid.New()
+
+	t.Run("successful enrollment", func(t *testing.T) {
+		mockService.EXPECT().
+			Enroll(ctx, sequenceID, contactID).
+			Return(&models.Enrollment{ID: uuid.New(), SequenceID: sequenceID, ContactID: contactID, State: models.EnrollmentStateActive}, nil)
+
+		response, err := handler.CreateEnrollment(ctx, openapi.CreateEnrollmentRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.EnrollmentInput{ContactId: contactID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, contactID, response.(openapi.CreateEnrollment201JSONResponse).ContactId)
+	})
+
+	t.Run("handles already enrolled", func(t *testing.T) {
+		mockService.EXPECT().
+			Enroll(ctx, sequenceID, contactID).
+			Return(nil, apperr.ErrAlreadyEnrolled)
+
+		response, err := handler.CreateEnrollment(ctx, openapi.CreateEnrollmentRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.EnrollmentInput{ContactId: contactID},
+		})
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrAlreadyEnrolled)
+		assert.Equal(t, 409, toAPIError(err).Code)
+	})
+
+	t.Run("rejects invalid sequence ID", func(t *testing.T) {
+		response, err := handler.CreateEnrollment(ctx, openapi.CreateEnrollmentRequestObject{
+			SequenceId: "invalid",
+			Body:       &openapi.EnrollmentInput{ContactId: contactID},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateEnrollments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockEnrollmentService(ctrl)
+	handler := &StrictHandler{enrollments: mockService}
+	ctx := context.Background()
+	sequenceID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+
+	t.Run("returns enrolled and skipped contacts", func(t *testing.T) {
+		mockService.EXPECT().
+			EnrollMany(ctx, sequenceID, []uuid.UUID{first, second}).
+			Return(&enrollment.BulkResult{
+				Enrolled: []*models.Enrollment{{ID: uuid.New(), ContactID: first}},
+				Skipped:  []uuid.UUID{second},
+			}, nil)
+
+		response, err := handler.CreateEnrollments(ctx, openapi.CreateEnrollmentsRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.BulkEnrollmentInput{ContactIds: []uuid.UUID{first, second}},
+		})
+		require.NoError(t, err)
+
+		result := response.(openapi.CreateEnrollments200JSONResponse)
+		require.Len(t, result.Items, 1)
+		assert.Equal(t, first, result.Items[0].ContactId)
+		assert.Equal(t, []uuid.UUID{second}, result.SkippedContactIds)
+	})
+
+	t.Run("skipped contacts are never null", func(t *testing.T) {
+		mockService.EXPECT().
+			EnrollMany(ctx, sequenceID, []uuid.UUID{first}).
+			Return(&enrollment.BulkResult{Enrolled: []*models.Enrollment{{ID: uuid.New(), ContactID: first}}}, nil)
+
+		response, err := handler.CreateEnrollments(ctx, openapi.CreateEnrollmentsRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.BulkEnrollmentInput{ContactIds: []uuid.UUID{first}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{}, response.(openapi.CreateEnrollments200JSONResponse).SkippedContactIds)
+	})
+
+	t.Run("rejects repeated contacts", func(t *testing.T) {
+		response, err := handler.CreateEnrollments(ctx, openapi.CreateEnrollmentsRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.BulkEnrollmentInput{ContactIds: []uuid.UUID{first, first}},
+		})
+		assert.Nil(t, response)
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{{Field: "contactIds[1]", Message: "must not be repeated"}}, validationErr.Fields)
+	})
+
+	t.Run("rejects empty list", func(t *testing.T) {
+		response, err := handler.CreateEnrollments(ctx, openapi.CreateEnrollmentsRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.BulkEnrollmentInput{ContactIds: []uuid.UUID{}},
+		})
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+	})
+}
+
+func TestEnrollmentTransitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockEnrollmentService(ctrl)
+	handler := &StrictHandler{enrollments: mockService}
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("pause", func(t *testing.T) {
+		mockService.EXPECT().Pause(ctx, id).Return(&models.Enrollment{ID: id, State: models.EnrollmentStatePaused}, nil)
+
+		response, err := handler.PauseEnrollment(ctx, openapi.PauseEnrollmentRequestObject{Id: id.String()})
+		require.NoError(t, err)
+		assert.Equal(t, openapi.EnrollmentState("paused"), response.(openapi.PauseEnrollment200JSONResponse).State)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		mockService.EXPECT().Resume(ctx, id).Return(&models.Enrollment{ID: id, State: models.EnrollmentStateActive}, nil)
+
+		response, err := handler.ResumeEnrollment(ctx, openapi.ResumeEnrollmentRequestObject{Id: id.String()})
+		require.NoError(t, err)
+		assert.Equal(t, openapi.EnrollmentState("active"), response.(openapi.ResumeEnrollment200JSONResponse).State)
+	})
+
+	t.Run("invalid transition", func(t *testing.T) {
+		mockService.EXPECT().Stop(ctx, id).Return(nil, apperr.ErrInvalidTransition)
+
+		response, err := handler.StopEnrollment(ctx, openapi.StopEnrollmentRequestObject{Id: id.String()})
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrInvalidTransition)
+		assert.Equal(t, 409, toAPIError(err).Code)
+	})
+
+	t.Run("rejects invalid ID", func(t *testing.T) {
+		response, err := handler.PauseEnrollment(ctx, openapi.PauseEnrollmentRequestObject{Id: "invalid"})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+	})
+}
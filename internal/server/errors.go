@@ -1,10 +1,22 @@
 package server
 
-import "net/http"
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/pkg/middleware"
+	"github.com/samber/lo"
+)
 
 type APIError struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code    int                 `json:"code"`
+	Message string              `json:"message"`
+	Fields  []apperr.FieldError `json:"fields,omitempty"`
 }
 
 func (e *APIError) Error() string {
@@ -15,6 +27,49 @@ func (e *APIError) StatusCode() int {
 	return e.Code
 }
 
+// Problem renders the error as RFC 7807 problem details. The instance is set
+// to the ID of the request that failed.
+func (e *APIError) Problem(instance string) openapi.Error {
+	problem := openapi.Error{
+		Type:   "about:blank",
+		Title:  http.StatusText(e.Code),
+		Status: int64(e.Code),
+	}
+	if e.Message != "" {
+		problem.Detail = &e.Message
+	}
+	if instance != "" {
+		problem.Instance = &instance
+	}
+	if len(e.Fields) > 0 {
+		details := lo.Map(e.Fields, func(f apperr.FieldError, _ int) openapi.ErrorDetail {
+			return openapi.ErrorDetail{
+				Field:   f.Field,
+				Pointer: fieldPointer(f.Field),
+				Message: f.Message,
+			}
+		})
+		problem.Errors = &details
+	}
+	return problem
+}
+
+var fieldIndex = regexp.MustCompile(`\[(\d+)\]`)
+
+// fieldPointer converts a field path such as "steps[1].emailSubject" into the
+// JSON pointer "/steps/1/emailSubject".
+func fieldPointer(field string) string {
+	if field == "" {
+		return ""
+	}
+	field = fieldIndex.ReplaceAllString(field, ".$1")
+	parts := strings.Split(field, ".")
+	for i, part := range parts {
+		parts[i] = strings.NewReplacer("~", "~0", "/", "~1").Replace(part)
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
 func ErrInternal(msg string) error {
 	return &APIError{
 		Code:    http.StatusInternalServerError,
@@ -35,3 +90,62 @@ func ErrBadRequest(msg string) error {
 		Message: msg,
 	}
 }
+
+// toAPIError maps errors returned by handlers, including wrapped domain errors
+// from the services, onto the API error sent to the client. Anything it does
+// not recognise becomes an internal server error.
+func toAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	var (
+		validationErr *apperr.ValidationError
+		maxBytesErr   *http.MaxBytesError
+	)
+	switch {
+	case errors.Is(err, apperr.ErrSequenceNotFound):
+		return &APIError{Code: http.StatusNotFound, Message: "Sequence not found"}
+	case errors.Is(err, apperr.ErrStepNotFound):
+		return &APIError{Code: http.StatusNotFound, Message: "Sequence step not found"}
+	case errors.Is(err, apperr.ErrContactNotFound):
+		return &APIError{Code: http.StatusNotFound, Message: "Contact not found"}
+	case errors.Is(err, apperr.ErrEmailTaken):
+		return &APIError{Code: http.StatusConflict, Message: "A contact with this email already exists"}
+	case errors.Is(err, apperr.ErrSequenceArchived):
+		return &APIError{Code: http.StatusConflict, Message: "Sequence is archived"}
+	case errors.Is(err, apperr.ErrEnrollmentNotFound):
+		return &APIError{Code: http.StatusNotFound, Message: "Enrollment not found"}
+	case errors.Is(err, apperr.ErrAlreadyEnrolled):
+		return &APIError{Code: http.StatusConflict, Message: "Contact is already enrolled in the sequence"}
+	case errors.Is(err, apperr.ErrInvalidTransition):
+		return &APIError{Code: http.StatusConflict, Message: "Enrollment cannot change to the requested state"}
+	case errors.Is(err, apperr.ErrDeadLetterNotFound):
+		return &APIError{Code: http.StatusNotFound, Message: "Dead letter not found"}
+	case errors.Is(err, apperr.ErrMailboxNotFound):
+		return &APIError{Code: http.StatusNotFound, Message: "Mailbox not found"}
+	case errors.Is(err, apperr.ErrMailboxInUse):
+		return &APIError{Code: http.StatusConflict, Message: "Mailbox still has emails to send"}
+	case errors.Is(err, apperr.ErrConflict):
+		return &APIError{Code: http.StatusConflict, Message: "Conflict"}
+	case errors.Is(err, middleware.ErrIdempotencyKeyInvalid):
+		return &APIError{Code: http.StatusBadRequest, Message: "Idempotency-Key must be at most 255 characters long"}
+	case errors.Is(err, middleware.ErrIdempotencyKeyReused):
+		return &APIError{Code: http.StatusUnprocessableEntity, Message: "Idempotency-Key was already used with a different request"}
+	case errors.Is(err, middleware.ErrIdempotencyKeyInUse):
+		return &APIError{Code: http.StatusConflict, Message: "A request with this Idempotency-Key is still being processed"}
+	case errors.As(err, &maxBytesErr):
+		return &APIError{Code: http.StatusRequestEntityTooLarge, Message: fmt.Sprintf("Request body must be at most %d bytes", maxBytesErr.Limit)}
+	case errors.Is(err, apperr.ErrVersionMismatch):
+		return &APIError{Code: http.StatusPreconditionFailed, Message: "Resource has been modified"}
+	case errors.As(err, &validationErr):
+		return &APIError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: "Validation failed",
+			Fields:  validationErr.Fields,
+		}
+	default:
+		return &APIError{Code: http.StatusInternalServerError, Message: "internal server error"}
+	}
+}
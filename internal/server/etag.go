@@ -0,0 +1,46 @@
+package server
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pirellik/sequence-api/internal/apperr"
+)
+
+// etag formats the version of a resource as a strong entity tag.
+func etag(version int32) string {
+	return `"` + strconv.FormatInt(int64(version), 10) + `"`
+}
+
+// ifMatchVersion returns the version a request expects from its If-Match
+// header, or nil when the header is absent or "*". If-Match uses the strong
+// comparison, so a weak tag can never match.
+func ifMatchVersion(header *string) (*int32, error) {
+	if header == nil {
+		return nil, nil
+	}
+
+	value := strings.TrimSpace(*header)
+	if value == "*" {
+		return nil, nil
+	}
+	if strings.HasPrefix(value, "W/") {
+		return nil, apperr.ErrVersionMismatch
+	}
+
+	unquoted, ok := strings.CutPrefix(value, `"`)
+	if ok {
+		unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	}
+	if !ok {
+		return nil, ErrBadRequest("Invalid If-Match header")
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 32)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid If-Match header")
+	}
+
+	v := int32(version)
+	return &v, nil
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestETag(t *testing.T) {
+	assert.Equal(t, `"1"`, etag(1))
+	assert.Equal(t, `"42"`, etag(42))
+}
+
+func TestIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  *string
+		want    *int32
+		wantErr error
+	}{
+		{name: "absent"},
+		{name: "any", header: pointer.To("*")},
+		{name: "version", header: pointer.To(`"3"`), want: pointer.To(int32(3))},
+		{name: "surrounding spaces", header: pointer.To(` "3" `), want: pointer.To(int32(3))},
+		{name: "weak tag", header: pointer.To(`W/"3"`), wantErr: apperr.ErrVersionMismatch},
+		{name: "unquoted", header: pointer.To("3"), wantErr: ErrBadRequest("Invalid If-Match header")},
+		{name: "not a version", header: pointer.To(`"abc"`), wantErr: ErrBadRequest("Invalid If-Match header")},
+		{name: "list", header: pointer.To(`"1", "2"`), wantErr: ErrBadRequest("Invalid If-Match header")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ifMatchVersion(tt.header)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr.Error(), err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,24 +4,98 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/contact"
 	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/deadletter"
+	"github.com/pirellik/sequence-api/internal/enrollment"
+	"github.com/pirellik/sequence-api/internal/mailbox"
 	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/render"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pirellik/sequence-api/internal/tracking"
 )
 
 type StrictHandler struct {
-	svc SequenceService
+	svc         SequenceService
+	contacts    ContactService
+	enrollments EnrollmentService
+	deadLetters DeadLetterService
+	mailboxes   MailboxService
+	tracking    TrackingService
 }
 
 var _ openapi.StrictServerInterface = (*StrictHandler)(nil)
 
 //go:generate go tool go.uber.org/mock/mockgen -source=handler.go -package=server -destination=mock_test.go -typed=true
 type SequenceService interface {
+	GetSequence(ctx context.Context, id uuid.UUID) (*models.Sequence, []*models.SequenceStep, error)
+	ListSequences(ctx context.Context, params sequence.ListSequencesParams) (*sequence.SequencePage, error)
 	CreateSequence(ctx context.Context, sequence *models.Sequence, steps []*models.SequenceStep) (*models.Sequence, []*models.SequenceStep, error)
-	UpdateSequence(ctx context.Context, id uuid.UUID, openTrackingEnabled, clickTrackingEnabled *bool) (*models.Sequence, []*models.SequenceStep, error)
-	UpdateSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID, emailSubject, emailContent *string) (*models.SequenceStep, error)
+	UpdateSequence(ctx context.Context, id uuid.UUID, update sequence.SequenceUpdate) (*models.Sequence, []*models.SequenceStep, error)
+	DeleteSequence(ctx context.Context, id uuid.UUID, hard bool) error
+	RestoreSequence(ctx context.Context, id uuid.UUID) (*models.Sequence, []*models.SequenceStep, error)
+	CreateSequenceStep(ctx context.Context, sequenceID uuid.UUID, step *models.SequenceStep, pos sequence.Position) (*models.SequenceStep, error)
+	MoveSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID, pos sequence.Position) (*models.Sequence, []*models.SequenceStep, error)
+	UpdateSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID, update sequence.StepUpdate) (*models.SequenceStep, error)
 	DeleteSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID) error
+	GetSendSchedule(ctx context.Context, sequenceID uuid.UUID) (*sequence.SendSchedule, error)
+	SetSendSchedule(ctx context.Context, sequenceID uuid.UUID, schedule sequence.SendSchedule) (*sequence.SendSchedule, error)
+	GetStats(ctx context.Context, sequenceID uuid.UUID, filter sequence.StatsFilter) (*sequence.Stats, error)
+	PreviewStep(ctx context.Context, sequenceID, stepID uuid.UUID, preview sequence.Preview) (*render.Email, error)
 }
 
-func NewHandler(svc SequenceService) *StrictHandler {
-	return &StrictHandler{svc: svc}
+type ContactService interface {
+	GetContact(ctx context.Context, id uuid.UUID) (*models.Contact, error)
+	ListContacts(ctx context.Context, params contact.ListContactsParams) (*contact.ContactPage, error)
+	CreateContact(ctx context.Context, contact *models.Contact) (*models.Contact, error)
+	UpdateContact(ctx context.Context, id uuid.UUID, contact *models.Contact) (*models.Contact, error)
+	DeleteContact(ctx context.Context, id uuid.UUID) error
+}
+
+type EnrollmentService interface {
+	Enroll(ctx context.Context, sequenceID, contactID uuid.UUID) (*models.Enrollment, error)
+	EnrollMany(ctx context.Context, sequenceID uuid.UUID, contactIDs []uuid.UUID) (*enrollment.BulkResult, error)
+	GetEnrollment(ctx context.Context, id uuid.UUID) (*models.Enrollment, error)
+	Pause(ctx context.Context, id uuid.UUID) (*models.Enrollment, error)
+	Resume(ctx context.Context, id uuid.UUID) (*models.Enrollment, error)
+	Stop(ctx context.Context, id uuid.UUID) (*models.Enrollment, error)
+}
+
+type DeadLetterService interface {
+	ListDeadLetters(ctx context.Context, params deadletter.ListDeadLettersParams) (*deadletter.DeadLetterPage, error)
+	GetDeadLetter(ctx context.Context, id uuid.UUID) (*deadletter.Details, error)
+	Requeue(ctx context.Context, id uuid.UUID) error
+}
+
+type MailboxService interface {
+	GetMailbox(ctx context.Context, id uuid.UUID) (*models.Mailbox, error)
+	ListMailboxes(ctx context.Context) ([]*models.Mailbox, error)
+	CreateMailbox(ctx context.Context, mailbox *models.Mailbox, password string) (*models.Mailbox, error)
+	UpdateMailbox(ctx context.Context, id uuid.UUID, mailbox *models.Mailbox, password *string) (*models.Mailbox, error)
+	DeleteMailbox(ctx context.Context, id uuid.UUID) error
+	GetSequenceMailboxes(ctx context.Context, sequenceID uuid.UUID) (*mailbox.SequenceMailboxes, error)
+	SetSequenceMailboxes(ctx context.Context, sequenceID uuid.UUID, mailboxes mailbox.SequenceMailboxes) (*mailbox.SequenceMailboxes, error)
+}
+
+type TrackingService interface {
+	RecordOpen(ctx context.Context, token string, client tracking.Client) error
+	RecordClick(ctx context.Context, token string, client tracking.Client) (string, error)
+}
+
+func NewHandler(
+	svc SequenceService,
+	contacts ContactService,
+	enrollments EnrollmentService,
+	deadLetters DeadLetterService,
+	mailboxes MailboxService,
+	tracking TrackingService,
+) *StrictHandler {
+	return &StrictHandler{
+		svc:         svc,
+		contacts:    contacts,
+		enrollments: enrollments,
+		deadLetters: deadLetters,
+		mailboxes:   mailboxes,
+		tracking:    tracking,
+	}
 }
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/mailbox"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// MailboxFromDB leaves out the SMTP password, which is never returned.
+func MailboxFromDB(mailbox *models.Mailbox) openapi.Mailbox {
+	return openapi.Mailbox{
+		Id:                  mailbox.ID,
+		FromName:            mailbox.FromName,
+		FromAddress:         mailbox.FromAddress,
+		SmtpHost:            mailbox.SmtpHost,
+		SmtpPort:            int(mailbox.SmtpPort),
+		SmtpUsername:        mailbox.SmtpUsername,
+		HasSmtpPassword:     len(mailbox.SmtpPassword) > 0,
+		DailyLimit:          int(mailbox.DailyLimit),
+		SendIntervalSeconds: int(mailbox.SendIntervalSeconds),
+		SendBurst:           int(mailbox.SendBurst),
+		CreatedAt:           &mailbox.CreatedAt.Time,
+		UpdatedAt:           &mailbox.UpdatedAt.Time,
+	}
+}
+
+func mailboxFromInput(input *openapi.MailboxInput) *models.Mailbox {
+	return &models.Mailbox{
+		FromName:            lo.FromPtr(input.FromName),
+		FromAddress:         input.FromAddress,
+		SmtpHost:            input.SmtpHost,
+		SmtpPort:            int32(input.SmtpPort),
+		SmtpUsername:        lo.FromPtr(input.SmtpUsername),
+		DailyLimit:          int32(input.DailyLimit),
+		SendIntervalSeconds: int32(lo.FromPtrOr(input.SendIntervalSeconds, defaultSendInterval)),
+		SendBurst:           int32(lo.FromPtrOr(input.SendBurst, defaultSendBurst)),
+	}
+}
+
+func SequenceMailboxesFromDomain(mailboxes *mailbox.SequenceMailboxes) openapi.SequenceMailboxes {
+	ids := mailboxes.MailboxIDs
+	if ids == nil {
+		ids = []uuid.UUID{}
+	}
+	return openapi.SequenceMailboxes{
+		MailboxIds: ids,
+		Rotation:   openapi.MailboxRotation(mailboxes.Rotation),
+	}
+}
+
+func (s *StrictHandler) ListMailboxes(ctx context.Context, _ openapi.ListMailboxesRequestObject) (openapi.ListMailboxesResponseObject, error) {
+	mailboxes, err := s.mailboxes.ListMailboxes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list mailboxes")
+	}
+
+	return openapi.ListMailboxes200JSONResponse{
+		Items: lo.Map(mailboxes, func(mailbox *models.Mailbox, _ int) openapi.Mailbox {
+			return MailboxFromDB(mailbox)
+		}),
+	}, nil
+}
+
+func (s *StrictHandler) GetMailbox(ctx context.Context, request openapi.GetMailboxRequestObject) (openapi.GetMailboxResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid mailbox ID")
+	}
+
+	found, err := s.mailboxes.GetMailbox(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get mailbox")
+	}
+
+	return openapi.GetMailbox200JSONResponse(MailboxFromDB(found)), nil
+}
+
+func (s *StrictHandler) CreateMailbox(ctx context.Context, request openapi.CreateMailboxRequestObject) (openapi.CreateMailboxResponseObject, error) {
+	if err := validateMailbox(request.Body); err != nil {
+		return nil, err
+	}
+
+	created, err := s.mailboxes.CreateMailbox(ctx, mailboxFromInput(request.Body), lo.FromPtr(request.Body.SmtpPassword))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create mailbox")
+	}
+
+	return openapi.CreateMailbox201JSONResponse(MailboxFromDB(created)), nil
+}
+
+func (s *StrictHandler) UpdateMailbox(ctx context.Context, request openapi.UpdateMailboxRequestObject) (openapi.UpdateMailboxResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid mailbox ID")
+	}
+
+	if err := validateMailbox(request.Body); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.mailboxes.UpdateMailbox(ctx, id, mailboxFromInput(request.Body), request.Body.SmtpPassword)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to update mailbox")
+	}
+
+	return openapi.UpdateMailbox200JSONResponse(MailboxFromDB(updated)), nil
+}
+
+func (s *StrictHandler) DeleteMailbox(ctx context.Context, request openapi.DeleteMailboxRequestObject) (openapi.DeleteMailboxResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid mailbox ID")
+	}
+
+	if err := s.mailboxes.DeleteMailbox(ctx, id); err != nil {
+		return nil, errors.Wrap(err, "Failed to delete mailbox")
+	}
+
+	return openapi.DeleteMailbox204Response{}, nil
+}
+
+func (s *StrictHandler) GetSequenceMailboxes(ctx context.Context, request openapi.GetSequenceMailboxesRequestObject) (openapi.GetSequenceMailboxesResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	mailboxes, err := s.mailboxes.GetSequenceMailboxes(ctx, sequenceID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get sequence mailboxes")
+	}
+
+	return openapi.GetSequenceMailboxes200JSONResponse(SequenceMailboxesFromDomain(mailboxes)), nil
+}
+
+func (s *StrictHandler) SetSequenceMailboxes(ctx context.Context, request openapi.SetSequenceMailboxesRequestObject) (openapi.SetSequenceMailboxesResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	if err := validateSequenceMailboxes(request.Body); err != nil {
+		return nil, err
+	}
+
+	mailboxes, err := s.mailboxes.SetSequenceMailboxes(ctx, sequenceID, mailbox.SequenceMailboxes{
+		Rotation:   models.MailboxRotation(request.Body.Rotation),
+		MailboxIDs: request.Body.MailboxIds,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to set sequence mailboxes")
+	}
+
+	return openapi.SetSequenceMailboxes200JSONResponse(SequenceMailboxesFromDomain(mailboxes)), nil
+}
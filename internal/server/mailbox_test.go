@@ -0,0 +1,185 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/mailbox"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMailboxFromDB(t *testing.T) {
+	result := MailboxFromDB(&models.Mailbox{
+		ID:           uuid.New(),
+		FromAddress:  "sales@example.com",
+		SmtpPort:     587,
+		SmtpPassword: []byte("sealed"),
+		DailyLimit:   100,
+	})
+	assert.True(t, result.HasSmtpPassword)
+	assert.Equal(t, 587, result.SmtpPort)
+
+	result = MailboxFromDB(&models.Mailbox{ID: uuid.New()})
+	assert.False(t, result.HasSmtpPassword)
+}
+
+func TestCreateMailbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockMailboxService(ctrl)
+	handler := &StrictHandler{mailboxes: mockService}
+	ctx := context.Background()
+
+	input := &openapi.MailboxInput{
+		FromName:     lo.ToPtr("Sales"),
+		FromAddress:  "sales@example.com",
+		SmtpHost:     "smtp.example.com",
+		SmtpPort:     587,
+		SmtpUsername: lo.ToPtr("sales"),
+		SmtpPassword: lo.ToPtr("hunter2"),
+		DailyLimit:   100,
+	}
+
+	t.Run("successful creation", func(t *testing.T) {
+		mockService.EXPECT().
+			CreateMailbox(ctx, gomock.Any(), "hunter2").
+			DoAndReturn(func(_ context.Context, m *models.Mailbox, _ string) (*models.Mailbox, error) {
+				assert.Equal(t, "Sales", m.FromName)
+				assert.Equal(t, int32(587), m.SmtpPort)
+				assert.Equal(t, int32(defaultSendInterval), m.SendIntervalSeconds, "optional limits get their defaults")
+				assert.Equal(t, int32(defaultSendBurst), m.SendBurst)
+				m.ID = uuid.New()
+				m.SmtpPassword = []byte("sealed")
+				return m, nil
+			})
+
+		response, err := handler.CreateMailbox(ctx, openapi.CreateMailboxRequestObject{Body: input})
+		require.NoError(t, err)
+
+		result := response.(openapi.CreateMailbox201JSONResponse)
+		assert.Equal(t, "sales@example.com", result.FromAddress)
+		assert.True(t, result.HasSmtpPassword)
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		response, err := handler.CreateMailbox(ctx, openapi.CreateMailboxRequestObject{Body: &openapi.MailboxInput{
+			FromAddress: "Sales <sales@example.com>",
+			SmtpPort:    70000,
+		}})
+		assert.Nil(t, response)
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "fromAddress", Message: "must be a valid email address"},
+			{Field: "smtpHost", Message: "must not be empty"},
+			{Field: "smtpPort", Message: "must be between 1 and 65535"},
+			{Field: "dailyLimit", Message: "must be at least 1"},
+		}, validationErr.Fields)
+	})
+}
+
+func TestUpdateMailbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockMailboxService(ctrl)
+	handler := &StrictHandler{mailboxes: mockService}
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("keeps the password when left out", func(t *testing.T) {
+		mockService.EXPECT().
+			UpdateMailbox(ctx, id, gomock.Any(), (*string)(nil)).
+			Return(&models.Mailbox{ID: id, DailyLimit: 10}, nil)
+
+		response, err := handler.UpdateMailbox(ctx, openapi.UpdateMailboxRequestObject{
+			Id: id.String(),
+			Body: &openapi.MailboxInput{
+				FromAddress: "sales@example.com",
+				SmtpHost:    "smtp.example.com",
+				SmtpPort:    587,
+				DailyLimit:  10,
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 10, response.(openapi.UpdateMailbox200JSONResponse).DailyLimit)
+	})
+
+	t.Run("handles not found", func(t *testing.T) {
+		mockService.EXPECT().DeleteMailbox(ctx, id).Return(apperr.ErrMailboxNotFound)
+
+		response, err := handler.DeleteMailbox(ctx, openapi.DeleteMailboxRequestObject{Id: id.String()})
+		assert.Nil(t, response)
+		assert.Equal(t, 404, toAPIError(err).Code)
+	})
+
+	t.Run("handles a mailbox with unsent emails", func(t *testing.T) {
+		mockService.EXPECT().DeleteMailbox(ctx, id).Return(apperr.ErrMailboxInUse)
+
+		response, err := handler.DeleteMailbox(ctx, openapi.DeleteMailboxRequestObject{Id: id.String()})
+		assert.Nil(t, response)
+		assert.Equal(t, 409, toAPIError(err).Code)
+	})
+}
+
+func TestSetSequenceMailboxes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockMailboxService(ctrl)
+	handler := &StrictHandler{mailboxes: mockService}
+	ctx := context.Background()
+	sequenceID, first, second := uuid.New(), uuid.New(), uuid.New()
+
+	t.Run("replaces the mailboxes", func(t *testing.T) {
+		expected := mailbox.SequenceMailboxes{
+			Rotation:   models.MailboxRotationLeastUsed,
+			MailboxIDs: []uuid.UUID{first, second},
+		}
+		mockService.EXPECT().SetSequenceMailboxes(ctx, sequenceID, expected).Return(&expected, nil)
+
+		response, err := handler.SetSequenceMailboxes(ctx, openapi.SetSequenceMailboxesRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.SequenceMailboxes{Rotation: openapi.LeastUsed, MailboxIds: []uuid.UUID{first, second}},
+		})
+		require.NoError(t, err)
+
+		result := response.(openapi.SetSequenceMailboxes200JSONResponse)
+		assert.Equal(t, openapi.LeastUsed, result.Rotation)
+		assert.Equal(t, []uuid.UUID{first, second}, result.MailboxIds)
+	})
+
+	t.Run("mailbox IDs are never null", func(t *testing.T) {
+		mockService.EXPECT().
+			GetSequenceMailboxes(ctx, sequenceID).
+			Return(&mailbox.SequenceMailboxes{Rotation: models.MailboxRotationRoundRobin}, nil)
+
+		response, err := handler.GetSequenceMailboxes(ctx, openapi.GetSequenceMailboxesRequestObject{SequenceId: sequenceID.String()})
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{}, response.(openapi.GetSequenceMailboxes200JSONResponse).MailboxIds)
+	})
+
+	t.Run("rejects repeated mailboxes and unknown rotations", func(t *testing.T) {
+		response, err := handler.SetSequenceMailboxes(ctx, openapi.SetSequenceMailboxesRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.SequenceMailboxes{Rotation: "random", MailboxIds: []uuid.UUID{first, first}},
+		})
+		assert.Nil(t, response)
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "mailboxIds[1]", Message: "must not be repeated"},
+			{Field: "rotation", Message: "must be round_robin or least_used"},
+		}, validationErr.Fields)
+	})
+}
@@ -14,7 +14,14 @@ import (
 	reflect "reflect"
 
 	uuid "github.com/google/uuid"
+	contact "github.com/pirellik/sequence-api/internal/contact"
 	models "github.com/pirellik/sequence-api/internal/db/models"
+	deadletter "github.com/pirellik/sequence-api/internal/deadletter"
+	enrollment "github.com/pirellik/sequence-api/internal/enrollment"
+	mailbox "github.com/pirellik/sequence-api/internal/mailbox"
+	render "github.com/pirellik/sequence-api/internal/render"
+	sequence "github.com/pirellik/sequence-api/internal/sequence"
+	tracking "github.com/pirellik/sequence-api/internal/tracking"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -43,9 +50,9 @@ func (m *MockSequenceService) EXPECT() *MockSequenceServiceMockRecorder {
 }
 
 // CreateSequence mocks base method.
-func (m *MockSequenceService) CreateSequence(ctx context.Context, sequence *models.Sequence, steps []*models.SequenceStep) (*models.Sequence, []*models.SequenceStep, error) {
+func (m *MockSequenceService) CreateSequence(ctx context.Context, arg1 *models.Sequence, steps []*models.SequenceStep) (*models.Sequence, []*models.SequenceStep, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSequence", ctx, sequence, steps)
+	ret := m.ctrl.Call(m, "CreateSequence", ctx, arg1, steps)
 	ret0, _ := ret[0].(*models.Sequence)
 	ret1, _ := ret[1].([]*models.SequenceStep)
 	ret2, _ := ret[2].(error)
@@ -53,9 +60,9 @@ func (m *MockSequenceService) CreateSequence(ctx context.Context, sequence *mode
 }
 
 // CreateSequence indicates an expected call of CreateSequence.
-func (mr *MockSequenceServiceMockRecorder) CreateSequence(ctx, sequence, steps any) *MockSequenceServiceCreateSequenceCall {
+func (mr *MockSequenceServiceMockRecorder) CreateSequence(ctx, arg1, steps any) *MockSequenceServiceCreateSequenceCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSequence", reflect.TypeOf((*MockSequenceService)(nil).CreateSequence), ctx, sequence, steps)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSequence", reflect.TypeOf((*MockSequenceService)(nil).CreateSequence), ctx, arg1, steps)
 	return &MockSequenceServiceCreateSequenceCall{Call: call}
 }
 
@@ -82,6 +89,83 @@ func (c *MockSequenceServiceCreateSequenceCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// CreateSequenceStep mocks base method.
+func (m *MockSequenceService) CreateSequenceStep(ctx context.Context, sequenceID uuid.UUID, step *models.SequenceStep, pos sequence.Position) (*models.SequenceStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSequenceStep", ctx, sequenceID, step, pos)
+	ret0, _ := ret[0].(*models.SequenceStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSequenceStep indicates an expected call of CreateSequenceStep.
+func (mr *MockSequenceServiceMockRecorder) CreateSequenceStep(ctx, sequenceID, step, pos any) *MockSequenceServiceCreateSequenceStepCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSequenceStep", reflect.TypeOf((*MockSequenceService)(nil).CreateSequenceStep), ctx, sequenceID, step, pos)
+	return &MockSequenceServiceCreateSequenceStepCall{Call: call}
+}
+
+// MockSequenceServiceCreateSequenceStepCall wrap *gomock.Call
+type MockSequenceServiceCreateSequenceStepCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceCreateSequenceStepCall) Return(arg0 *models.SequenceStep, arg1 error) *MockSequenceServiceCreateSequenceStepCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceCreateSequenceStepCall) Do(f func(context.Context, uuid.UUID, *models.SequenceStep, sequence.Position) (*models.SequenceStep, error)) *MockSequenceServiceCreateSequenceStepCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceCreateSequenceStepCall) DoAndReturn(f func(context.Context, uuid.UUID, *models.SequenceStep, sequence.Position) (*models.SequenceStep, error)) *MockSequenceServiceCreateSequenceStepCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSequence mocks base method.
+func (m *MockSequenceService) DeleteSequence(ctx context.Context, id uuid.UUID, hard bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSequence", ctx, id, hard)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSequence indicates an expected call of DeleteSequence.
+func (mr *MockSequenceServiceMockRecorder) DeleteSequence(ctx, id, hard any) *MockSequenceServiceDeleteSequenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSequence", reflect.TypeOf((*MockSequenceService)(nil).DeleteSequence), ctx, id, hard)
+	return &MockSequenceServiceDeleteSequenceCall{Call: call}
+}
+
+// MockSequenceServiceDeleteSequenceCall wrap *gomock.Call
+type MockSequenceServiceDeleteSequenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceDeleteSequenceCall) Return(arg0 error) *MockSequenceServiceDeleteSequenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceDeleteSequenceCall) Do(f func(context.Context, uuid.UUID, bool) error) *MockSequenceServiceDeleteSequenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceDeleteSequenceCall) DoAndReturn(f func(context.Context, uuid.UUID, bool) error) *MockSequenceServiceDeleteSequenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteSequenceStep mocks base method.
 func (m *MockSequenceService) DeleteSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -120,81 +204,1409 @@ func (c *MockSequenceServiceDeleteSequenceStepCall) DoAndReturn(f func(context.C
 	return c
 }
 
-// UpdateSequence mocks base method.
-func (m *MockSequenceService) UpdateSequence(ctx context.Context, id uuid.UUID, openTrackingEnabled, clickTrackingEnabled *bool) (*models.Sequence, []*models.SequenceStep, error) {
+// GetSendSchedule mocks base method.
+func (m *MockSequenceService) GetSendSchedule(ctx context.Context, sequenceID uuid.UUID) (*sequence.SendSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSendSchedule", ctx, sequenceID)
+	ret0, _ := ret[0].(*sequence.SendSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSendSchedule indicates an expected call of GetSendSchedule.
+func (mr *MockSequenceServiceMockRecorder) GetSendSchedule(ctx, sequenceID any) *MockSequenceServiceGetSendScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendSchedule", reflect.TypeOf((*MockSequenceService)(nil).GetSendSchedule), ctx, sequenceID)
+	return &MockSequenceServiceGetSendScheduleCall{Call: call}
+}
+
+// MockSequenceServiceGetSendScheduleCall wrap *gomock.Call
+type MockSequenceServiceGetSendScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceGetSendScheduleCall) Return(arg0 *sequence.SendSchedule, arg1 error) *MockSequenceServiceGetSendScheduleCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceGetSendScheduleCall) Do(f func(context.Context, uuid.UUID) (*sequence.SendSchedule, error)) *MockSequenceServiceGetSendScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceGetSendScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID) (*sequence.SendSchedule, error)) *MockSequenceServiceGetSendScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSequence mocks base method.
+func (m *MockSequenceService) GetSequence(ctx context.Context, id uuid.UUID) (*models.Sequence, []*models.SequenceStep, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSequence", ctx, id, openTrackingEnabled, clickTrackingEnabled)
+	ret := m.ctrl.Call(m, "GetSequence", ctx, id)
 	ret0, _ := ret[0].(*models.Sequence)
 	ret1, _ := ret[1].([]*models.SequenceStep)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// UpdateSequence indicates an expected call of UpdateSequence.
-func (mr *MockSequenceServiceMockRecorder) UpdateSequence(ctx, id, openTrackingEnabled, clickTrackingEnabled any) *MockSequenceServiceUpdateSequenceCall {
+// GetSequence indicates an expected call of GetSequence.
+func (mr *MockSequenceServiceMockRecorder) GetSequence(ctx, id any) *MockSequenceServiceGetSequenceCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSequence", reflect.TypeOf((*MockSequenceService)(nil).UpdateSequence), ctx, id, openTrackingEnabled, clickTrackingEnabled)
-	return &MockSequenceServiceUpdateSequenceCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSequence", reflect.TypeOf((*MockSequenceService)(nil).GetSequence), ctx, id)
+	return &MockSequenceServiceGetSequenceCall{Call: call}
 }
 
-// MockSequenceServiceUpdateSequenceCall wrap *gomock.Call
-type MockSequenceServiceUpdateSequenceCall struct {
+// MockSequenceServiceGetSequenceCall wrap *gomock.Call
+type MockSequenceServiceGetSequenceCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSequenceServiceUpdateSequenceCall) Return(arg0 *models.Sequence, arg1 []*models.SequenceStep, arg2 error) *MockSequenceServiceUpdateSequenceCall {
+func (c *MockSequenceServiceGetSequenceCall) Return(arg0 *models.Sequence, arg1 []*models.SequenceStep, arg2 error) *MockSequenceServiceGetSequenceCall {
 	c.Call = c.Call.Return(arg0, arg1, arg2)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSequenceServiceUpdateSequenceCall) Do(f func(context.Context, uuid.UUID, *bool, *bool) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceUpdateSequenceCall {
+func (c *MockSequenceServiceGetSequenceCall) Do(f func(context.Context, uuid.UUID) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceGetSequenceCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSequenceServiceUpdateSequenceCall) DoAndReturn(f func(context.Context, uuid.UUID, *bool, *bool) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceUpdateSequenceCall {
+func (c *MockSequenceServiceGetSequenceCall) DoAndReturn(f func(context.Context, uuid.UUID) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceGetSequenceCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// UpdateSequenceStep mocks base method.
-func (m *MockSequenceService) UpdateSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID, emailSubject, emailContent *string) (*models.SequenceStep, error) {
+// GetStats mocks base method.
+func (m *MockSequenceService) GetStats(ctx context.Context, sequenceID uuid.UUID, filter sequence.StatsFilter) (*sequence.Stats, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSequenceStep", ctx, sequenceID, stepID, emailSubject, emailContent)
-	ret0, _ := ret[0].(*models.SequenceStep)
+	ret := m.ctrl.Call(m, "GetStats", ctx, sequenceID, filter)
+	ret0, _ := ret[0].(*sequence.Stats)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateSequenceStep indicates an expected call of UpdateSequenceStep.
-func (mr *MockSequenceServiceMockRecorder) UpdateSequenceStep(ctx, sequenceID, stepID, emailSubject, emailContent any) *MockSequenceServiceUpdateSequenceStepCall {
+// GetStats indicates an expected call of GetStats.
+func (mr *MockSequenceServiceMockRecorder) GetStats(ctx, sequenceID, filter any) *MockSequenceServiceGetStatsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSequenceStep", reflect.TypeOf((*MockSequenceService)(nil).UpdateSequenceStep), ctx, sequenceID, stepID, emailSubject, emailContent)
-	return &MockSequenceServiceUpdateSequenceStepCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockSequenceService)(nil).GetStats), ctx, sequenceID, filter)
+	return &MockSequenceServiceGetStatsCall{Call: call}
 }
 
-// MockSequenceServiceUpdateSequenceStepCall wrap *gomock.Call
-type MockSequenceServiceUpdateSequenceStepCall struct {
+// MockSequenceServiceGetStatsCall wrap *gomock.Call
+type MockSequenceServiceGetStatsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSequenceServiceUpdateSequenceStepCall) Return(arg0 *models.SequenceStep, arg1 error) *MockSequenceServiceUpdateSequenceStepCall {
+func (c *MockSequenceServiceGetStatsCall) Return(arg0 *sequence.Stats, arg1 error) *MockSequenceServiceGetStatsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceGetStatsCall) Do(f func(context.Context, uuid.UUID, sequence.StatsFilter) (*sequence.Stats, error)) *MockSequenceServiceGetStatsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceGetStatsCall) DoAndReturn(f func(context.Context, uuid.UUID, sequence.StatsFilter) (*sequence.Stats, error)) *MockSequenceServiceGetStatsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSequences mocks base method.
+func (m *MockSequenceService) ListSequences(ctx context.Context, params sequence.ListSequencesParams) (*sequence.SequencePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSequences", ctx, params)
+	ret0, _ := ret[0].(*sequence.SequencePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSequences indicates an expected call of ListSequences.
+func (mr *MockSequenceServiceMockRecorder) ListSequences(ctx, params any) *MockSequenceServiceListSequencesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSequences", reflect.TypeOf((*MockSequenceService)(nil).ListSequences), ctx, params)
+	return &MockSequenceServiceListSequencesCall{Call: call}
+}
+
+// MockSequenceServiceListSequencesCall wrap *gomock.Call
+type MockSequenceServiceListSequencesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceListSequencesCall) Return(arg0 *sequence.SequencePage, arg1 error) *MockSequenceServiceListSequencesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceListSequencesCall) Do(f func(context.Context, sequence.ListSequencesParams) (*sequence.SequencePage, error)) *MockSequenceServiceListSequencesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceListSequencesCall) DoAndReturn(f func(context.Context, sequence.ListSequencesParams) (*sequence.SequencePage, error)) *MockSequenceServiceListSequencesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MoveSequenceStep mocks base method.
+func (m *MockSequenceService) MoveSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID, pos sequence.Position) (*models.Sequence, []*models.SequenceStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveSequenceStep", ctx, sequenceID, stepID, pos)
+	ret0, _ := ret[0].(*models.Sequence)
+	ret1, _ := ret[1].([]*models.SequenceStep)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// MoveSequenceStep indicates an expected call of MoveSequenceStep.
+func (mr *MockSequenceServiceMockRecorder) MoveSequenceStep(ctx, sequenceID, stepID, pos any) *MockSequenceServiceMoveSequenceStepCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveSequenceStep", reflect.TypeOf((*MockSequenceService)(nil).MoveSequenceStep), ctx, sequenceID, stepID, pos)
+	return &MockSequenceServiceMoveSequenceStepCall{Call: call}
+}
+
+// MockSequenceServiceMoveSequenceStepCall wrap *gomock.Call
+type MockSequenceServiceMoveSequenceStepCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceMoveSequenceStepCall) Return(arg0 *models.Sequence, arg1 []*models.SequenceStep, arg2 error) *MockSequenceServiceMoveSequenceStepCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceMoveSequenceStepCall) Do(f func(context.Context, uuid.UUID, uuid.UUID, sequence.Position) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceMoveSequenceStepCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceMoveSequenceStepCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID, sequence.Position) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceMoveSequenceStepCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PreviewStep mocks base method.
+func (m *MockSequenceService) PreviewStep(ctx context.Context, sequenceID, stepID uuid.UUID, preview sequence.Preview) (*render.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewStep", ctx, sequenceID, stepID, preview)
+	ret0, _ := ret[0].(*render.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewStep indicates an expected call of PreviewStep.
+func (mr *MockSequenceServiceMockRecorder) PreviewStep(ctx, sequenceID, stepID, preview any) *MockSequenceServicePreviewStepCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewStep", reflect.TypeOf((*MockSequenceService)(nil).PreviewStep), ctx, sequenceID, stepID, preview)
+	return &MockSequenceServicePreviewStepCall{Call: call}
+}
+
+// MockSequenceServicePreviewStepCall wrap *gomock.Call
+type MockSequenceServicePreviewStepCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServicePreviewStepCall) Return(arg0 *render.Email, arg1 error) *MockSequenceServicePreviewStepCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServicePreviewStepCall) Do(f func(context.Context, uuid.UUID, uuid.UUID, sequence.Preview) (*render.Email, error)) *MockSequenceServicePreviewStepCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServicePreviewStepCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID, sequence.Preview) (*render.Email, error)) *MockSequenceServicePreviewStepCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RestoreSequence mocks base method.
+func (m *MockSequenceService) RestoreSequence(ctx context.Context, id uuid.UUID) (*models.Sequence, []*models.SequenceStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSequence", ctx, id)
+	ret0, _ := ret[0].(*models.Sequence)
+	ret1, _ := ret[1].([]*models.SequenceStep)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RestoreSequence indicates an expected call of RestoreSequence.
+func (mr *MockSequenceServiceMockRecorder) RestoreSequence(ctx, id any) *MockSequenceServiceRestoreSequenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSequence", reflect.TypeOf((*MockSequenceService)(nil).RestoreSequence), ctx, id)
+	return &MockSequenceServiceRestoreSequenceCall{Call: call}
+}
+
+// MockSequenceServiceRestoreSequenceCall wrap *gomock.Call
+type MockSequenceServiceRestoreSequenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceRestoreSequenceCall) Return(arg0 *models.Sequence, arg1 []*models.SequenceStep, arg2 error) *MockSequenceServiceRestoreSequenceCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceRestoreSequenceCall) Do(f func(context.Context, uuid.UUID) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceRestoreSequenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceRestoreSequenceCall) DoAndReturn(f func(context.Context, uuid.UUID) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceRestoreSequenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetSendSchedule mocks base method.
+func (m *MockSequenceService) SetSendSchedule(ctx context.Context, sequenceID uuid.UUID, schedule sequence.SendSchedule) (*sequence.SendSchedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSendSchedule", ctx, sequenceID, schedule)
+	ret0, _ := ret[0].(*sequence.SendSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSendSchedule indicates an expected call of SetSendSchedule.
+func (mr *MockSequenceServiceMockRecorder) SetSendSchedule(ctx, sequenceID, schedule any) *MockSequenceServiceSetSendScheduleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendSchedule", reflect.TypeOf((*MockSequenceService)(nil).SetSendSchedule), ctx, sequenceID, schedule)
+	return &MockSequenceServiceSetSendScheduleCall{Call: call}
+}
+
+// MockSequenceServiceSetSendScheduleCall wrap *gomock.Call
+type MockSequenceServiceSetSendScheduleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceSetSendScheduleCall) Return(arg0 *sequence.SendSchedule, arg1 error) *MockSequenceServiceSetSendScheduleCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSequenceServiceUpdateSequenceStepCall) Do(f func(context.Context, uuid.UUID, uuid.UUID, *string, *string) (*models.SequenceStep, error)) *MockSequenceServiceUpdateSequenceStepCall {
+func (c *MockSequenceServiceSetSendScheduleCall) Do(f func(context.Context, uuid.UUID, sequence.SendSchedule) (*sequence.SendSchedule, error)) *MockSequenceServiceSetSendScheduleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceSetSendScheduleCall) DoAndReturn(f func(context.Context, uuid.UUID, sequence.SendSchedule) (*sequence.SendSchedule, error)) *MockSequenceServiceSetSendScheduleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSequence mocks base method.
+func (m *MockSequenceService) UpdateSequence(ctx context.Context, id uuid.UUID, update sequence.SequenceUpdate) (*models.Sequence, []*models.SequenceStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSequence", ctx, id, update)
+	ret0, _ := ret[0].(*models.Sequence)
+	ret1, _ := ret[1].([]*models.SequenceStep)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateSequence indicates an expected call of UpdateSequence.
+func (mr *MockSequenceServiceMockRecorder) UpdateSequence(ctx, id, update any) *MockSequenceServiceUpdateSequenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSequence", reflect.TypeOf((*MockSequenceService)(nil).UpdateSequence), ctx, id, update)
+	return &MockSequenceServiceUpdateSequenceCall{Call: call}
+}
+
+// MockSequenceServiceUpdateSequenceCall wrap *gomock.Call
+type MockSequenceServiceUpdateSequenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceUpdateSequenceCall) Return(arg0 *models.Sequence, arg1 []*models.SequenceStep, arg2 error) *MockSequenceServiceUpdateSequenceCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceUpdateSequenceCall) Do(f func(context.Context, uuid.UUID, sequence.SequenceUpdate) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceUpdateSequenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceUpdateSequenceCall) DoAndReturn(f func(context.Context, uuid.UUID, sequence.SequenceUpdate) (*models.Sequence, []*models.SequenceStep, error)) *MockSequenceServiceUpdateSequenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateSequenceStep mocks base method.
+func (m *MockSequenceService) UpdateSequenceStep(ctx context.Context, sequenceID, stepID uuid.UUID, update sequence.StepUpdate) (*models.SequenceStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSequenceStep", ctx, sequenceID, stepID, update)
+	ret0, _ := ret[0].(*models.SequenceStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSequenceStep indicates an expected call of UpdateSequenceStep.
+func (mr *MockSequenceServiceMockRecorder) UpdateSequenceStep(ctx, sequenceID, stepID, update any) *MockSequenceServiceUpdateSequenceStepCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSequenceStep", reflect.TypeOf((*MockSequenceService)(nil).UpdateSequenceStep), ctx, sequenceID, stepID, update)
+	return &MockSequenceServiceUpdateSequenceStepCall{Call: call}
+}
+
+// MockSequenceServiceUpdateSequenceStepCall wrap *gomock.Call
+type MockSequenceServiceUpdateSequenceStepCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSequenceServiceUpdateSequenceStepCall) Return(arg0 *models.SequenceStep, arg1 error) *MockSequenceServiceUpdateSequenceStepCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSequenceServiceUpdateSequenceStepCall) Do(f func(context.Context, uuid.UUID, uuid.UUID, sequence.StepUpdate) (*models.SequenceStep, error)) *MockSequenceServiceUpdateSequenceStepCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSequenceServiceUpdateSequenceStepCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID, sequence.StepUpdate) (*models.SequenceStep, error)) *MockSequenceServiceUpdateSequenceStepCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockContactService is a mock of ContactService interface.
+type MockContactService struct {
+	ctrl     *gomock.Controller
+	recorder *MockContactServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockContactServiceMockRecorder is the mock recorder for MockContactService.
+type MockContactServiceMockRecorder struct {
+	mock *MockContactService
+}
+
+// NewMockContactService creates a new mock instance.
+func NewMockContactService(ctrl *gomock.Controller) *MockContactService {
+	mock := &MockContactService{ctrl: ctrl}
+	mock.recorder = &MockContactServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContactService) EXPECT() *MockContactServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateContact mocks base method.
+func (m *MockContactService) CreateContact(ctx context.Context, arg1 *models.Contact) (*models.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContact", ctx, arg1)
+	ret0, _ := ret[0].(*models.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContact indicates an expected call of CreateContact.
+func (mr *MockContactServiceMockRecorder) CreateContact(ctx, arg1 any) *MockContactServiceCreateContactCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContact", reflect.TypeOf((*MockContactService)(nil).CreateContact), ctx, arg1)
+	return &MockContactServiceCreateContactCall{Call: call}
+}
+
+// MockContactServiceCreateContactCall wrap *gomock.Call
+type MockContactServiceCreateContactCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContactServiceCreateContactCall) Return(arg0 *models.Contact, arg1 error) *MockContactServiceCreateContactCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContactServiceCreateContactCall) Do(f func(context.Context, *models.Contact) (*models.Contact, error)) *MockContactServiceCreateContactCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContactServiceCreateContactCall) DoAndReturn(f func(context.Context, *models.Contact) (*models.Contact, error)) *MockContactServiceCreateContactCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteContact mocks base method.
+func (m *MockContactService) DeleteContact(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContact", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContact indicates an expected call of DeleteContact.
+func (mr *MockContactServiceMockRecorder) DeleteContact(ctx, id any) *MockContactServiceDeleteContactCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockContactService)(nil).DeleteContact), ctx, id)
+	return &MockContactServiceDeleteContactCall{Call: call}
+}
+
+// MockContactServiceDeleteContactCall wrap *gomock.Call
+type MockContactServiceDeleteContactCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContactServiceDeleteContactCall) Return(arg0 error) *MockContactServiceDeleteContactCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContactServiceDeleteContactCall) Do(f func(context.Context, uuid.UUID) error) *MockContactServiceDeleteContactCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContactServiceDeleteContactCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockContactServiceDeleteContactCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetContact mocks base method.
+func (m *MockContactService) GetContact(ctx context.Context, id uuid.UUID) (*models.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContact", ctx, id)
+	ret0, _ := ret[0].(*models.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContact indicates an expected call of GetContact.
+func (mr *MockContactServiceMockRecorder) GetContact(ctx, id any) *MockContactServiceGetContactCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContact", reflect.TypeOf((*MockContactService)(nil).GetContact), ctx, id)
+	return &MockContactServiceGetContactCall{Call: call}
+}
+
+// MockContactServiceGetContactCall wrap *gomock.Call
+type MockContactServiceGetContactCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContactServiceGetContactCall) Return(arg0 *models.Contact, arg1 error) *MockContactServiceGetContactCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContactServiceGetContactCall) Do(f func(context.Context, uuid.UUID) (*models.Contact, error)) *MockContactServiceGetContactCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContactServiceGetContactCall) DoAndReturn(f func(context.Context, uuid.UUID) (*models.Contact, error)) *MockContactServiceGetContactCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListContacts mocks base method.
+func (m *MockContactService) ListContacts(ctx context.Context, params contact.ListContactsParams) (*contact.ContactPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContacts", ctx, params)
+	ret0, _ := ret[0].(*contact.ContactPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContacts indicates an expected call of ListContacts.
+func (mr *MockContactServiceMockRecorder) ListContacts(ctx, params any) *MockContactServiceListContactsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContacts", reflect.TypeOf((*MockContactService)(nil).ListContacts), ctx, params)
+	return &MockContactServiceListContactsCall{Call: call}
+}
+
+// MockContactServiceListContactsCall wrap *gomock.Call
+type MockContactServiceListContactsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContactServiceListContactsCall) Return(arg0 *contact.ContactPage, arg1 error) *MockContactServiceListContactsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContactServiceListContactsCall) Do(f func(context.Context, contact.ListContactsParams) (*contact.ContactPage, error)) *MockContactServiceListContactsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContactServiceListContactsCall) DoAndReturn(f func(context.Context, contact.ListContactsParams) (*contact.ContactPage, error)) *MockContactServiceListContactsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateContact mocks base method.
+func (m *MockContactService) UpdateContact(ctx context.Context, id uuid.UUID, arg2 *models.Contact) (*models.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateContact", ctx, id, arg2)
+	ret0, _ := ret[0].(*models.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateContact indicates an expected call of UpdateContact.
+func (mr *MockContactServiceMockRecorder) UpdateContact(ctx, id, arg2 any) *MockContactServiceUpdateContactCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateContact", reflect.TypeOf((*MockContactService)(nil).UpdateContact), ctx, id, arg2)
+	return &MockContactServiceUpdateContactCall{Call: call}
+}
+
+// MockContactServiceUpdateContactCall wrap *gomock.Call
+type MockContactServiceUpdateContactCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockContactServiceUpdateContactCall) Return(arg0 *models.Contact, arg1 error) *MockContactServiceUpdateContactCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockContactServiceUpdateContactCall) Do(f func(context.Context, uuid.UUID, *models.Contact) (*models.Contact, error)) *MockContactServiceUpdateContactCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockContactServiceUpdateContactCall) DoAndReturn(f func(context.Context, uuid.UUID, *models.Contact) (*models.Contact, error)) *MockContactServiceUpdateContactCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockEnrollmentService is a mock of EnrollmentService interface.
+type MockEnrollmentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnrollmentServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockEnrollmentServiceMockRecorder is the mock recorder for MockEnrollmentService.
+type MockEnrollmentServiceMockRecorder struct {
+	mock *MockEnrollmentService
+}
+
+// NewMockEnrollmentService creates a new mock instance.
+func NewMockEnrollmentService(ctrl *gomock.Controller) *MockEnrollmentService {
+	mock := &MockEnrollmentService{ctrl: ctrl}
+	mock.recorder = &MockEnrollmentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnrollmentService) EXPECT() *MockEnrollmentServiceMockRecorder {
+	return m.recorder
+}
+
+// Enroll mocks base method.
+func (m *MockEnrollmentService) Enroll(ctx context.Context, sequenceID, contactID uuid.UUID) (*models.Enrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", ctx, sequenceID, contactID)
+	ret0, _ := ret[0].(*models.Enrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockEnrollmentServiceMockRecorder) Enroll(ctx, sequenceID, contactID any) *MockEnrollmentServiceEnrollCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockEnrollmentService)(nil).Enroll), ctx, sequenceID, contactID)
+	return &MockEnrollmentServiceEnrollCall{Call: call}
+}
+
+// MockEnrollmentServiceEnrollCall wrap *gomock.Call
+type MockEnrollmentServiceEnrollCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnrollmentServiceEnrollCall) Return(arg0 *models.Enrollment, arg1 error) *MockEnrollmentServiceEnrollCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnrollmentServiceEnrollCall) Do(f func(context.Context, uuid.UUID, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServiceEnrollCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnrollmentServiceEnrollCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServiceEnrollCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnrollMany mocks base method.
+func (m *MockEnrollmentService) EnrollMany(ctx context.Context, sequenceID uuid.UUID, contactIDs []uuid.UUID) (*enrollment.BulkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMany", ctx, sequenceID, contactIDs)
+	ret0, _ := ret[0].(*enrollment.BulkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMany indicates an expected call of EnrollMany.
+func (mr *MockEnrollmentServiceMockRecorder) EnrollMany(ctx, sequenceID, contactIDs any) *MockEnrollmentServiceEnrollManyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMany", reflect.TypeOf((*MockEnrollmentService)(nil).EnrollMany), ctx, sequenceID, contactIDs)
+	return &MockEnrollmentServiceEnrollManyCall{Call: call}
+}
+
+// MockEnrollmentServiceEnrollManyCall wrap *gomock.Call
+type MockEnrollmentServiceEnrollManyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnrollmentServiceEnrollManyCall) Return(arg0 *enrollment.BulkResult, arg1 error) *MockEnrollmentServiceEnrollManyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnrollmentServiceEnrollManyCall) Do(f func(context.Context, uuid.UUID, []uuid.UUID) (*enrollment.BulkResult, error)) *MockEnrollmentServiceEnrollManyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnrollmentServiceEnrollManyCall) DoAndReturn(f func(context.Context, uuid.UUID, []uuid.UUID) (*enrollment.BulkResult, error)) *MockEnrollmentServiceEnrollManyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetEnrollment mocks base method.
+func (m *MockEnrollmentService) GetEnrollment(ctx context.Context, id uuid.UUID) (*models.Enrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollment", ctx, id)
+	ret0, _ := ret[0].(*models.Enrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnrollment indicates an expected call of GetEnrollment.
+func (mr *MockEnrollmentServiceMockRecorder) GetEnrollment(ctx, id any) *MockEnrollmentServiceGetEnrollmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollment", reflect.TypeOf((*MockEnrollmentService)(nil).GetEnrollment), ctx, id)
+	return &MockEnrollmentServiceGetEnrollmentCall{Call: call}
+}
+
+// MockEnrollmentServiceGetEnrollmentCall wrap *gomock.Call
+type MockEnrollmentServiceGetEnrollmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnrollmentServiceGetEnrollmentCall) Return(arg0 *models.Enrollment, arg1 error) *MockEnrollmentServiceGetEnrollmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnrollmentServiceGetEnrollmentCall) Do(f func(context.Context, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServiceGetEnrollmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnrollmentServiceGetEnrollmentCall) DoAndReturn(f func(context.Context, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServiceGetEnrollmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Pause mocks base method.
+func (m *MockEnrollmentService) Pause(ctx context.Context, id uuid.UUID) (*models.Enrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", ctx, id)
+	ret0, _ := ret[0].(*models.Enrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockEnrollmentServiceMockRecorder) Pause(ctx, id any) *MockEnrollmentServicePauseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockEnrollmentService)(nil).Pause), ctx, id)
+	return &MockEnrollmentServicePauseCall{Call: call}
+}
+
+// MockEnrollmentServicePauseCall wrap *gomock.Call
+type MockEnrollmentServicePauseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnrollmentServicePauseCall) Return(arg0 *models.Enrollment, arg1 error) *MockEnrollmentServicePauseCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnrollmentServicePauseCall) Do(f func(context.Context, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServicePauseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnrollmentServicePauseCall) DoAndReturn(f func(context.Context, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServicePauseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Resume mocks base method.
+func (m *MockEnrollmentService) Resume(ctx context.Context, id uuid.UUID) (*models.Enrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", ctx, id)
+	ret0, _ := ret[0].(*models.Enrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockEnrollmentServiceMockRecorder) Resume(ctx, id any) *MockEnrollmentServiceResumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockEnrollmentService)(nil).Resume), ctx, id)
+	return &MockEnrollmentServiceResumeCall{Call: call}
+}
+
+// MockEnrollmentServiceResumeCall wrap *gomock.Call
+type MockEnrollmentServiceResumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnrollmentServiceResumeCall) Return(arg0 *models.Enrollment, arg1 error) *MockEnrollmentServiceResumeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnrollmentServiceResumeCall) Do(f func(context.Context, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServiceResumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnrollmentServiceResumeCall) DoAndReturn(f func(context.Context, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServiceResumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Stop mocks base method.
+func (m *MockEnrollmentService) Stop(ctx context.Context, id uuid.UUID) (*models.Enrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, id)
+	ret0, _ := ret[0].(*models.Enrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockEnrollmentServiceMockRecorder) Stop(ctx, id any) *MockEnrollmentServiceStopCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockEnrollmentService)(nil).Stop), ctx, id)
+	return &MockEnrollmentServiceStopCall{Call: call}
+}
+
+// MockEnrollmentServiceStopCall wrap *gomock.Call
+type MockEnrollmentServiceStopCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEnrollmentServiceStopCall) Return(arg0 *models.Enrollment, arg1 error) *MockEnrollmentServiceStopCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEnrollmentServiceStopCall) Do(f func(context.Context, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServiceStopCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEnrollmentServiceStopCall) DoAndReturn(f func(context.Context, uuid.UUID) (*models.Enrollment, error)) *MockEnrollmentServiceStopCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockDeadLetterService is a mock of DeadLetterService interface.
+type MockDeadLetterService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeadLetterServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeadLetterServiceMockRecorder is the mock recorder for MockDeadLetterService.
+type MockDeadLetterServiceMockRecorder struct {
+	mock *MockDeadLetterService
+}
+
+// NewMockDeadLetterService creates a new mock instance.
+func NewMockDeadLetterService(ctrl *gomock.Controller) *MockDeadLetterService {
+	mock := &MockDeadLetterService{ctrl: ctrl}
+	mock.recorder = &MockDeadLetterServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeadLetterService) EXPECT() *MockDeadLetterServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDeadLetter mocks base method.
+func (m *MockDeadLetterService) GetDeadLetter(ctx context.Context, id uuid.UUID) (*deadletter.Details, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetter", ctx, id)
+	ret0, _ := ret[0].(*deadletter.Details)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetter indicates an expected call of GetDeadLetter.
+func (mr *MockDeadLetterServiceMockRecorder) GetDeadLetter(ctx, id any) *MockDeadLetterServiceGetDeadLetterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetter", reflect.TypeOf((*MockDeadLetterService)(nil).GetDeadLetter), ctx, id)
+	return &MockDeadLetterServiceGetDeadLetterCall{Call: call}
+}
+
+// MockDeadLetterServiceGetDeadLetterCall wrap *gomock.Call
+type MockDeadLetterServiceGetDeadLetterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDeadLetterServiceGetDeadLetterCall) Return(arg0 *deadletter.Details, arg1 error) *MockDeadLetterServiceGetDeadLetterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDeadLetterServiceGetDeadLetterCall) Do(f func(context.Context, uuid.UUID) (*deadletter.Details, error)) *MockDeadLetterServiceGetDeadLetterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDeadLetterServiceGetDeadLetterCall) DoAndReturn(f func(context.Context, uuid.UUID) (*deadletter.Details, error)) *MockDeadLetterServiceGetDeadLetterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListDeadLetters mocks base method.
+func (m *MockDeadLetterService) ListDeadLetters(ctx context.Context, params deadletter.ListDeadLettersParams) (*deadletter.DeadLetterPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeadLetters", ctx, params)
+	ret0, _ := ret[0].(*deadletter.DeadLetterPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeadLetters indicates an expected call of ListDeadLetters.
+func (mr *MockDeadLetterServiceMockRecorder) ListDeadLetters(ctx, params any) *MockDeadLetterServiceListDeadLettersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeadLetters", reflect.TypeOf((*MockDeadLetterService)(nil).ListDeadLetters), ctx, params)
+	return &MockDeadLetterServiceListDeadLettersCall{Call: call}
+}
+
+// MockDeadLetterServiceListDeadLettersCall wrap *gomock.Call
+type MockDeadLetterServiceListDeadLettersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDeadLetterServiceListDeadLettersCall) Return(arg0 *deadletter.DeadLetterPage, arg1 error) *MockDeadLetterServiceListDeadLettersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDeadLetterServiceListDeadLettersCall) Do(f func(context.Context, deadletter.ListDeadLettersParams) (*deadletter.DeadLetterPage, error)) *MockDeadLetterServiceListDeadLettersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDeadLetterServiceListDeadLettersCall) DoAndReturn(f func(context.Context, deadletter.ListDeadLettersParams) (*deadletter.DeadLetterPage, error)) *MockDeadLetterServiceListDeadLettersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Requeue mocks base method.
+func (m *MockDeadLetterService) Requeue(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockDeadLetterServiceMockRecorder) Requeue(ctx, id any) *MockDeadLetterServiceRequeueCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockDeadLetterService)(nil).Requeue), ctx, id)
+	return &MockDeadLetterServiceRequeueCall{Call: call}
+}
+
+// MockDeadLetterServiceRequeueCall wrap *gomock.Call
+type MockDeadLetterServiceRequeueCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDeadLetterServiceRequeueCall) Return(arg0 error) *MockDeadLetterServiceRequeueCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDeadLetterServiceRequeueCall) Do(f func(context.Context, uuid.UUID) error) *MockDeadLetterServiceRequeueCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDeadLetterServiceRequeueCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockDeadLetterServiceRequeueCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockMailboxService is a mock of MailboxService interface.
+type MockMailboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMailboxServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMailboxServiceMockRecorder is the mock recorder for MockMailboxService.
+type MockMailboxServiceMockRecorder struct {
+	mock *MockMailboxService
+}
+
+// NewMockMailboxService creates a new mock instance.
+func NewMockMailboxService(ctrl *gomock.Controller) *MockMailboxService {
+	mock := &MockMailboxService{ctrl: ctrl}
+	mock.recorder = &MockMailboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailboxService) EXPECT() *MockMailboxServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateMailbox mocks base method.
+func (m *MockMailboxService) CreateMailbox(ctx context.Context, arg1 *models.Mailbox, password string) (*models.Mailbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMailbox", ctx, arg1, password)
+	ret0, _ := ret[0].(*models.Mailbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMailbox indicates an expected call of CreateMailbox.
+func (mr *MockMailboxServiceMockRecorder) CreateMailbox(ctx, arg1, password any) *MockMailboxServiceCreateMailboxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMailbox", reflect.TypeOf((*MockMailboxService)(nil).CreateMailbox), ctx, arg1, password)
+	return &MockMailboxServiceCreateMailboxCall{Call: call}
+}
+
+// MockMailboxServiceCreateMailboxCall wrap *gomock.Call
+type MockMailboxServiceCreateMailboxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMailboxServiceCreateMailboxCall) Return(arg0 *models.Mailbox, arg1 error) *MockMailboxServiceCreateMailboxCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMailboxServiceCreateMailboxCall) Do(f func(context.Context, *models.Mailbox, string) (*models.Mailbox, error)) *MockMailboxServiceCreateMailboxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMailboxServiceCreateMailboxCall) DoAndReturn(f func(context.Context, *models.Mailbox, string) (*models.Mailbox, error)) *MockMailboxServiceCreateMailboxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteMailbox mocks base method.
+func (m *MockMailboxService) DeleteMailbox(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMailbox", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMailbox indicates an expected call of DeleteMailbox.
+func (mr *MockMailboxServiceMockRecorder) DeleteMailbox(ctx, id any) *MockMailboxServiceDeleteMailboxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMailbox", reflect.TypeOf((*MockMailboxService)(nil).DeleteMailbox), ctx, id)
+	return &MockMailboxServiceDeleteMailboxCall{Call: call}
+}
+
+// MockMailboxServiceDeleteMailboxCall wrap *gomock.Call
+type MockMailboxServiceDeleteMailboxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMailboxServiceDeleteMailboxCall) Return(arg0 error) *MockMailboxServiceDeleteMailboxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMailboxServiceDeleteMailboxCall) Do(f func(context.Context, uuid.UUID) error) *MockMailboxServiceDeleteMailboxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMailboxServiceDeleteMailboxCall) DoAndReturn(f func(context.Context, uuid.UUID) error) *MockMailboxServiceDeleteMailboxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMailbox mocks base method.
+func (m *MockMailboxService) GetMailbox(ctx context.Context, id uuid.UUID) (*models.Mailbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMailbox", ctx, id)
+	ret0, _ := ret[0].(*models.Mailbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMailbox indicates an expected call of GetMailbox.
+func (mr *MockMailboxServiceMockRecorder) GetMailbox(ctx, id any) *MockMailboxServiceGetMailboxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMailbox", reflect.TypeOf((*MockMailboxService)(nil).GetMailbox), ctx, id)
+	return &MockMailboxServiceGetMailboxCall{Call: call}
+}
+
+// MockMailboxServiceGetMailboxCall wrap *gomock.Call
+type MockMailboxServiceGetMailboxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMailboxServiceGetMailboxCall) Return(arg0 *models.Mailbox, arg1 error) *MockMailboxServiceGetMailboxCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMailboxServiceGetMailboxCall) Do(f func(context.Context, uuid.UUID) (*models.Mailbox, error)) *MockMailboxServiceGetMailboxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMailboxServiceGetMailboxCall) DoAndReturn(f func(context.Context, uuid.UUID) (*models.Mailbox, error)) *MockMailboxServiceGetMailboxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSequenceMailboxes mocks base method.
+func (m *MockMailboxService) GetSequenceMailboxes(ctx context.Context, sequenceID uuid.UUID) (*mailbox.SequenceMailboxes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSequenceMailboxes", ctx, sequenceID)
+	ret0, _ := ret[0].(*mailbox.SequenceMailboxes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSequenceMailboxes indicates an expected call of GetSequenceMailboxes.
+func (mr *MockMailboxServiceMockRecorder) GetSequenceMailboxes(ctx, sequenceID any) *MockMailboxServiceGetSequenceMailboxesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSequenceMailboxes", reflect.TypeOf((*MockMailboxService)(nil).GetSequenceMailboxes), ctx, sequenceID)
+	return &MockMailboxServiceGetSequenceMailboxesCall{Call: call}
+}
+
+// MockMailboxServiceGetSequenceMailboxesCall wrap *gomock.Call
+type MockMailboxServiceGetSequenceMailboxesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMailboxServiceGetSequenceMailboxesCall) Return(arg0 *mailbox.SequenceMailboxes, arg1 error) *MockMailboxServiceGetSequenceMailboxesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMailboxServiceGetSequenceMailboxesCall) Do(f func(context.Context, uuid.UUID) (*mailbox.SequenceMailboxes, error)) *MockMailboxServiceGetSequenceMailboxesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMailboxServiceGetSequenceMailboxesCall) DoAndReturn(f func(context.Context, uuid.UUID) (*mailbox.SequenceMailboxes, error)) *MockMailboxServiceGetSequenceMailboxesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListMailboxes mocks base method.
+func (m *MockMailboxService) ListMailboxes(ctx context.Context) ([]*models.Mailbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMailboxes", ctx)
+	ret0, _ := ret[0].([]*models.Mailbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMailboxes indicates an expected call of ListMailboxes.
+func (mr *MockMailboxServiceMockRecorder) ListMailboxes(ctx any) *MockMailboxServiceListMailboxesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMailboxes", reflect.TypeOf((*MockMailboxService)(nil).ListMailboxes), ctx)
+	return &MockMailboxServiceListMailboxesCall{Call: call}
+}
+
+// MockMailboxServiceListMailboxesCall wrap *gomock.Call
+type MockMailboxServiceListMailboxesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMailboxServiceListMailboxesCall) Return(arg0 []*models.Mailbox, arg1 error) *MockMailboxServiceListMailboxesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMailboxServiceListMailboxesCall) Do(f func(context.Context) ([]*models.Mailbox, error)) *MockMailboxServiceListMailboxesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMailboxServiceListMailboxesCall) DoAndReturn(f func(context.Context) ([]*models.Mailbox, error)) *MockMailboxServiceListMailboxesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetSequenceMailboxes mocks base method.
+func (m *MockMailboxService) SetSequenceMailboxes(ctx context.Context, sequenceID uuid.UUID, mailboxes mailbox.SequenceMailboxes) (*mailbox.SequenceMailboxes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSequenceMailboxes", ctx, sequenceID, mailboxes)
+	ret0, _ := ret[0].(*mailbox.SequenceMailboxes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSequenceMailboxes indicates an expected call of SetSequenceMailboxes.
+func (mr *MockMailboxServiceMockRecorder) SetSequenceMailboxes(ctx, sequenceID, mailboxes any) *MockMailboxServiceSetSequenceMailboxesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSequenceMailboxes", reflect.TypeOf((*MockMailboxService)(nil).SetSequenceMailboxes), ctx, sequenceID, mailboxes)
+	return &MockMailboxServiceSetSequenceMailboxesCall{Call: call}
+}
+
+// MockMailboxServiceSetSequenceMailboxesCall wrap *gomock.Call
+type MockMailboxServiceSetSequenceMailboxesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMailboxServiceSetSequenceMailboxesCall) Return(arg0 *mailbox.SequenceMailboxes, arg1 error) *MockMailboxServiceSetSequenceMailboxesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMailboxServiceSetSequenceMailboxesCall) Do(f func(context.Context, uuid.UUID, mailbox.SequenceMailboxes) (*mailbox.SequenceMailboxes, error)) *MockMailboxServiceSetSequenceMailboxesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMailboxServiceSetSequenceMailboxesCall) DoAndReturn(f func(context.Context, uuid.UUID, mailbox.SequenceMailboxes) (*mailbox.SequenceMailboxes, error)) *MockMailboxServiceSetSequenceMailboxesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateMailbox mocks base method.
+func (m *MockMailboxService) UpdateMailbox(ctx context.Context, id uuid.UUID, arg2 *models.Mailbox, password *string) (*models.Mailbox, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMailbox", ctx, id, arg2, password)
+	ret0, _ := ret[0].(*models.Mailbox)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMailbox indicates an expected call of UpdateMailbox.
+func (mr *MockMailboxServiceMockRecorder) UpdateMailbox(ctx, id, arg2, password any) *MockMailboxServiceUpdateMailboxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMailbox", reflect.TypeOf((*MockMailboxService)(nil).UpdateMailbox), ctx, id, arg2, password)
+	return &MockMailboxServiceUpdateMailboxCall{Call: call}
+}
+
+// MockMailboxServiceUpdateMailboxCall wrap *gomock.Call
+type MockMailboxServiceUpdateMailboxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMailboxServiceUpdateMailboxCall) Return(arg0 *models.Mailbox, arg1 error) *MockMailboxServiceUpdateMailboxCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMailboxServiceUpdateMailboxCall) Do(f func(context.Context, uuid.UUID, *models.Mailbox, *string) (*models.Mailbox, error)) *MockMailboxServiceUpdateMailboxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMailboxServiceUpdateMailboxCall) DoAndReturn(f func(context.Context, uuid.UUID, *models.Mailbox, *string) (*models.Mailbox, error)) *MockMailboxServiceUpdateMailboxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockTrackingService is a mock of TrackingService interface.
+type MockTrackingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTrackingServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTrackingServiceMockRecorder is the mock recorder for MockTrackingService.
+type MockTrackingServiceMockRecorder struct {
+	mock *MockTrackingService
+}
+
+// NewMockTrackingService creates a new mock instance.
+func NewMockTrackingService(ctrl *gomock.Controller) *MockTrackingService {
+	mock := &MockTrackingService{ctrl: ctrl}
+	mock.recorder = &MockTrackingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTrackingService) EXPECT() *MockTrackingServiceMockRecorder {
+	return m.recorder
+}
+
+// RecordClick mocks base method.
+func (m *MockTrackingService) RecordClick(ctx context.Context, token string, client tracking.Client) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordClick", ctx, token, client)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordClick indicates an expected call of RecordClick.
+func (mr *MockTrackingServiceMockRecorder) RecordClick(ctx, token, client any) *MockTrackingServiceRecordClickCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordClick", reflect.TypeOf((*MockTrackingService)(nil).RecordClick), ctx, token, client)
+	return &MockTrackingServiceRecordClickCall{Call: call}
+}
+
+// MockTrackingServiceRecordClickCall wrap *gomock.Call
+type MockTrackingServiceRecordClickCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTrackingServiceRecordClickCall) Return(arg0 string, arg1 error) *MockTrackingServiceRecordClickCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTrackingServiceRecordClickCall) Do(f func(context.Context, string, tracking.Client) (string, error)) *MockTrackingServiceRecordClickCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTrackingServiceRecordClickCall) DoAndReturn(f func(context.Context, string, tracking.Client) (string, error)) *MockTrackingServiceRecordClickCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordOpen mocks base method.
+func (m *MockTrackingService) RecordOpen(ctx context.Context, token string, client tracking.Client) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOpen", ctx, token, client)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordOpen indicates an expected call of RecordOpen.
+func (mr *MockTrackingServiceMockRecorder) RecordOpen(ctx, token, client any) *MockTrackingServiceRecordOpenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOpen", reflect.TypeOf((*MockTrackingService)(nil).RecordOpen), ctx, token, client)
+	return &MockTrackingServiceRecordOpenCall{Call: call}
+}
+
+// MockTrackingServiceRecordOpenCall wrap *gomock.Call
+type MockTrackingServiceRecordOpenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTrackingServiceRecordOpenCall) Return(arg0 error) *MockTrackingServiceRecordOpenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTrackingServiceRecordOpenCall) Do(f func(context.Context, string, tracking.Client) error) *MockTrackingServiceRecordOpenCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSequenceServiceUpdateSequenceStepCall) DoAndReturn(f func(context.Context, uuid.UUID, uuid.UUID, *string, *string) (*models.SequenceStep, error)) *MockSequenceServiceUpdateSequenceStepCall {
+func (c *MockTrackingServiceRecordOpenCall) DoAndReturn(f func(context.Context, string, tracking.Client) error) *MockTrackingServiceRecordOpenCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/schedule"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// weekdays is indexed by time.Weekday.
+var weekdays = []openapi.Weekday{
+	openapi.Sunday,
+	openapi.Monday,
+	openapi.Tuesday,
+	openapi.Wednesday,
+	openapi.Thursday,
+	openapi.Friday,
+	openapi.Saturday,
+}
+
+func SendScheduleFromDomain(sendSchedule *sequence.SendSchedule) openapi.SendSchedule {
+	return openapi.SendSchedule{
+		TimeZone: sendSchedule.TimeZone,
+		Weekdays: lo.Map(sendSchedule.Weekdays, func(day time.Weekday, _ int) openapi.Weekday {
+			return weekdays[day]
+		}),
+		StartHour: sendSchedule.StartHour,
+		EndHour:   sendSchedule.EndHour,
+		Holidays: lo.Map(sendSchedule.Holidays, func(day schedule.Date, _ int) openapi_types.Date {
+			return openapi_types.Date{Time: time.Date(day.Year, day.Month, day.Day, 0, 0, 0, 0, time.UTC)}
+		}),
+		UseContactTimeZone: sendSchedule.UseContactTimeZone,
+	}
+}
+
+func sendScheduleFromInput(input *openapi.SendSchedule) sequence.SendSchedule {
+	return sequence.SendSchedule{
+		TimeZone: input.TimeZone,
+		Weekdays: lo.Map(input.Weekdays, func(day openapi.Weekday, _ int) time.Weekday {
+			return time.Weekday(lo.IndexOf(weekdays, day))
+		}),
+		StartHour: input.StartHour,
+		EndHour:   input.EndHour,
+		Holidays: lo.Map(input.Holidays, func(day openapi_types.Date, _ int) schedule.Date {
+			return schedule.DateOf(day.Time)
+		}),
+		UseContactTimeZone: input.UseContactTimeZone,
+	}
+}
+
+func (s *StrictHandler) GetSendSchedule(ctx context.Context, request openapi.GetSendScheduleRequestObject) (openapi.GetSendScheduleResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	sendSchedule, err := s.svc.GetSendSchedule(ctx, sequenceID)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get send schedule")
+	}
+
+	return openapi.GetSendSchedule200JSONResponse(SendScheduleFromDomain(sendSchedule)), nil
+}
+
+func (s *StrictHandler) SetSendSchedule(ctx context.Context, request openapi.SetSendScheduleRequestObject) (openapi.SetSendScheduleResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	if err := validateSendSchedule(request.Body); err != nil {
+		return nil, err
+	}
+
+	sendSchedule, err := s.svc.SetSendSchedule(ctx, sequenceID, sendScheduleFromInput(request.Body))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to set send schedule")
+	}
+
+	return openapi.SetSendSchedule200JSONResponse(SendScheduleFromDomain(sendSchedule)), nil
+}
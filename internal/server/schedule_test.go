@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/schedule"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetSendSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+	sequenceID := uuid.New()
+	christmas := openapi_types.Date{Time: time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("replaces the schedule", func(t *testing.T) {
+		expected := sequence.SendSchedule{
+			TimeZone:           "Europe/Berlin",
+			Weekdays:           []time.Weekday{time.Monday, time.Friday},
+			StartHour:          9,
+			EndHour:            17,
+			Holidays:           []schedule.Date{{Year: 2025, Month: time.December, Day: 25}},
+			UseContactTimeZone: true,
+		}
+		mockService.EXPECT().SetSendSchedule(ctx, sequenceID, expected).Return(&expected, nil)
+
+		body := openapi.SendSchedule{
+			TimeZone:           "Europe/Berlin",
+			Weekdays:           []openapi.Weekday{openapi.Monday, openapi.Friday},
+			StartHour:          9,
+			EndHour:            17,
+			Holidays:           []openapi_types.Date{christmas},
+			UseContactTimeZone: true,
+		}
+		response, err := handler.SetSendSchedule(ctx, openapi.SetSendScheduleRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &body,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, openapi.SetSendSchedule200JSONResponse(body), response)
+	})
+
+	t.Run("holidays are never null", func(t *testing.T) {
+		mockService.EXPECT().
+			GetSendSchedule(ctx, sequenceID).
+			Return(&sequence.SendSchedule{TimeZone: "UTC", Weekdays: []time.Weekday{time.Sunday}, EndHour: 24}, nil)
+
+		response, err := handler.GetSendSchedule(ctx, openapi.GetSendScheduleRequestObject{SequenceId: sequenceID.String()})
+		require.NoError(t, err)
+
+		result := response.(openapi.GetSendSchedule200JSONResponse)
+		assert.Equal(t, []openapi.Weekday{openapi.Sunday}, result.Weekdays)
+		assert.Equal(t, []openapi_types.Date{}, result.Holidays)
+	})
+
+	t.Run("rejects invalid schedules", func(t *testing.T) {
+		response, err := handler.SetSendSchedule(ctx, openapi.SetSendScheduleRequestObject{
+			SequenceId: sequenceID.String(),
+			Body: &openapi.SendSchedule{
+				TimeZone:  "Mars/Olympus_Mons",
+				Weekdays:  []openapi.Weekday{openapi.Monday, "someday", openapi.Monday},
+				StartHour: 17,
+				EndHour:   9,
+				Holidays:  []openapi_types.Date{christmas, christmas},
+			},
+		})
+		assert.Nil(t, response)
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "timeZone", Message: "must be an IANA time zone"},
+			{Field: "weekdays[1]", Message: "must be a day of the week"},
+			{Field: "weekdays[2]", Message: "must not be repeated"},
+			{Field: "endHour", Message: "must be after startHour"},
+			{Field: "holidays[1]", Message: "must not be repeated"},
+		}, validationErr.Fields)
+	})
+
+	t.Run("requires a weekday", func(t *testing.T) {
+		_, err := handler.SetSendSchedule(ctx, openapi.SetSendScheduleRequestObject{
+			SequenceId: sequenceID.String(),
+			Body:       &openapi.SendSchedule{TimeZone: "UTC", Weekdays: []openapi.Weekday{}, EndHour: 24},
+		})
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{{Field: "weekdays", Message: "must not be empty"}}, validationErr.Fields)
+	})
+}
@@ -2,17 +2,22 @@ package server
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 
 	"github.com/google/uuid"
 	"github.com/pirellik/sequence-api/internal/db/models"
 	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pkg/errors"
 	"github.com/samber/lo"
 )
 
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
 func SequenceFromDB(sequence *models.Sequence, steps []*models.SequenceStep) openapi.Sequence {
-	return openapi.Sequence{
+	result := openapi.Sequence{
 		Id:                   sequence.ID,
 		Name:                 sequence.Name,
 		OpenTrackingEnabled:  sequence.OpenTrackingEnabled,
@@ -23,9 +28,78 @@ func SequenceFromDB(sequence *models.Sequence, steps []*models.SequenceStep) ope
 		CreatedAt: &sequence.CreatedAt.Time,
 		UpdatedAt: &sequence.UpdatedAt.Time,
 	}
+	if sequence.ArchivedAt.Valid {
+		result.ArchivedAt = &sequence.ArchivedAt.Time
+	}
+	return result
+}
+
+func (s *StrictHandler) GetSequence(ctx context.Context, request openapi.GetSequenceRequestObject) (openapi.GetSequenceResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	found, steps, err := s.svc.GetSequence(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get sequence")
+	}
+
+	return openapi.GetSequence200JSONResponse{
+		Body:    SequenceFromDB(found, steps),
+		Headers: openapi.GetSequence200ResponseHeaders{ETag: etag(found.Version)},
+	}, nil
+}
+
+func (s *StrictHandler) ListSequences(ctx context.Context, request openapi.ListSequencesRequestObject) (openapi.ListSequencesResponseObject, error) {
+	params := sequence.ListSequencesParams{
+		Name:   request.Params.Name,
+		Cursor: request.Params.Cursor,
+		Limit:  defaultListLimit,
+	}
+
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > maxListLimit {
+			return nil, ErrBadRequest("Invalid limit")
+		}
+		params.Limit = *request.Params.Limit
+	}
+
+	sort := openapi.SortCreatedAtDesc
+	if request.Params.Sort != nil {
+		sort = *request.Params.Sort
+	}
+	switch sort {
+	case openapi.SortCreatedAt:
+		params.Sort = sequence.SortByCreatedAt
+	case openapi.SortCreatedAtDesc:
+		params.Sort, params.Descending = sequence.SortByCreatedAt, true
+	case openapi.SortName:
+		params.Sort = sequence.SortByName
+	case openapi.SortNameDesc:
+		params.Sort, params.Descending = sequence.SortByName, true
+	default:
+		return nil, ErrBadRequest("Invalid sort")
+	}
+
+	page, err := s.svc.ListSequences(ctx, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list sequences")
+	}
+
+	return openapi.ListSequences200JSONResponse{
+		Items: lo.Map(page.Sequences, func(seq *models.Sequence, _ int) openapi.Sequence {
+			return SequenceFromDB(seq, page.Steps[seq.ID])
+		}),
+		NextCursor: page.NextCursor,
+	}, nil
 }
 
 func (s *StrictHandler) CreateSequence(ctx context.Context, request openapi.CreateSequenceRequestObject) (openapi.CreateSequenceResponseObject, error) {
+	if err := validateSequence(request.Body); err != nil {
+		return nil, err
+	}
+
 	sequence := models.Sequence{
 		Name:                 request.Body.Name,
 		OpenTrackingEnabled:  request.Body.OpenTrackingEnabled,
@@ -42,10 +116,13 @@ func (s *StrictHandler) CreateSequence(ctx context.Context, request openapi.Crea
 
 	createdSequence, createdSteps, err := s.svc.CreateSequence(ctx, &sequence, steps)
 	if err != nil {
-		return nil, ErrInternal("Failed to create sequence")
+		return nil, errors.Wrap(err, "Failed to create sequence")
 	}
 
-	return openapi.CreateSequence201JSONResponse(SequenceFromDB(createdSequence, createdSteps)), nil
+	return openapi.CreateSequence201JSONResponse{
+		Body:    SequenceFromDB(createdSequence, createdSteps),
+		Headers: openapi.CreateSequence201ResponseHeaders{ETag: etag(createdSequence.Version)},
+	}, nil
 }
 
 func (s *StrictHandler) UpdateSequence(ctx context.Context, request openapi.UpdateSequenceRequestObject) (openapi.UpdateSequenceResponseObject, error) {
@@ -54,13 +131,71 @@ func (s *StrictHandler) UpdateSequence(ctx context.Context, request openapi.Upda
 		return nil, ErrBadRequest("Invalid sequence ID")
 	}
 
-	updatedSequence, updatedSteps, err := s.svc.UpdateSequence(ctx, id, request.Body.OpenTrackingEnabled, request.Body.ClickTrackingEnabled)
+	version, err := ifMatchVersion(request.Params.IfMatch)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound("Sequence not found")
-		}
-		return nil, ErrInternal("Failed to create sequence")
+		return nil, err
+	}
+
+	if err := validateUpdateSequence(request.Body); err != nil {
+		return nil, err
+	}
+
+	update := sequence.SequenceUpdate{
+		Version:              version,
+		Name:                 request.Body.Name,
+		OpenTrackingEnabled:  request.Body.OpenTrackingEnabled,
+		ClickTrackingEnabled: request.Body.ClickTrackingEnabled,
+	}
+	if request.Body.Steps != nil {
+		update.Steps = lo.Map(*request.Body.Steps, func(step openapi.SequenceStepInput, _ int) *models.SequenceStep {
+			return &models.SequenceStep{
+				ID:                    lo.FromPtr(step.Id),
+				EmailSubject:          step.EmailSubject,
+				EmailContent:          step.EmailContent,
+				DaysAfterPreviousStep: int32(step.DaysAfterPreviousStep),
+			}
+		})
+	}
+
+	updatedSequence, updatedSteps, err := s.svc.UpdateSequence(ctx, id, update)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to update sequence")
+	}
+
+	return openapi.UpdateSequence200JSONResponse{
+		Body:    SequenceFromDB(updatedSequence, updatedSteps),
+		Headers: openapi.UpdateSequence200ResponseHeaders{ETag: etag(updatedSequence.Version)},
+	}, nil
+}
+
+func (s *StrictHandler) DeleteSequence(ctx context.Context, request openapi.DeleteSequenceRequestObject) (openapi.DeleteSequenceResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	hard := request.Params.Hard != nil && *request.Params.Hard
+	err = s.svc.DeleteSequence(ctx, id, hard)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to delete sequence")
+	}
+
+	return openapi.DeleteSequence204Response{}, nil
+}
+
+func (s *StrictHandler) RestoreSequence(ctx context.Context, request openapi.RestoreSequenceRequestObject) (openapi.RestoreSequenceResponseObject, error) {
+	id, err := uuid.Parse(request.Id)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	restored, steps, err := s.svc.RestoreSequence(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to restore sequence")
 	}
 
-	return openapi.UpdateSequence200JSONResponse(SequenceFromDB(updatedSequence, updatedSteps)), nil
+	return openapi.RestoreSequence200JSONResponse{
+		Body:    SequenceFromDB(restored, steps),
+		Headers: openapi.RestoreSequence200ResponseHeaders{ETag: etag(restored.Version)},
+	}, nil
 }
@@ -2,15 +2,18 @@ package server
 
 import (
 	"context"
-	"database/sql"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/apperr"
 	"github.com/pirellik/sequence-api/internal/db/models"
 	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pirellik/sequence-api/pkg/pointer"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -66,7 +69,7 @@ func TestCreateSequence(t *testing.T) {
 					{
 						EmailSubject:          "Test Subject",
 						EmailContent:          "Test Content",
-						DaysAfterPreviousStep: 1,
+						DaysAfterPreviousStep: 0,
 					},
 				},
 			},
@@ -98,7 +101,7 @@ func TestCreateSequence(t *testing.T) {
 		response, err := handler.CreateSequence(ctx, request)
 		assert.NoError(t, err)
 
-		result := response.(openapi.CreateSequence201JSONResponse)
+		result := response.(openapi.CreateSequence201JSONResponse).Body
 		assert.Equal(t, expectedSequence.ID, result.Id)
 		assert.Equal(t, expectedSequence.Name, result.Name)
 		assert.Equal(t, expectedSequence.OpenTrackingEnabled, result.OpenTrackingEnabled)
@@ -106,7 +109,7 @@ func TestCreateSequence(t *testing.T) {
 		assert.Len(t, result.Steps, 1)
 	})
 
-	t.Run("successful creation without steps", func(t *testing.T) {
+	t.Run("rejects sequence without steps", func(t *testing.T) {
 		request := openapi.CreateSequenceRequestObject{
 			Body: &openapi.Sequence{
 				Name:                 "Test Sequence",
@@ -116,29 +119,9 @@ func TestCreateSequence(t *testing.T) {
 			},
 		}
 
-		now := time.Now()
-		expectedSequence := &models.Sequence{
-			ID:                   uuid.New(),
-			Name:                 request.Body.Name,
-			OpenTrackingEnabled:  request.Body.OpenTrackingEnabled,
-			ClickTrackingEnabled: request.Body.ClickTrackingEnabled,
-			CreatedAt:            pgtype.Timestamptz{Time: now, Valid: true},
-			UpdatedAt:            pgtype.Timestamptz{Time: now, Valid: true},
-		}
-
-		mockService.EXPECT().
-			CreateSequence(ctx, gomock.Any(), gomock.Any()).
-			Return(expectedSequence, []*models.SequenceStep{}, nil)
-
 		response, err := handler.CreateSequence(ctx, request)
-		assert.NoError(t, err)
-
-		result := response.(openapi.CreateSequence201JSONResponse)
-		assert.Equal(t, expectedSequence.ID, result.Id)
-		assert.Equal(t, expectedSequence.Name, result.Name)
-		assert.Equal(t, expectedSequence.OpenTrackingEnabled, result.OpenTrackingEnabled)
-		assert.Equal(t, expectedSequence.ClickTrackingEnabled, result.ClickTrackingEnabled)
-		assert.Empty(t, result.Steps)
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrValidation)
 	})
 
 	t.Run("handles service error", func(t *testing.T) {
@@ -147,7 +130,13 @@ func TestCreateSequence(t *testing.T) {
 				Name:                 "Test Sequence",
 				OpenTrackingEnabled:  true,
 				ClickTrackingEnabled: false,
-				Steps:                []openapi.SequenceStep{},
+				Steps: []openapi.SequenceStep{
+					{
+						EmailSubject:          "Test Subject",
+						EmailContent:          "Test Content",
+						DaysAfterPreviousStep: 0,
+					},
+				},
 			},
 		}
 
@@ -203,13 +192,16 @@ func TestUpdateSequence(t *testing.T) {
 		}
 
 		mockService.EXPECT().
-			UpdateSequence(ctx, sequenceID, &openTracking, &clickTracking).
+			UpdateSequence(ctx, sequenceID, sequence.SequenceUpdate{
+				OpenTrackingEnabled:  &openTracking,
+				ClickTrackingEnabled: &clickTracking,
+			}).
 			Return(expectedSequence, expectedSteps, nil)
 
 		response, err := handler.UpdateSequence(ctx, request)
 		assert.NoError(t, err)
 
-		result := response.(openapi.UpdateSequence200JSONResponse)
+		result := response.(openapi.UpdateSequence200JSONResponse).Body
 		assert.Equal(t, expectedSequence.ID, result.Id)
 		assert.Equal(t, expectedSequence.Name, result.Name)
 		assert.Equal(t, expectedSequence.OpenTrackingEnabled, result.OpenTrackingEnabled)
@@ -243,13 +235,16 @@ func TestUpdateSequence(t *testing.T) {
 		}
 
 		mockService.EXPECT().
-			UpdateSequence(ctx, sequenceID, &openTracking, &clickTracking).
-			Return(nil, nil, sql.ErrNoRows)
+			UpdateSequence(ctx, sequenceID, sequence.SequenceUpdate{
+				OpenTrackingEnabled:  &openTracking,
+				ClickTrackingEnabled: &clickTracking,
+			}).
+			Return(nil, nil, apperr.ErrSequenceNotFound)
 
 		response, err := handler.UpdateSequence(ctx, request)
 		assert.Nil(t, response)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "Sequence not found")
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
 	})
 
 	t.Run("handles partial update", func(t *testing.T) {
@@ -274,17 +269,337 @@ func TestUpdateSequence(t *testing.T) {
 		}
 
 		mockService.EXPECT().
-			UpdateSequence(ctx, sequenceID, &openTracking, nil).
+			UpdateSequence(ctx, sequenceID, sequence.SequenceUpdate{OpenTrackingEnabled: &openTracking}).
 			Return(expectedSequence, []*models.SequenceStep{}, nil)
 
 		response, err := handler.UpdateSequence(ctx, request)
 		assert.NoError(t, err)
 
-		result := response.(openapi.UpdateSequence200JSONResponse)
+		result := response.(openapi.UpdateSequence200JSONResponse).Body
 		assert.Equal(t, expectedSequence.ID, result.Id)
 		assert.Equal(t, expectedSequence.Name, result.Name)
 		assert.Equal(t, expectedSequence.OpenTrackingEnabled, result.OpenTrackingEnabled)
 		assert.Equal(t, expectedSequence.ClickTrackingEnabled, result.ClickTrackingEnabled)
 		assert.Empty(t, result.Steps)
 	})
+
+	t.Run("renames and replaces steps", func(t *testing.T) {
+		sequenceID := uuid.New()
+		existingStepID := uuid.New()
+
+		request := openapi.UpdateSequenceRequestObject{
+			Id: sequenceID.String(),
+			Body: &openapi.UpdateSequenceInput{
+				Name: pointer.To("Renamed Sequence"),
+				Steps: &[]openapi.SequenceStepInput{
+					{EmailSubject: "New Subject", EmailContent: "New Content", DaysAfterPreviousStep: 0},
+					{Id: &existingStepID, EmailSubject: "Subject", EmailContent: "Content", DaysAfterPreviousStep: 2},
+				},
+			},
+		}
+
+		expectedSteps := []*models.SequenceStep{
+			{ID: uuid.New(), EmailSubject: "New Subject", EmailContent: "New Content"},
+			{ID: existingStepID, EmailSubject: "Subject", EmailContent: "Content", DaysAfterPreviousStep: 2},
+		}
+
+		mockService.EXPECT().
+			UpdateSequence(ctx, sequenceID, sequence.SequenceUpdate{
+				Name: pointer.To("Renamed Sequence"),
+				Steps: []*models.SequenceStep{
+					{EmailSubject: "New Subject", EmailContent: "New Content"},
+					{ID: existingStepID, EmailSubject: "Subject", EmailContent: "Content", DaysAfterPreviousStep: 2},
+				},
+			}).
+			Return(&models.Sequence{ID: sequenceID, Name: "Renamed Sequence"}, expectedSteps, nil)
+
+		response, err := handler.UpdateSequence(ctx, request)
+		require.NoError(t, err)
+
+		result := response.(openapi.UpdateSequence200JSONResponse).Body
+		assert.Equal(t, "Renamed Sequence", result.Name)
+		require.Len(t, result.Steps, 2)
+		assert.Equal(t, existingStepID, result.Steps[1].Id)
+	})
+
+	t.Run("rejects invalid steps", func(t *testing.T) {
+		request := openapi.UpdateSequenceRequestObject{
+			Id: uuid.New().String(),
+			Body: &openapi.UpdateSequenceInput{
+				Name:  pointer.To(""),
+				Steps: &[]openapi.SequenceStepInput{},
+			},
+		}
+
+		response, err := handler.UpdateSequence(ctx, request)
+		assert.Nil(t, response)
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "name", Message: "must not be empty"},
+			{Field: "steps", Message: "must contain at least one step"},
+		}, validationErr.Fields)
+	})
+}
+
+func TestGetSequence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+
+	t.Run("successful get", func(t *testing.T) {
+		sequenceID := uuid.New()
+		now := time.Now()
+		expectedSequence := &models.Sequence{
+			ID:                   sequenceID,
+			Name:                 "Test Sequence",
+			OpenTrackingEnabled:  true,
+			ClickTrackingEnabled: true,
+			CreatedAt:            pgtype.Timestamptz{Time: now, Valid: true},
+			UpdatedAt:            pgtype.Timestamptz{Time: now, Valid: true},
+			Version:              4,
+		}
+
+		expectedSteps := []*models.SequenceStep{
+			{
+				ID:                    uuid.New(),
+				EmailSubject:          "Test Subject",
+				EmailContent:          "Test Content",
+				DaysAfterPreviousStep: 1,
+			},
+		}
+
+		mockService.EXPECT().
+			GetSequence(ctx, sequenceID).
+			Return(expectedSequence, expectedSteps, nil)
+
+		response, err := handler.GetSequence(ctx, openapi.GetSequenceRequestObject{Id: sequenceID.String()})
+		assert.NoError(t, err)
+
+		result := response.(openapi.GetSequence200JSONResponse)
+		assert.Equal(t, expectedSequence.ID, result.Body.Id)
+		assert.Equal(t, expectedSequence.Name, result.Body.Name)
+		assert.Len(t, result.Body.Steps, 1)
+		assert.Equal(t, `"4"`, result.Headers.ETag)
+	})
+
+	t.Run("handles invalid UUID", func(t *testing.T) {
+		response, err := handler.GetSequence(ctx, openapi.GetSequenceRequestObject{Id: "invalid-uuid"})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid sequence ID")
+	})
+
+	t.Run("handles not found error", func(t *testing.T) {
+		sequenceID := uuid.New()
+
+		mockService.EXPECT().
+			GetSequence(ctx, sequenceID).
+			Return(nil, nil, apperr.ErrSequenceNotFound)
+
+		response, err := handler.GetSequence(ctx, openapi.GetSequenceRequestObject{Id: sequenceID.String()})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
+}
+
+func TestListSequences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+
+	t.Run("defaults to newest first", func(t *testing.T) {
+		first := &models.Sequence{ID: uuid.New(), Name: "First"}
+		second := &models.Sequence{ID: uuid.New(), Name: "Second"}
+		nextCursor := "next"
+
+		mockService.EXPECT().
+			ListSequences(ctx, sequence.ListSequencesParams{
+				Sort:       sequence.SortByCreatedAt,
+				Descending: true,
+				Limit:      defaultListLimit,
+			}).
+			Return(&sequence.SequencePage{
+				Sequences: []*models.Sequence{first, second},
+				Steps: map[uuid.UUID][]*models.SequenceStep{
+					first.ID: {{ID: uuid.New(), EmailSubject: "Subject"}},
+				},
+				NextCursor: &nextCursor,
+			}, nil)
+
+		response, err := handler.ListSequences(ctx, openapi.ListSequencesRequestObject{})
+		assert.NoError(t, err)
+
+		result := response.(openapi.ListSequences200JSONResponse)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, first.ID, result.Items[0].Id)
+		assert.Len(t, result.Items[0].Steps, 1)
+		assert.Equal(t, second.ID, result.Items[1].Id)
+		assert.Empty(t, result.Items[1].Steps)
+		assert.Equal(t, &nextCursor, result.NextCursor)
+	})
+
+	t.Run("passes filters through", func(t *testing.T) {
+		name := "test"
+		cursor := "cursor"
+		sort := openapi.SortName
+
+		mockService.EXPECT().
+			ListSequences(ctx, sequence.ListSequencesParams{
+				Name:   &name,
+				Sort:   sequence.SortByName,
+				Cursor: &cursor,
+				Limit:  5,
+			}).
+			Return(&sequence.SequencePage{}, nil)
+
+		response, err := handler.ListSequences(ctx, openapi.ListSequencesRequestObject{
+			Params: openapi.ListSequencesParams{
+				Name:   &name,
+				Sort:   &sort,
+				Cursor: &cursor,
+				Limit:  pointer.To(5),
+			},
+		})
+		assert.NoError(t, err)
+
+		result := response.(openapi.ListSequences200JSONResponse)
+		assert.Empty(t, result.Items)
+		assert.Nil(t, result.NextCursor)
+	})
+
+	t.Run("handles invalid limit", func(t *testing.T) {
+		response, err := handler.ListSequences(ctx, openapi.ListSequencesRequestObject{
+			Params: openapi.ListSequencesParams{Limit: pointer.To(maxListLimit + 1)},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid limit")
+	})
+
+	t.Run("handles invalid cursor", func(t *testing.T) {
+		mockService.EXPECT().
+			ListSequences(ctx, gomock.Any()).
+			Return(nil, sequence.ErrInvalidCursor)
+
+		response, err := handler.ListSequences(ctx, openapi.ListSequencesRequestObject{
+			Params: openapi.ListSequencesParams{Cursor: pointer.To("garbage")},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, sequence.ErrInvalidCursor)
+	})
+
+	t.Run("handles service error", func(t *testing.T) {
+		mockService.EXPECT().
+			ListSequences(ctx, gomock.Any()).
+			Return(nil, assert.AnError)
+
+		response, err := handler.ListSequences(ctx, openapi.ListSequencesRequestObject{})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Failed to list sequences")
+	})
+}
+
+func TestDeleteSequence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+
+	t.Run("archives by default", func(t *testing.T) {
+		sequenceID := uuid.New()
+
+		mockService.EXPECT().
+			DeleteSequence(ctx, sequenceID, false).
+			Return(nil)
+
+		response, err := handler.DeleteSequence(ctx, openapi.DeleteSequenceRequestObject{Id: sequenceID.String()})
+		assert.NoError(t, err)
+		assert.IsType(t, openapi.DeleteSequence204Response{}, response)
+	})
+
+	t.Run("hard delete", func(t *testing.T) {
+		sequenceID := uuid.New()
+
+		mockService.EXPECT().
+			DeleteSequence(ctx, sequenceID, true).
+			Return(nil)
+
+		response, err := handler.DeleteSequence(ctx, openapi.DeleteSequenceRequestObject{
+			Id:     sequenceID.String(),
+			Params: openapi.DeleteSequenceParams{Hard: pointer.To(true)},
+		})
+		assert.NoError(t, err)
+		assert.IsType(t, openapi.DeleteSequence204Response{}, response)
+	})
+
+	t.Run("handles invalid UUID", func(t *testing.T) {
+		response, err := handler.DeleteSequence(ctx, openapi.DeleteSequenceRequestObject{Id: "invalid-uuid"})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid sequence ID")
+	})
+
+	t.Run("handles not found error", func(t *testing.T) {
+		sequenceID := uuid.New()
+
+		mockService.EXPECT().
+			DeleteSequence(ctx, sequenceID, false).
+			Return(apperr.ErrSequenceNotFound)
+
+		response, err := handler.DeleteSequence(ctx, openapi.DeleteSequenceRequestObject{Id: sequenceID.String()})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
+}
+
+func TestRestoreSequence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+
+	t.Run("successful restore", func(t *testing.T) {
+		sequenceID := uuid.New()
+		expectedSequence := &models.Sequence{ID: sequenceID, Name: "Test Sequence"}
+
+		mockService.EXPECT().
+			RestoreSequence(ctx, sequenceID).
+			Return(expectedSequence, []*models.SequenceStep{}, nil)
+
+		response, err := handler.RestoreSequence(ctx, openapi.RestoreSequenceRequestObject{Id: sequenceID.String()})
+		assert.NoError(t, err)
+
+		result := response.(openapi.RestoreSequence200JSONResponse).Body
+		assert.Equal(t, sequenceID, result.Id)
+		assert.Nil(t, result.ArchivedAt)
+	})
+
+	t.Run("handles not found error", func(t *testing.T) {
+		sequenceID := uuid.New()
+
+		mockService.EXPECT().
+			RestoreSequence(ctx, sequenceID).
+			Return(nil, nil, apperr.ErrSequenceNotFound)
+
+		response, err := handler.RestoreSequence(ctx, openapi.RestoreSequenceRequestObject{Id: sequenceID.String()})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
 }
@@ -5,57 +5,77 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/netip"
+	"time"
 
 	"github.com/pirellik/sequence-api/internal/openapi"
 	"github.com/pirellik/sequence-api/pkg/middleware"
-	"github.com/pkg/errors"
 )
 
-func New(svc openapi.StrictServerInterface, port int) *http.Server {
+type Options struct {
+	Port int
+	// IdempotencyStore enables Idempotency-Key handling on POST requests when
+	// set. Stored responses are replayed for IdempotencyTTL, keys of requests
+	// in progress are held for IdempotencyLease.
+	IdempotencyStore middleware.IdempotencyStore
+	IdempotencyTTL   time.Duration
+	IdempotencyLease time.Duration
+	// TrustedProxies are the networks of the proxies in front of the API,
+	// whose X-Forwarded-For headers name the client.
+	TrustedProxies []netip.Prefix
+}
+
+func New(svc openapi.StrictServerInterface, opts Options) *http.Server {
 	strictHandler := openapi.NewStrictHandlerWithOptions(svc, nil, openapi.StrictHTTPServerOptions{
-		RequestErrorHandlerFunc:  errorHandler,
+		RequestErrorHandlerFunc:  requestErrorHandler,
 		ResponseErrorHandlerFunc: errorHandler,
 	})
 
 	r := http.NewServeMux()
-	handler := openapi.HandlerFromMux(strictHandler, r)
+	handler := openapi.HandlerWithOptions(strictHandler, openapi.StdHTTPServerOptions{
+		BaseRouter:       r,
+		ErrorHandlerFunc: requestErrorHandler,
+	})
+	if opts.IdempotencyStore != nil {
+		handler = middleware.Idempotency(opts.IdempotencyStore, opts.IdempotencyTTL, opts.IdempotencyLease, errorHandler)(handler)
+	}
 	handler = middleware.Apply(handler,
 		middleware.Logging,
 		middleware.RequestID,
+		middleware.ClientIP(opts.TrustedProxies),
 	)
 	return &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
+		Addr:    fmt.Sprintf(":%d", opts.Port),
 		Handler: handler,
 	}
 }
 
 func errorHandler(w http.ResponseWriter, r *http.Request, err error) {
-	slog.ErrorContext(r.Context(), "error", "err", err)
 	if err == nil {
-		writeErrorResponse(w, r, openapi.Error{
-			Message: "internal server error",
-			Status:  http.StatusInternalServerError,
-		})
-		return
+		err = ErrInternal("internal server error")
 	}
+	apiErr := toAPIError(err)
 
-	err = errors.Cause(err)
-	apiErr, ok := err.(*APIError)
-	if !ok {
-		writeErrorResponse(w, r, openapi.Error{
-			Message: "internal server error",
-			Status:  http.StatusInternalServerError,
-		})
-		return
+	// Client errors are part of normal operation, only server errors need
+	// someone to look at them.
+	level := slog.LevelInfo
+	if apiErr.Code >= http.StatusInternalServerError {
+		level = slog.LevelError
 	}
+	slog.Log(r.Context(), level, "error", "err", err, "status", apiErr.Code)
 
-	writeErrorResponse(w, r, openapi.Error{
-		Message: apiErr.Error(),
-		Status:  int64(apiErr.StatusCode()),
-	})
+	writeErrorResponse(w, r, apiErr.Problem(r.Header.Get("X-Request-ID")))
+}
+
+// requestErrorHandler handles requests that could not be decoded, such as
+// malformed JSON bodies or query parameters of the wrong type.
+func requestErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	errorHandler(w, r, ErrBadRequest(err.Error()))
 }
 
 func writeErrorResponse(w http.ResponseWriter, r *http.Request, e openapi.Error) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(int(e.Status))
 	err := json.NewEncoder(w).Encode(e)
 	if err != nil {
 		slog.ErrorContext(r.Context(), "failed to encode error response", "error", err)
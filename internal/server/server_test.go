@@ -0,0 +1,172 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/pkg/middleware"
+	"github.com/pirellik/sequence-api/pkg/pointer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantMessage string
+		wantErrors  []openapi.ErrorDetail
+	}{
+		{
+			name:        "api error",
+			err:         ErrBadRequest("Invalid sequence ID"),
+			wantStatus:  http.StatusBadRequest,
+			wantMessage: "Invalid sequence ID",
+		},
+		{
+			name:        "wrapped sequence not found",
+			err:         errors.Wrap(apperr.ErrSequenceNotFound, "Failed to get sequence"),
+			wantStatus:  http.StatusNotFound,
+			wantMessage: "Sequence not found",
+		},
+		{
+			name:        "wrapped step not found",
+			err:         errors.Wrap(fmt.Errorf("updating: %w", apperr.ErrStepNotFound), "Failed to update sequence step"),
+			wantStatus:  http.StatusNotFound,
+			wantMessage: "Sequence step not found",
+		},
+		{
+			name:        "conflict",
+			err:         errors.Wrap(apperr.ErrConflict, "Failed to update sequence"),
+			wantStatus:  http.StatusConflict,
+			wantMessage: "Conflict",
+		},
+		{
+			name:        "idempotency key reused",
+			err:         middleware.ErrIdempotencyKeyReused,
+			wantStatus:  http.StatusUnprocessableEntity,
+			wantMessage: "Idempotency-Key was already used with a different request",
+		},
+		{
+			name:        "idempotency key in use",
+			err:         middleware.ErrIdempotencyKeyInUse,
+			wantStatus:  http.StatusConflict,
+			wantMessage: "A request with this Idempotency-Key is still being processed",
+		},
+		{
+			name:        "request body too large",
+			err:         &http.MaxBytesError{Limit: 1024},
+			wantStatus:  http.StatusRequestEntityTooLarge,
+			wantMessage: "Request body must be at most 1024 bytes",
+		},
+		{
+			name:        "version mismatch",
+			err:         errors.Wrap(apperr.ErrVersionMismatch, "Failed to update sequence step"),
+			wantStatus:  http.StatusPreconditionFailed,
+			wantMessage: "Resource has been modified",
+		},
+		{
+			name:        "validation",
+			err:         errors.Wrap(apperr.Validation(apperr.FieldError{Field: "cursor", Message: "invalid cursor"}), "Failed to list sequences"),
+			wantStatus:  http.StatusUnprocessableEntity,
+			wantMessage: "Validation failed",
+			wantErrors: []openapi.ErrorDetail{
+				{Field: "cursor", Pointer: "/cursor", Message: "invalid cursor"},
+			},
+		},
+		{
+			name:        "unknown error",
+			err:         errors.Wrap(assert.AnError, "Failed to create sequence"),
+			wantStatus:  http.StatusInternalServerError,
+			wantMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/v1/sequences", nil)
+			r.Header.Set("X-Request-ID", "request-id")
+
+			errorHandler(w, r, tt.err)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+			var body openapi.Error
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+			assert.Equal(t, "about:blank", body.Type)
+			assert.Equal(t, http.StatusText(tt.wantStatus), body.Title)
+			assert.Equal(t, int64(tt.wantStatus), body.Status)
+			assert.Equal(t, &tt.wantMessage, body.Detail)
+			assert.Equal(t, pointer.To("request-id"), body.Instance)
+			if tt.wantErrors == nil {
+				assert.Nil(t, body.Errors)
+			} else {
+				require.NotNil(t, body.Errors)
+				assert.Equal(t, tt.wantErrors, *body.Errors)
+			}
+		})
+	}
+}
+
+func TestErrorHandlerLogLevel(t *testing.T) {
+	var logs bytes.Buffer
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	tests := []struct {
+		name      string
+		err       error
+		wantLevel string
+	}{
+		{name: "client error", err: errors.Wrap(apperr.ErrSequenceNotFound, "Failed to get sequence"), wantLevel: "INFO"},
+		{name: "server error", err: errors.Wrap(assert.AnError, "Failed to get sequence"), wantLevel: "ERROR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs.Reset()
+			errorHandler(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/sequences", nil), tt.err)
+
+			var entry struct{ Level string }
+			require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+			assert.Equal(t, tt.wantLevel, entry.Level)
+		})
+	}
+}
+
+func TestMalformedRequest(t *testing.T) {
+	srv := New(&StrictHandler{}, Options{})
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodPost, "/v1/sequences", strings.NewReader("{"))
+	r.Header.Set("Content-Type", "application/json")
+	srv.Handler.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+	var body openapi.Error
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+	assert.Equal(t, int64(http.StatusBadRequest), body.Status)
+	assert.NotEmpty(t, body.Instance)
+}
+
+func TestFieldPointer(t *testing.T) {
+	assert.Equal(t, "", fieldPointer(""))
+	assert.Equal(t, "/name", fieldPointer("name"))
+	assert.Equal(t, "/steps/1/emailSubject", fieldPointer("steps[1].emailSubject"))
+	assert.Equal(t, "/a~1b/c~0d", fieldPointer("a/b.c~d"))
+}
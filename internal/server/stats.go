@@ -0,0 +1,67 @@
+package server
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+func StatsCountsFromDomain(counts sequence.Counts) openapi.StatsCounts {
+	rates := counts.Rates()
+	return openapi.StatsCounts{
+		Sent:         int(counts.Sent),
+		Delivered:    int(counts.Delivered),
+		Opened:       int(counts.Opened),
+		Opens:        int(counts.Opens),
+		Clicked:      int(counts.Clicked),
+		Clicks:       int(counts.Clicks),
+		Replied:      int(counts.Replied),
+		Bounced:      int(counts.Bounced),
+		Unsubscribed: int(counts.Unsubscribed),
+		Rates: openapi.StatsRates{
+			Delivery:    rates.Delivery,
+			Open:        rates.Open,
+			Click:       rates.Click,
+			Reply:       rates.Reply,
+			Bounce:      rates.Bounce,
+			Unsubscribe: rates.Unsubscribe,
+		},
+	}
+}
+
+func SequenceStatsFromDomain(stats *sequence.Stats) openapi.SequenceStats {
+	steps := make([]openapi.StepStats, len(stats.Steps))
+	for i, step := range stats.Steps {
+		steps[i] = openapi.StepStats{StepId: step.StepID, Counts: StatsCountsFromDomain(step.Counts)}
+	}
+	return openapi.SequenceStats{
+		Total: StatsCountsFromDomain(stats.Total),
+		Steps: steps,
+	}
+}
+
+func (s *StrictHandler) GetSequenceStats(ctx context.Context, request openapi.GetSequenceStatsRequestObject) (openapi.GetSequenceStatsResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	if err := validateStatsParams(request.Params); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.svc.GetStats(ctx, sequenceID, sequence.StatsFilter{
+		From:             request.Params.From,
+		To:               request.Params.To,
+		IncludeAutomated: lo.FromPtr(request.Params.IncludeAutomated),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to get sequence stats")
+	}
+
+	return openapi.GetSequenceStats200JSONResponse(SequenceStatsFromDomain(stats)), nil
+}
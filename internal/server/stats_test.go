@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pirellik/sequence-api/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetSequenceStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+	sequenceID := uuid.New()
+	stepID := uuid.New()
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("returns the stats", func(t *testing.T) {
+		counts := sequence.Counts{Sent: 4, Delivered: 2, Bounced: 2, Opened: 1, Opens: 3, Clicked: 1, Clicks: 1, Replied: 1, Unsubscribed: 1}
+		mockService.EXPECT().
+			GetStats(ctx, sequenceID, sequence.StatsFilter{From: &from, To: &to, IncludeAutomated: true}).
+			Return(&sequence.Stats{Total: counts, Steps: []sequence.StepStats{{StepID: stepID, Counts: counts}}}, nil)
+
+		response, err := handler.GetSequenceStats(ctx, openapi.GetSequenceStatsRequestObject{
+			SequenceId: sequenceID.String(),
+			Params:     openapi.GetSequenceStatsParams{From: &from, To: &to, IncludeAutomated: pointer.To(true)},
+		})
+		require.NoError(t, err)
+		expected := openapi.StatsCounts{
+			Sent: 4, Delivered: 2, Bounced: 2, Opened: 1, Opens: 3, Clicked: 1, Clicks: 1, Replied: 1, Unsubscribed: 1,
+			Rates: openapi.StatsRates{Delivery: 0.5, Bounce: 0.5, Open: 0.5, Click: 0.5, Reply: 0.5, Unsubscribe: 0.5},
+		}
+		assert.Equal(t, openapi.GetSequenceStats200JSONResponse{
+			Total: expected,
+			Steps: []openapi.StepStats{{StepId: stepID, Counts: expected}},
+		}, response)
+	})
+
+	t.Run("rejects empty time ranges", func(t *testing.T) {
+		_, err := handler.GetSequenceStats(ctx, openapi.GetSequenceStatsRequestObject{
+			SequenceId: sequenceID.String(),
+			Params:     openapi.GetSequenceStatsParams{From: &to, To: &from},
+		})
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{{Field: "to", Message: "must be after from"}}, validationErr.Fields)
+	})
+
+	t.Run("handles invalid UUID", func(t *testing.T) {
+		_, err := handler.GetSequenceStats(ctx, openapi.GetSequenceStatsRequestObject{SequenceId: "invalid-uuid"})
+		assert.ErrorContains(t, err, "Invalid sequence ID")
+	})
+
+	t.Run("handles sequence not found", func(t *testing.T) {
+		mockService.EXPECT().GetStats(ctx, sequenceID, sequence.StatsFilter{}).Return(nil, apperr.ErrSequenceNotFound)
+
+		_, err := handler.GetSequenceStats(ctx, openapi.GetSequenceStatsRequestObject{SequenceId: sequenceID.String()})
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
+}
@@ -2,12 +2,16 @@ package server
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 
 	"github.com/google/uuid"
+	"github.com/oapi-codegen/nullable"
 	"github.com/pirellik/sequence-api/internal/db/models"
 	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/render"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pirellik/sequence-api/pkg/pointer"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
 )
 
 func SequenceStepFromDB(step *models.SequenceStep) openapi.SequenceStep {
@@ -21,6 +25,64 @@ func SequenceStepFromDB(step *models.SequenceStep) openapi.SequenceStep {
 	}
 }
 
+func (s *StrictHandler) CreateSequenceStep(ctx context.Context, request openapi.CreateSequenceStepRequestObject) (openapi.CreateSequenceStepResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	if err := validateCreateSequenceStep(request.Body); err != nil {
+		return nil, err
+	}
+
+	step := models.SequenceStep{
+		EmailSubject:          request.Body.EmailSubject,
+		EmailContent:          request.Body.EmailContent,
+		DaysAfterPreviousStep: int32(request.Body.DaysAfterPreviousStep),
+	}
+	pos := sequence.Position{
+		AfterStepID:  request.Body.AfterStepId,
+		BeforeStepID: request.Body.BeforeStepId,
+	}
+
+	created, err := s.svc.CreateSequenceStep(ctx, sequenceID, &step, pos)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to create sequence step")
+	}
+
+	return openapi.CreateSequenceStep201JSONResponse{
+		Body:    SequenceStepFromDB(created),
+		Headers: openapi.CreateSequenceStep201ResponseHeaders{ETag: etag(created.Version)},
+	}, nil
+}
+
+func (s *StrictHandler) MoveSequenceStep(ctx context.Context, request openapi.MoveSequenceStepRequestObject) (openapi.MoveSequenceStepResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	stepID, err := uuid.Parse(request.StepId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid step ID")
+	}
+
+	pos := sequence.Position{
+		AfterStepID:  request.Body.AfterStepId,
+		BeforeStepID: request.Body.BeforeStepId,
+	}
+
+	moved, steps, err := s.svc.MoveSequenceStep(ctx, sequenceID, stepID, pos)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to move sequence step")
+	}
+
+	return openapi.MoveSequenceStep200JSONResponse{
+		Body:    SequenceFromDB(moved, steps),
+		Headers: openapi.MoveSequenceStep200ResponseHeaders{ETag: etag(moved.Version)},
+	}, nil
+}
+
 func (s *StrictHandler) UpdateSequenceStep(ctx context.Context, request openapi.UpdateSequenceStepRequestObject) (openapi.UpdateSequenceStepResponseObject, error) {
 	sequenceID, err := uuid.Parse(request.SequenceId)
 	if err != nil {
@@ -32,15 +94,95 @@ func (s *StrictHandler) UpdateSequenceStep(ctx context.Context, request openapi.
 		return nil, ErrBadRequest("Invalid step ID")
 	}
 
-	step, err := s.svc.UpdateSequenceStep(ctx, sequenceID, stepID, request.Body.EmailSubject, request.Body.EmailContent)
+	version, err := ifMatchVersion(request.Params.IfMatch)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrNotFound("Sequence step not found")
-		}
-		return nil, ErrInternal("Failed to update sequence step")
+		return nil, err
+	}
+
+	if err := validateUpdateSequenceStep(request.Body); err != nil {
+		return nil, err
+	}
+
+	update := sequence.StepUpdate{
+		Version:               version,
+		EmailSubject:          &request.Body.EmailSubject,
+		EmailContent:          &request.Body.EmailContent,
+		DaysAfterPreviousStep: pointer.To(int32(request.Body.DaysAfterPreviousStep)),
+		Position:              stepPosition(request.Body.AfterStepId, request.Body.BeforeStepId),
 	}
 
-	return openapi.UpdateSequenceStep200JSONResponse(SequenceStepFromDB(step)), nil
+	step, err := s.svc.UpdateSequenceStep(ctx, sequenceID, stepID, update)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to update sequence step")
+	}
+
+	return openapi.UpdateSequenceStep200JSONResponse{
+		Body:    SequenceStepFromDB(step),
+		Headers: openapi.UpdateSequenceStep200ResponseHeaders{ETag: etag(step.Version)},
+	}, nil
+}
+
+func (s *StrictHandler) PatchSequenceStep(ctx context.Context, request openapi.PatchSequenceStepRequestObject) (openapi.PatchSequenceStepResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	stepID, err := uuid.Parse(request.StepId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid step ID")
+	}
+
+	version, err := ifMatchVersion(request.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePatchSequenceStep(request.Body); err != nil {
+		return nil, err
+	}
+
+	update := sequence.StepUpdate{
+		Version:      version,
+		EmailSubject: patchValue(request.Body.EmailSubject),
+		EmailContent: patchValue(request.Body.EmailContent),
+		Position:     stepPosition(request.Body.AfterStepId, request.Body.BeforeStepId),
+	}
+	if days := patchValue(request.Body.DaysAfterPreviousStep); days != nil {
+		update.DaysAfterPreviousStep = pointer.To(int32(*days))
+	}
+
+	step, err := s.svc.UpdateSequenceStep(ctx, sequenceID, stepID, update)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to patch sequence step")
+	}
+
+	return openapi.PatchSequenceStep200JSONResponse{
+		Body:    SequenceStepFromDB(step),
+		Headers: openapi.PatchSequenceStep200ResponseHeaders{ETag: etag(step.Version)},
+	}, nil
+}
+
+// stepPosition returns the position a step should be moved to, or nil when
+// the request leaves the step where it is.
+func stepPosition(afterStepID, beforeStepID *uuid.UUID) *sequence.Position {
+	if afterStepID == nil && beforeStepID == nil {
+		return nil
+	}
+	return &sequence.Position{
+		AfterStepID:  afterStepID,
+		BeforeStepID: beforeStepID,
+	}
+}
+
+// patchValue returns the value set by a merge patch, or nil when the field is
+// absent. Null values are rejected by validation before this is called.
+func patchValue[T any](value nullable.Nullable[T]) *T {
+	v, err := value.Get()
+	if err != nil {
+		return nil
+	}
+	return &v
 }
 
 func (s *StrictHandler) DeleteSequenceStep(ctx context.Context, request openapi.DeleteSequenceStepRequestObject) (openapi.DeleteSequenceStepResponseObject, error) {
@@ -56,8 +198,50 @@ func (s *StrictHandler) DeleteSequenceStep(ctx context.Context, request openapi.
 
 	err = s.svc.DeleteSequenceStep(ctx, sequenceID, stepID)
 	if err != nil {
-		return nil, ErrInternal("Failed to delete sequence step")
+		return nil, errors.Wrap(err, "Failed to delete sequence step")
 	}
 
 	return openapi.DeleteSequenceStep204Response{}, nil
 }
+
+func PreviewFromDomain(email *render.Email) openapi.Preview {
+	links := make([]openapi.PreviewLink, len(email.Links))
+	for i, link := range email.Links {
+		links[i] = openapi.PreviewLink{Url: link.URL, TrackingUrl: lo.EmptyableToPtr(link.TrackingURL)}
+	}
+	return openapi.Preview{
+		Subject:          email.Subject,
+		Html:             email.HTML,
+		Text:             email.Text,
+		Links:            links,
+		MissingVariables: append([]string{}, email.Missing...),
+	}
+}
+
+func (s *StrictHandler) PreviewSequenceStep(ctx context.Context, request openapi.PreviewSequenceStepRequestObject) (openapi.PreviewSequenceStepResponseObject, error) {
+	sequenceID, err := uuid.Parse(request.SequenceId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid sequence ID")
+	}
+
+	stepID, err := uuid.Parse(request.StepId)
+	if err != nil {
+		return nil, ErrBadRequest("Invalid step ID")
+	}
+
+	if err := validatePreview(request.Body); err != nil {
+		return nil, err
+	}
+
+	preview := sequence.Preview{ContactID: request.Body.ContactId}
+	if request.Body.Variables != nil {
+		preview.Variables = *request.Body.Variables
+	}
+
+	email, err := s.svc.PreviewStep(ctx, sequenceID, stepID, preview)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to preview sequence step")
+	}
+
+	return openapi.PreviewSequenceStep200JSONResponse(PreviewFromDomain(email)), nil
+}
@@ -2,15 +2,20 @@ package server
 
 import (
 	"context"
-	"database/sql"
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/pirellik/sequence-api/internal/apperr"
 	"github.com/pirellik/sequence-api/internal/db/models"
 	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/render"
+	"github.com/pirellik/sequence-api/internal/sequence"
+	"github.com/pirellik/sequence-api/pkg/pointer"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -35,6 +40,196 @@ func TestSequenceStepFromDB(t *testing.T) {
 	assert.Equal(t, &step.UpdatedAt.Time, result.UpdatedAt)
 }
 
+func TestCreateSequenceStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+
+	t.Run("successful creation", func(t *testing.T) {
+		sequenceID := uuid.New()
+		afterStepID := uuid.New()
+
+		request := openapi.CreateSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			Body: &openapi.CreateSequenceStepInput{
+				EmailSubject:          "Test Subject",
+				EmailContent:          "Test Content",
+				DaysAfterPreviousStep: 2,
+				AfterStepId:           &afterStepID,
+			},
+		}
+
+		expectedStep := &models.SequenceStep{
+			ID:                    uuid.New(),
+			SequenceID:            sequenceID,
+			EmailSubject:          "Test Subject",
+			EmailContent:          "Test Content",
+			DaysAfterPreviousStep: 2,
+		}
+
+		mockService.EXPECT().
+			CreateSequenceStep(ctx, sequenceID, &models.SequenceStep{
+				EmailSubject:          "Test Subject",
+				EmailContent:          "Test Content",
+				DaysAfterPreviousStep: 2,
+			}, sequence.Position{AfterStepID: &afterStepID}).
+			Return(expectedStep, nil)
+
+		response, err := handler.CreateSequenceStep(ctx, request)
+		assert.NoError(t, err)
+
+		result := response.(openapi.CreateSequenceStep201JSONResponse).Body
+		assert.Equal(t, expectedStep.ID, result.Id)
+		assert.Equal(t, expectedStep.EmailSubject, result.EmailSubject)
+		assert.Equal(t, 2, result.DaysAfterPreviousStep)
+	})
+
+	t.Run("handles invalid sequence UUID", func(t *testing.T) {
+		request := openapi.CreateSequenceStepRequestObject{
+			SequenceId: "invalid-uuid",
+			Body: &openapi.CreateSequenceStepInput{
+				EmailSubject: "Test Subject",
+				EmailContent: "Test Content",
+			},
+		}
+
+		response, err := handler.CreateSequenceStep(ctx, request)
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid sequence ID")
+	})
+
+	t.Run("handles invalid position", func(t *testing.T) {
+		sequenceID := uuid.New()
+
+		mockService.EXPECT().
+			CreateSequenceStep(ctx, sequenceID, gomock.Any(), gomock.Any()).
+			Return(nil, sequence.ErrInvalidPosition)
+
+		response, err := handler.CreateSequenceStep(ctx, openapi.CreateSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			Body: &openapi.CreateSequenceStepInput{
+				EmailSubject: "Test Subject",
+				EmailContent: "Test Content",
+			},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, sequence.ErrInvalidPosition)
+	})
+
+	t.Run("handles not found error", func(t *testing.T) {
+		sequenceID := uuid.New()
+
+		mockService.EXPECT().
+			CreateSequenceStep(ctx, sequenceID, gomock.Any(), gomock.Any()).
+			Return(nil, apperr.ErrSequenceNotFound)
+
+		response, err := handler.CreateSequenceStep(ctx, openapi.CreateSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			Body: &openapi.CreateSequenceStepInput{
+				EmailSubject: "Test Subject",
+				EmailContent: "Test Content",
+			},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, apperr.ErrSequenceNotFound)
+	})
+}
+
+func TestCreateSequenceStepValidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := &StrictHandler{svc: NewMockSequenceService(ctrl)}
+
+	response, err := handler.CreateSequenceStep(context.Background(), openapi.CreateSequenceStepRequestObject{
+		SequenceId: uuid.New().String(),
+		Body: &openapi.CreateSequenceStepInput{
+			EmailSubject:          "",
+			EmailContent:          "Test Content",
+			DaysAfterPreviousStep: -1,
+		},
+	})
+	assert.Nil(t, response)
+
+	var validationErr *apperr.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []apperr.FieldError{
+		{Field: "emailSubject", Message: "must not be empty"},
+		{Field: "daysAfterPreviousStep", Message: "must be between 0 and 365"},
+	}, validationErr.Fields)
+}
+
+func TestMoveSequenceStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+
+	t.Run("successful move", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+		beforeStepID := uuid.New()
+
+		expectedSteps := []*models.SequenceStep{
+			{ID: stepID},
+			{ID: beforeStepID},
+		}
+
+		mockService.EXPECT().
+			MoveSequenceStep(ctx, sequenceID, stepID, sequence.Position{BeforeStepID: &beforeStepID}).
+			Return(&models.Sequence{ID: sequenceID}, expectedSteps, nil)
+
+		response, err := handler.MoveSequenceStep(ctx, openapi.MoveSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       &openapi.StepPosition{BeforeStepId: &beforeStepID},
+		})
+		assert.NoError(t, err)
+
+		result := response.(openapi.MoveSequenceStep200JSONResponse).Body
+		assert.Equal(t, sequenceID, result.Id)
+		assert.Equal(t, stepID, result.Steps[0].Id)
+		assert.Equal(t, beforeStepID, result.Steps[1].Id)
+	})
+
+	t.Run("handles invalid step UUID", func(t *testing.T) {
+		response, err := handler.MoveSequenceStep(ctx, openapi.MoveSequenceStepRequestObject{
+			SequenceId: uuid.New().String(),
+			StepId:     "invalid-uuid",
+			Body:       &openapi.StepPosition{},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid step ID")
+	})
+
+	t.Run("handles not found error", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+
+		mockService.EXPECT().
+			MoveSequenceStep(ctx, sequenceID, stepID, sequence.Position{}).
+			Return(nil, nil, apperr.ErrStepNotFound)
+
+		response, err := handler.MoveSequenceStep(ctx, openapi.MoveSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       &openapi.StepPosition{},
+		})
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, apperr.ErrStepNotFound)
+	})
+}
+
 func TestUpdateSequenceStep(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -43,56 +238,131 @@ func TestUpdateSequenceStep(t *testing.T) {
 	handler := &StrictHandler{svc: mockService}
 	ctx := context.Background()
 
+	body := openapi.UpdateSequenceStepInput{
+		EmailSubject:          "Updated Subject",
+		EmailContent:          "Updated Content",
+		DaysAfterPreviousStep: 3,
+	}
+	update := sequence.StepUpdate{
+		EmailSubject:          pointer.To("Updated Subject"),
+		EmailContent:          pointer.To("Updated Content"),
+		DaysAfterPreviousStep: pointer.To(int32(3)),
+	}
+
 	t.Run("successful update", func(t *testing.T) {
 		sequenceID := uuid.New()
 		stepID := uuid.New()
 		now := time.Now()
 
-		emailSubject := "Updated Subject"
-		emailContent := "Updated Content"
-
 		request := openapi.UpdateSequenceStepRequestObject{
 			SequenceId: sequenceID.String(),
 			StepId:     stepID.String(),
-			Body: &openapi.UpdateSequenceStepInput{
-				EmailSubject: &emailSubject,
-				EmailContent: &emailContent,
-			},
+			Body:       &body,
 		}
 
 		expectedStep := &models.SequenceStep{
 			ID:                    stepID,
-			EmailSubject:          emailSubject,
-			EmailContent:          emailContent,
-			DaysAfterPreviousStep: 1,
+			EmailSubject:          body.EmailSubject,
+			EmailContent:          body.EmailContent,
+			DaysAfterPreviousStep: 3,
 			CreatedAt:             pgtype.Timestamptz{Time: now, Valid: true},
 			UpdatedAt:             pgtype.Timestamptz{Time: now, Valid: true},
 		}
 
 		mockService.EXPECT().
-			UpdateSequenceStep(ctx, sequenceID, stepID, &emailSubject, &emailContent).
+			UpdateSequenceStep(ctx, sequenceID, stepID, update).
 			Return(expectedStep, nil)
 
 		response, err := handler.UpdateSequenceStep(ctx, request)
 		assert.NoError(t, err)
 
-		result := response.(openapi.UpdateSequenceStep200JSONResponse)
+		result := response.(openapi.UpdateSequenceStep200JSONResponse).Body
 		assert.Equal(t, expectedStep.ID, result.Id)
 		assert.Equal(t, expectedStep.EmailSubject, result.EmailSubject)
 		assert.Equal(t, expectedStep.EmailContent, result.EmailContent)
+		assert.Equal(t, 3, result.DaysAfterPreviousStep)
 	})
 
-	t.Run("handles invalid sequence UUID", func(t *testing.T) {
-		emailSubject := "Updated Subject"
-		emailContent := "Updated Content"
+	t.Run("moves the step", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+		afterStepID := uuid.New()
+
+		moveBody := body
+		moveBody.AfterStepId = &afterStepID
+		moveUpdate := update
+		moveUpdate.Position = &sequence.Position{AfterStepID: &afterStepID}
+
+		mockService.EXPECT().
+			UpdateSequenceStep(ctx, sequenceID, stepID, moveUpdate).
+			Return(&models.SequenceStep{ID: stepID}, nil)
 
+		_, err := handler.UpdateSequenceStep(ctx, openapi.UpdateSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       &moveBody,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("checks If-Match version", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+
+		versionedUpdate := update
+		versionedUpdate.Version = pointer.To(int32(2))
+
+		mockService.EXPECT().
+			UpdateSequenceStep(ctx, sequenceID, stepID, versionedUpdate).
+			Return(&models.SequenceStep{ID: stepID, Version: 3}, nil)
+
+		response, err := handler.UpdateSequenceStep(ctx, openapi.UpdateSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Params:     openapi.UpdateSequenceStepParams{IfMatch: pointer.To(`"2"`)},
+			Body:       &body,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `"3"`, response.(openapi.UpdateSequenceStep200JSONResponse).Headers.ETag)
+	})
+
+	t.Run("handles version mismatch", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+
+		versionedUpdate := update
+		versionedUpdate.Version = pointer.To(int32(1))
+
+		mockService.EXPECT().
+			UpdateSequenceStep(ctx, sequenceID, stepID, versionedUpdate).
+			Return(nil, apperr.ErrVersionMismatch)
+
+		response, err := handler.UpdateSequenceStep(ctx, openapi.UpdateSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Params:     openapi.UpdateSequenceStepParams{IfMatch: pointer.To(`"1"`)},
+			Body:       &body,
+		})
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrVersionMismatch)
+	})
+
+	t.Run("handles invalid If-Match header", func(t *testing.T) {
+		response, err := handler.UpdateSequenceStep(ctx, openapi.UpdateSequenceStepRequestObject{
+			SequenceId: uuid.New().String(),
+			StepId:     uuid.New().String(),
+			Params:     openapi.UpdateSequenceStepParams{IfMatch: pointer.To("1")},
+			Body:       &body,
+		})
+		assert.Nil(t, response)
+		assert.Contains(t, err.Error(), "Invalid If-Match header")
+	})
+
+	t.Run("handles invalid sequence UUID", func(t *testing.T) {
 		request := openapi.UpdateSequenceStepRequestObject{
 			SequenceId: "invalid-uuid",
 			StepId:     uuid.New().String(),
-			Body: &openapi.UpdateSequenceStepInput{
-				EmailSubject: &emailSubject,
-				EmailContent: &emailContent,
-			},
+			Body:       &body,
 		}
 
 		response, err := handler.UpdateSequenceStep(ctx, request)
@@ -102,16 +372,10 @@ func TestUpdateSequenceStep(t *testing.T) {
 	})
 
 	t.Run("handles invalid step UUID", func(t *testing.T) {
-		emailSubject := "Updated Subject"
-		emailContent := "Updated Content"
-
 		request := openapi.UpdateSequenceStepRequestObject{
 			SequenceId: uuid.New().String(),
 			StepId:     "invalid-uuid",
-			Body: &openapi.UpdateSequenceStepInput{
-				EmailSubject: &emailSubject,
-				EmailContent: &emailContent,
-			},
+			Body:       &body,
 		}
 
 		response, err := handler.UpdateSequenceStep(ctx, request)
@@ -120,50 +384,50 @@ func TestUpdateSequenceStep(t *testing.T) {
 		assert.Contains(t, err.Error(), "Invalid step ID")
 	})
 
+	t.Run("rejects partial body", func(t *testing.T) {
+		request := openapi.UpdateSequenceStepRequestObject{
+			SequenceId: uuid.New().String(),
+			StepId:     uuid.New().String(),
+			Body:       &openapi.UpdateSequenceStepInput{EmailSubject: "Updated Subject"},
+		}
+
+		response, err := handler.UpdateSequenceStep(ctx, request)
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrValidation)
+	})
+
 	t.Run("handles not found error", func(t *testing.T) {
 		sequenceID := uuid.New()
 		stepID := uuid.New()
 
-		emailSubject := "Updated Subject"
-		emailContent := "Updated Content"
-
 		request := openapi.UpdateSequenceStepRequestObject{
 			SequenceId: sequenceID.String(),
 			StepId:     stepID.String(),
-			Body: &openapi.UpdateSequenceStepInput{
-				EmailSubject: &emailSubject,
-				EmailContent: &emailContent,
-			},
+			Body:       &body,
 		}
 
 		mockService.EXPECT().
-			UpdateSequenceStep(ctx, sequenceID, stepID, &emailSubject, &emailContent).
-			Return(nil, sql.ErrNoRows)
+			UpdateSequenceStep(ctx, sequenceID, stepID, update).
+			Return(nil, apperr.ErrStepNotFound)
 
 		response, err := handler.UpdateSequenceStep(ctx, request)
 		assert.Nil(t, response)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "Sequence step not found")
+		assert.ErrorIs(t, err, apperr.ErrStepNotFound)
 	})
 
 	t.Run("handles internal error", func(t *testing.T) {
 		sequenceID := uuid.New()
 		stepID := uuid.New()
 
-		emailSubject := "Updated Subject"
-		emailContent := "Updated Content"
-
 		request := openapi.UpdateSequenceStepRequestObject{
 			SequenceId: sequenceID.String(),
 			StepId:     stepID.String(),
-			Body: &openapi.UpdateSequenceStepInput{
-				EmailSubject: &emailSubject,
-				EmailContent: &emailContent,
-			},
+			Body:       &body,
 		}
 
 		mockService.EXPECT().
-			UpdateSequenceStep(ctx, sequenceID, stepID, &emailSubject, &emailContent).
+			UpdateSequenceStep(ctx, sequenceID, stepID, update).
 			Return(nil, assert.AnError)
 
 		response, err := handler.UpdateSequenceStep(ctx, request)
@@ -173,6 +437,107 @@ func TestUpdateSequenceStep(t *testing.T) {
 	})
 }
 
+func TestPatchSequenceStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+
+	decode := func(t *testing.T, raw string) *openapi.PatchSequenceStepInput {
+		var body openapi.PatchSequenceStepInput
+		require.NoError(t, json.Unmarshal([]byte(raw), &body))
+		return &body
+	}
+
+	t.Run("updates only present fields", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+
+		mockService.EXPECT().
+			UpdateSequenceStep(ctx, sequenceID, stepID, sequence.StepUpdate{
+				DaysAfterPreviousStep: pointer.To(int32(5)),
+			}).
+			Return(&models.SequenceStep{ID: stepID, DaysAfterPreviousStep: 5}, nil)
+
+		response, err := handler.PatchSequenceStep(ctx, openapi.PatchSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       decode(t, `{"daysAfterPreviousStep": 5}`),
+		})
+		require.NoError(t, err)
+
+		result := response.(openapi.PatchSequenceStep200JSONResponse).Body
+		assert.Equal(t, stepID, result.Id)
+		assert.Equal(t, 5, result.DaysAfterPreviousStep)
+	})
+
+	t.Run("moves the step", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+		beforeStepID := uuid.New()
+
+		mockService.EXPECT().
+			UpdateSequenceStep(ctx, sequenceID, stepID, sequence.StepUpdate{
+				EmailSubject: pointer.To("Patched Subject"),
+				Position:     &sequence.Position{BeforeStepID: &beforeStepID},
+			}).
+			Return(&models.SequenceStep{ID: stepID}, nil)
+
+		_, err := handler.PatchSequenceStep(ctx, openapi.PatchSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       decode(t, `{"emailSubject": "Patched Subject", "beforeStepId": "`+beforeStepID.String()+`"}`),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects null for required fields", func(t *testing.T) {
+		response, err := handler.PatchSequenceStep(ctx, openapi.PatchSequenceStepRequestObject{
+			SequenceId: uuid.New().String(),
+			StepId:     uuid.New().String(),
+			Body:       decode(t, `{"emailSubject": null, "daysAfterPreviousStep": -1}`),
+		})
+		assert.Nil(t, response)
+
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "emailSubject", Message: "must not be null"},
+			{Field: "daysAfterPreviousStep", Message: "must be between 0 and 365"},
+		}, validationErr.Fields)
+	})
+
+	t.Run("handles invalid step UUID", func(t *testing.T) {
+		response, err := handler.PatchSequenceStep(ctx, openapi.PatchSequenceStepRequestObject{
+			SequenceId: uuid.New().String(),
+			StepId:     "invalid-uuid",
+			Body:       decode(t, `{}`),
+		})
+		assert.Nil(t, response)
+		assert.Contains(t, err.Error(), "Invalid step ID")
+	})
+
+	t.Run("handles not found error", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+
+		mockService.EXPECT().
+			UpdateSequenceStep(ctx, sequenceID, stepID, sequence.StepUpdate{}).
+			Return(nil, apperr.ErrStepNotFound)
+
+		response, err := handler.PatchSequenceStep(ctx, openapi.PatchSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       decode(t, `{}`),
+		})
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, apperr.ErrStepNotFound)
+		assert.Contains(t, err.Error(), "Failed to patch sequence step")
+	})
+}
+
 func TestDeleteSequenceStep(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -241,4 +606,98 @@ func TestDeleteSequenceStep(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "Failed to delete sequence step")
 	})
+
+	t.Run("handles not found error", func(t *testing.T) {
+		sequenceID := uuid.New()
+		stepID := uuid.New()
+
+		request := openapi.DeleteSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+		}
+
+		mockService.EXPECT().
+			DeleteSequenceStep(ctx, sequenceID, stepID).
+			Return(apperr.ErrStepNotFound)
+
+		response, err := handler.DeleteSequenceStep(ctx, request)
+		assert.Nil(t, response)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, apperr.ErrStepNotFound)
+	})
+}
+
+func TestPreviewSequenceStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockSequenceService(ctrl)
+	handler := &StrictHandler{svc: mockService}
+	ctx := context.Background()
+	sequenceID := uuid.New()
+	stepID := uuid.New()
+
+	t.Run("renders the step", func(t *testing.T) {
+		contactID := uuid.New()
+		variables := map[string]any{"custom.company": "Acme"}
+		mockService.EXPECT().
+			PreviewStep(ctx, sequenceID, stepID, sequence.Preview{ContactID: &contactID, Variables: variables}).
+			Return(&render.Email{
+				Subject: "Hi Jane",
+				HTML:    `<a href="https://example.com">Acme</a>`,
+				Text:    "Acme (https://example.com)",
+				Links:   []render.Link{{URL: "https://example.com", TrackingURL: "https://api.example.com/t/c/token"}},
+			}, nil)
+
+		response, err := handler.PreviewSequenceStep(ctx, openapi.PreviewSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       &openapi.PreviewInput{ContactId: &contactID, Variables: &variables},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, openapi.PreviewSequenceStep200JSONResponse{
+			Subject:          "Hi Jane",
+			Html:             `<a href="https://example.com">Acme</a>`,
+			Text:             "Acme (https://example.com)",
+			Links:            []openapi.PreviewLink{{Url: "https://example.com", TrackingUrl: pointer.To("https://api.example.com/t/c/token")}},
+			MissingVariables: []string{},
+		}, response)
+	})
+
+	t.Run("rejects unknown variables", func(t *testing.T) {
+		variables := map[string]any{"contact.name": "Jane", "custom.tags": []any{"a"}}
+		_, err := handler.PreviewSequenceStep(ctx, openapi.PreviewSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       &openapi.PreviewInput{Variables: &variables},
+		})
+		var validationErr *apperr.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []apperr.FieldError{
+			{Field: "variables.contact.name", Message: "must be a known variable"},
+			{Field: "variables.custom.tags", Message: "must be a string, number, boolean or null"},
+		}, validationErr.Fields)
+	})
+
+	t.Run("handles invalid step UUID", func(t *testing.T) {
+		_, err := handler.PreviewSequenceStep(ctx, openapi.PreviewSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     "invalid-uuid",
+			Body:       &openapi.PreviewInput{},
+		})
+		assert.ErrorContains(t, err, "Invalid step ID")
+	})
+
+	t.Run("handles step not found", func(t *testing.T) {
+		mockService.EXPECT().
+			PreviewStep(ctx, sequenceID, stepID, sequence.Preview{}).
+			Return(nil, apperr.ErrStepNotFound)
+
+		_, err := handler.PreviewSequenceStep(ctx, openapi.PreviewSequenceStepRequestObject{
+			SequenceId: sequenceID.String(),
+			StepId:     stepID.String(),
+			Body:       &openapi.PreviewInput{},
+		})
+		assert.ErrorIs(t, err, apperr.ErrStepNotFound)
+	})
 }
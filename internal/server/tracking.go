@@ -0,0 +1,58 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/tracking"
+	"github.com/pirellik/sequence-api/pkg/middleware"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+// noStore keeps clients and proxies from caching tracking responses, so that
+// every open and click reaches the API.
+const noStore = "no-store, no-cache, must-revalidate, private"
+
+func (s *StrictHandler) TrackOpen(ctx context.Context, request openapi.TrackOpenRequestObject) (openapi.TrackOpenResponseObject, error) {
+	client := tracking.Client{
+		UserAgent: lo.FromPtr(request.Params.UserAgent),
+		IP:        middleware.ClientIPFrom(ctx),
+	}
+	err := s.tracking.RecordOpen(ctx, request.Token, client)
+	switch {
+	case errors.Is(err, tracking.ErrInvalidToken):
+		slog.DebugContext(ctx, "ignoring open with an invalid token")
+	case err != nil:
+		slog.ErrorContext(ctx, "recording open", "err", err)
+	}
+
+	return openapi.TrackOpen200ImagegifResponse{
+		Body:          bytes.NewReader(tracking.Pixel),
+		ContentLength: int64(len(tracking.Pixel)),
+		Headers:       openapi.TrackOpen200ResponseHeaders{CacheControl: noStore},
+	}, nil
+}
+
+func (s *StrictHandler) TrackClick(ctx context.Context, request openapi.TrackClickRequestObject) (openapi.TrackClickResponseObject, error) {
+	client := tracking.Client{
+		UserAgent: lo.FromPtr(request.Params.UserAgent),
+		IP:        middleware.ClientIPFrom(ctx),
+	}
+	url, err := s.tracking.RecordClick(ctx, request.Token, client)
+	switch {
+	case errors.Is(err, tracking.ErrInvalidToken), errors.Is(err, tracking.ErrUnknownLink):
+		return nil, ErrNotFound("Link not found")
+	case err != nil && url == "":
+		return nil, errors.Wrap(err, "Failed to record click")
+	case err != nil:
+		// Contacts still get where they are going when only recording fails.
+		slog.ErrorContext(ctx, "recording click", "err", err)
+	}
+
+	return openapi.TrackClick302Response{
+		Headers: openapi.TrackClick302ResponseHeaders{Location: url, CacheControl: noStore},
+	}, nil
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/netip"
+	"testing"
+
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/tracking"
+	"github.com/pirellik/sequence-api/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTrackOpen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockTrackingService(ctrl)
+	handler := &StrictHandler{tracking: mockService}
+	ctx := context.Background()
+
+	assertPixel := func(t *testing.T, response openapi.TrackOpenResponseObject) {
+		t.Helper()
+		gif, ok := response.(openapi.TrackOpen200ImagegifResponse)
+		require.True(t, ok)
+		body, err := io.ReadAll(gif.Body)
+		require.NoError(t, err)
+		assert.Equal(t, tracking.Pixel, body)
+		assert.Equal(t, int64(len(tracking.Pixel)), gif.ContentLength)
+		assert.Equal(t, noStore, gif.Headers.CacheControl)
+	}
+
+	t.Run("records the open", func(t *testing.T) {
+		mockService.EXPECT().
+			RecordOpen(ctx, "token", tracking.Client{UserAgent: "Thunderbird", IP: netip.Addr{}}).
+			Return(nil)
+
+		response, err := handler.TrackOpen(ctx, openapi.TrackOpenRequestObject{
+			Token:  "token",
+			Params: openapi.TrackOpenParams{UserAgent: pointer.To("Thunderbird")},
+		})
+		require.NoError(t, err)
+		assertPixel(t, response)
+	})
+
+	t.Run("serves the pixel for invalid tokens", func(t *testing.T) {
+		mockService.EXPECT().RecordOpen(ctx, "forged", tracking.Client{}).Return(tracking.ErrInvalidToken)
+
+		response, err := handler.TrackOpen(ctx, openapi.TrackOpenRequestObject{Token: "forged"})
+		require.NoError(t, err)
+		assertPixel(t, response)
+	})
+}
+
+func TestTrackClick(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := NewMockTrackingService(ctrl)
+	handler := &StrictHandler{tracking: mockService}
+	ctx := context.Background()
+
+	t.Run("redirects to the link", func(t *testing.T) {
+		mockService.EXPECT().
+			RecordClick(ctx, "token", tracking.Client{UserAgent: "Thunderbird"}).
+			Return("https://example.com", nil)
+
+		response, err := handler.TrackClick(ctx, openapi.TrackClickRequestObject{
+			Token:  "token",
+			Params: openapi.TrackClickParams{UserAgent: pointer.To("Thunderbird")},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, openapi.TrackClick302Response{
+			Headers: openapi.TrackClick302ResponseHeaders{Location: "https://example.com", CacheControl: noStore},
+		}, response)
+	})
+
+	t.Run("redirects when recording fails", func(t *testing.T) {
+		mockService.EXPECT().RecordClick(ctx, "token", tracking.Client{}).Return("https://example.com", errors.New("db down"))
+
+		response, err := handler.TrackClick(ctx, openapi.TrackClickRequestObject{Token: "token"})
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com", response.(openapi.TrackClick302Response).Headers.Location)
+	})
+
+	t.Run("handles invalid tokens", func(t *testing.T) {
+		mockService.EXPECT().RecordClick(ctx, "forged", tracking.Client{}).Return("", tracking.ErrInvalidToken)
+
+		_, err := handler.TrackClick(ctx, openapi.TrackClickRequestObject{Token: "forged"})
+		assert.ErrorContains(t, err, "Link not found")
+	})
+
+	t.Run("handles unknown links", func(t *testing.T) {
+		mockService.EXPECT().RecordClick(ctx, "token", tracking.Client{}).Return("", tracking.ErrUnknownLink)
+
+		_, err := handler.TrackClick(ctx, openapi.TrackClickRequestObject{Token: "token"})
+		assert.ErrorContains(t, err, "Link not found")
+	})
+
+	t.Run("handles errors", func(t *testing.T) {
+		mockService.EXPECT().RecordClick(ctx, "token", tracking.Client{}).Return("", errors.New("db down"))
+
+		_, err := handler.TrackClick(ctx, openapi.TrackClickRequestObject{Token: "token"})
+		assert.ErrorContains(t, err, "Failed to record click")
+	})
+}
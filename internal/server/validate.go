@@ -0,0 +1,321 @@
+package server
+
+import (
+	"fmt"
+	"maps"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/oapi-codegen/nullable"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/pirellik/sequence-api/internal/render"
+)
+
+// Limits mirror the column sizes in the database schema and the constraints
+// declared in openapi.yaml.
+const (
+	maxNameLength    = 255
+	maxSubjectLength = 255
+	maxDaysAfter     = 365
+	maxEmailLength   = 320
+	maxCustomFields  = 50
+	maxVariables     = 100
+	maxBulkEnroll    = 1000
+	maxMailboxes     = 100
+	maxPort          = 65535
+	maxSendInterval  = 86400
+	maxSendBurst     = 1000
+	maxTimeZone      = 64
+	maxHolidays      = 366
+)
+
+// Defaults of optional mailbox fields, as declared in openapi.yaml.
+const (
+	defaultSendInterval = 60
+	defaultSendBurst    = 1
+)
+
+// customFieldKey matches keys that can be referenced from email templates.
+var customFieldKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// validator collects field errors so that a request is rejected with every
+// problem at once rather than one at a time.
+type validator struct {
+	fields []apperr.FieldError
+}
+
+func (v *validator) check(ok bool, field, message string) {
+	if !ok {
+		v.fields = append(v.fields, apperr.FieldError{Field: field, Message: message})
+	}
+}
+
+func (v *validator) requiredText(field, value string, maxLength int) {
+	v.check(strings.TrimSpace(value) != "", field, "must not be empty")
+	if maxLength > 0 {
+		v.check(utf8.RuneCountInString(value) <= maxLength, field, fmt.Sprintf("must be at most %d characters long", maxLength))
+	}
+}
+
+func (v *validator) daysAfterPreviousStep(field string, days int) {
+	v.check(days >= 0 && days <= maxDaysAfter, field, fmt.Sprintf("must be between 0 and %d", maxDaysAfter))
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	return apperr.Validation(v.fields...)
+}
+
+// steps validates the step list of a sequence. The fields of each step are
+// passed in through get so that both input shapes of a step can share it.
+func (v *validator) steps(n int, get func(i int) (subject, content string, days int)) {
+	v.check(n > 0, "steps", "must contain at least one step")
+	for i := range n {
+		subject, content, days := get(i)
+		prefix := fmt.Sprintf("steps[%d].", i)
+		v.requiredText(prefix+"emailSubject", subject, maxSubjectLength)
+		v.template(prefix+"emailSubject", subject)
+		v.requiredText(prefix+"emailContent", content, 0)
+		v.template(prefix+"emailContent", content)
+		v.daysAfterPreviousStep(prefix+"daysAfterPreviousStep", days)
+		if i == 0 {
+			v.check(days == 0, prefix+"daysAfterPreviousStep", "must be 0 for the first step")
+		}
+	}
+}
+
+// template accepts step templates that parse and only use known variables.
+func (v *validator) template(field, value string) {
+	if _, err := render.Parse(value); err != nil {
+		v.check(false, field, err.Error())
+	}
+}
+
+func validateSequence(sequence *openapi.Sequence) error {
+	var v validator
+	v.requiredText("name", sequence.Name, maxNameLength)
+	v.steps(len(sequence.Steps), func(i int) (string, string, int) {
+		step := sequence.Steps[i]
+		return step.EmailSubject, step.EmailContent, step.DaysAfterPreviousStep
+	})
+	return v.err()
+}
+
+func validateUpdateSequence(input *openapi.UpdateSequenceInput) error {
+	var v validator
+	if input.Name != nil {
+		v.requiredText("name", *input.Name, maxNameLength)
+	}
+	if input.Steps != nil {
+		steps := *input.Steps
+		v.steps(len(steps), func(i int) (string, string, int) {
+			return steps[i].EmailSubject, steps[i].EmailContent, steps[i].DaysAfterPreviousStep
+		})
+	}
+	return v.err()
+}
+
+func validateCreateSequenceStep(input *openapi.CreateSequenceStepInput) error {
+	var v validator
+	v.requiredText("emailSubject", input.EmailSubject, maxSubjectLength)
+	v.template("emailSubject", input.EmailSubject)
+	v.requiredText("emailContent", input.EmailContent, 0)
+	v.template("emailContent", input.EmailContent)
+	v.daysAfterPreviousStep("daysAfterPreviousStep", input.DaysAfterPreviousStep)
+	return v.err()
+}
+
+func validateUpdateSequenceStep(input *openapi.UpdateSequenceStepInput) error {
+	var v validator
+	v.requiredText("emailSubject", input.EmailSubject, maxSubjectLength)
+	v.template("emailSubject", input.EmailSubject)
+	v.requiredText("emailContent", input.EmailContent, 0)
+	v.template("emailContent", input.EmailContent)
+	v.daysAfterPreviousStep("daysAfterPreviousStep", input.DaysAfterPreviousStep)
+	return v.err()
+}
+
+func validatePatchSequenceStep(input *openapi.PatchSequenceStepInput) error {
+	var v validator
+	if notNull(&v, "emailSubject", input.EmailSubject) {
+		v.requiredText("emailSubject", input.EmailSubject.MustGet(), maxSubjectLength)
+		v.template("emailSubject", input.EmailSubject.MustGet())
+	}
+	if notNull(&v, "emailContent", input.EmailContent) {
+		v.requiredText("emailContent", input.EmailContent.MustGet(), 0)
+		v.template("emailContent", input.EmailContent.MustGet())
+	}
+	if notNull(&v, "daysAfterPreviousStep", input.DaysAfterPreviousStep) {
+		v.daysAfterPreviousStep("daysAfterPreviousStep", input.DaysAfterPreviousStep.MustGet())
+	}
+	return v.err()
+}
+
+func validateContact(input *openapi.ContactInput) error {
+	var v validator
+	v.email("email", input.Email)
+	if input.FirstName != nil {
+		v.check(utf8.RuneCountInString(*input.FirstName) <= maxNameLength, "firstName", fmt.Sprintf("must be at most %d characters long", maxNameLength))
+	}
+	if input.LastName != nil {
+		v.check(utf8.RuneCountInString(*input.LastName) <= maxNameLength, "lastName", fmt.Sprintf("must be at most %d characters long", maxNameLength))
+	}
+	if input.CustomFields != nil {
+		v.customFields("customFields", *input.CustomFields)
+	}
+	if input.TimeZone != nil {
+		v.timeZone("timeZone", *input.TimeZone)
+	}
+	return v.err()
+}
+
+// timeZone accepts IANA time zone names such as "Europe/Berlin".
+func (v *validator) timeZone(field, value string) {
+	v.requiredText(field, value, maxTimeZone)
+	if strings.TrimSpace(value) != "" {
+		_, err := time.LoadLocation(value)
+		v.check(err == nil && value != "Local", field, "must be an IANA time zone")
+	}
+}
+
+// email accepts bare addresses such as "jane@example.com", without a display
+// name.
+func (v *validator) email(field, value string) {
+	v.requiredText(field, value, maxEmailLength)
+	if strings.TrimSpace(value) != "" {
+		addr, err := mail.ParseAddress(value)
+		v.check(err == nil && addr.Address == value, field, "must be a valid email address")
+	}
+}
+
+// customFields accepts flat objects only: nested values could not be printed
+// by a template.
+func (v *validator) customFields(field string, fields map[string]any) {
+	v.check(len(fields) <= maxCustomFields, field, fmt.Sprintf("must have at most %d fields", maxCustomFields))
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		value := fields[key]
+		path := field + "." + key
+		v.check(customFieldKey.MatchString(key), path, "must start with a letter or underscore and contain only letters, digits and underscores")
+		v.scalar(path, value)
+	}
+}
+
+// scalar accepts the JSON values a template can print.
+func (v *validator) scalar(field string, value any) {
+	switch value.(type) {
+	case nil, string, float64, bool:
+	default:
+		v.check(false, field, "must be a string, number, boolean or null")
+	}
+}
+
+func validatePreview(input *openapi.PreviewInput) error {
+	var v validator
+	if input.Variables != nil {
+		variables := *input.Variables
+		v.check(len(variables) <= maxVariables, "variables", fmt.Sprintf("must have at most %d variables", maxVariables))
+		for _, path := range slices.Sorted(maps.Keys(variables)) {
+			field := "variables." + path
+			v.check(render.Known(path), field, "must be a known variable")
+			v.scalar(field, variables[path])
+		}
+	}
+	return v.err()
+}
+
+func validateBulkEnrollment(input *openapi.BulkEnrollmentInput) error {
+	var v validator
+	n := len(input.ContactIds)
+	v.check(n > 0 && n <= maxBulkEnroll, "contactIds", fmt.Sprintf("must contain between 1 and %d contacts", maxBulkEnroll))
+	seen := make(map[uuid.UUID]bool, n)
+	for i, id := range input.ContactIds {
+		v.check(!seen[id], fmt.Sprintf("contactIds[%d]", i), "must not be repeated")
+		seen[id] = true
+	}
+	return v.err()
+}
+
+func validateMailbox(input *openapi.MailboxInput) error {
+	var v validator
+	v.email("fromAddress", input.FromAddress)
+	if input.FromName != nil {
+		v.check(utf8.RuneCountInString(*input.FromName) <= maxNameLength, "fromName", fmt.Sprintf("must be at most %d characters long", maxNameLength))
+	}
+	v.requiredText("smtpHost", input.SmtpHost, maxNameLength)
+	v.check(input.SmtpPort >= 1 && input.SmtpPort <= maxPort, "smtpPort", fmt.Sprintf("must be between 1 and %d", maxPort))
+	if input.SmtpUsername != nil {
+		v.check(utf8.RuneCountInString(*input.SmtpUsername) <= maxNameLength, "smtpUsername", fmt.Sprintf("must be at most %d characters long", maxNameLength))
+	}
+	v.check(input.DailyLimit >= 1, "dailyLimit", "must be at least 1")
+	if input.SendIntervalSeconds != nil {
+		v.check(*input.SendIntervalSeconds >= 0 && *input.SendIntervalSeconds <= maxSendInterval, "sendIntervalSeconds", fmt.Sprintf("must be between 0 and %d", maxSendInterval))
+	}
+	if input.SendBurst != nil {
+		v.check(*input.SendBurst >= 1 && *input.SendBurst <= maxSendBurst, "sendBurst", fmt.Sprintf("must be between 1 and %d", maxSendBurst))
+	}
+	return v.err()
+}
+
+func validateSequenceMailboxes(input *openapi.SequenceMailboxes) error {
+	var v validator
+	v.check(len(input.MailboxIds) <= maxMailboxes, "mailboxIds", fmt.Sprintf("must contain at most %d mailboxes", maxMailboxes))
+	seen := make(map[uuid.UUID]bool, len(input.MailboxIds))
+	for i, id := range input.MailboxIds {
+		v.check(!seen[id], fmt.Sprintf("mailboxIds[%d]", i), "must not be repeated")
+		seen[id] = true
+	}
+	v.check(
+		input.Rotation == openapi.RoundRobin || input.Rotation == openapi.LeastUsed,
+		"rotation", "must be round_robin or least_used",
+	)
+	return v.err()
+}
+
+func validateSendSchedule(input *openapi.SendSchedule) error {
+	var v validator
+	v.timeZone("timeZone", input.TimeZone)
+	v.check(len(input.Weekdays) > 0, "weekdays", "must not be empty")
+	seenDays := make(map[openapi.Weekday]bool, len(input.Weekdays))
+	for i, day := range input.Weekdays {
+		field := fmt.Sprintf("weekdays[%d]", i)
+		v.check(slices.Contains(weekdays, day), field, "must be a day of the week")
+		v.check(!seenDays[day], field, "must not be repeated")
+		seenDays[day] = true
+	}
+	v.check(input.StartHour >= 0 && input.StartHour <= 23, "startHour", "must be between 0 and 23")
+	v.check(input.EndHour >= 1 && input.EndHour <= 24, "endHour", "must be between 1 and 24")
+	v.check(input.StartHour < input.EndHour, "endHour", "must be after startHour")
+	v.check(len(input.Holidays) <= maxHolidays, "holidays", fmt.Sprintf("must contain at most %d dates", maxHolidays))
+	seenDates := make(map[string]bool, len(input.Holidays))
+	for i, date := range input.Holidays {
+		v.check(!seenDates[date.String()], fmt.Sprintf("holidays[%d]", i), "must not be repeated")
+		seenDates[date.String()] = true
+	}
+	return v.err()
+}
+
+func validateStatsParams(params openapi.GetSequenceStatsParams) error {
+	var v validator
+	if params.From != nil && params.To != nil {
+		v.check(params.To.After(*params.From), "to", "must be after from")
+	}
+	return v.err()
+}
+
+// notNull reports whether a merge patch sets the field to a value. None of the
+// step fields can be removed, so an explicit null is a validation error.
+func notNull[T any](v *validator, field string, value nullable.Nullable[T]) bool {
+	if !value.IsSpecified() {
+		return false
+	}
+	v.check(!value.IsNull(), field, "must not be null")
+	return !value.IsNull()
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/oapi-codegen/nullable"
+	"github.com/pirellik/sequence-api/internal/apperr"
+	"github.com/pirellik/sequence-api/internal/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSequence(t *testing.T) {
+	validStep := func(days int) openapi.SequenceStep {
+		return openapi.SequenceStep{
+			EmailSubject:          "Subject",
+			EmailContent:          "Content",
+			DaysAfterPreviousStep: days,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		sequence   openapi.Sequence
+		wantFields []apperr.FieldError
+	}{
+		{
+			name: "valid sequence",
+			sequence: openapi.Sequence{
+				Name:  "Sequence",
+				Steps: []openapi.SequenceStep{validStep(0), validStep(3)},
+			},
+		},
+		{
+			name: "empty name",
+			sequence: openapi.Sequence{
+				Name:  "   ",
+				Steps: []openapi.SequenceStep{validStep(0)},
+			},
+			wantFields: []apperr.FieldError{
+				{Field: "name", Message: "must not be empty"},
+			},
+		},
+		{
+			name: "name too long",
+			sequence: openapi.Sequence{
+				Name:  strings.Repeat("a", 256),
+				Steps: []openapi.SequenceStep{validStep(0)},
+			},
+			wantFields: []apperr.FieldError{
+				{Field: "name", Message: "must be at most 255 characters long"},
+			},
+		},
+		{
+			name:     "no steps",
+			sequence: openapi.Sequence{Name: "Sequence"},
+			wantFields: []apperr.FieldError{
+				{Field: "steps", Message: "must contain at least one step"},
+			},
+		},
+		{
+			name: "first step with delay",
+			sequence: openapi.Sequence{
+				Name:  "Sequence",
+				Steps: []openapi.SequenceStep{validStep(2)},
+			},
+			wantFields: []apperr.FieldError{
+				{Field: "steps[0].daysAfterPreviousStep", Message: "must be 0 for the first step"},
+			},
+		},
+		{
+			name: "invalid steps",
+			sequence: openapi.Sequence{
+				Name: "Sequence",
+				Steps: []openapi.SequenceStep{
+					validStep(0),
+					{EmailSubject: strings.Repeat("ś", 256), EmailContent: "", DaysAfterPreviousStep: -1},
+				},
+			},
+			wantFields: []apperr.FieldError{
+				{Field: "steps[1].emailSubject", Message: "must be at most 255 characters long"},
+				{Field: "steps[1].emailContent", Message: "must not be empty"},
+				{Field: "steps[1].daysAfterPreviousStep", Message: "must be between 0 and 365"},
+			},
+		}, {
+			name: "invalid templates",
+			sequence: openapi.Sequence{
+				Name: "Sequence",
+				Steps: []openapi.SequenceStep{{
+					EmailSubject: `Hi {{contact.firstName | default "there"}}`,
+					EmailContent: "<p>Hi {{contact.fristName}}</p>\n<p>{{custom.company | shout}}</p>",
+				}},
+			},
+			wantFields: []apperr.FieldError{
+				{Field: "steps[0].emailContent", Message: "line 2, column 23: unknown filter shout"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSequence(&tt.sequence)
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *apperr.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.wantFields, validationErr.Fields)
+		})
+	}
+}
+
+func TestValidateUpdateSequenceStep(t *testing.T) {
+	assert.NoError(t, validateUpdateSequenceStep(&openapi.UpdateSequenceStepInput{
+		EmailSubject: strings.Repeat("a", 255),
+		EmailContent: "Content",
+	}))
+
+	err := validateUpdateSequenceStep(&openapi.UpdateSequenceStepInput{
+		EmailSubject:          strings.Repeat("a", 256),
+		DaysAfterPreviousStep: 366,
+	})
+	var validationErr *apperr.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []apperr.FieldError{
+		{Field: "emailSubject", Message: "must be at most 255 characters long"},
+		{Field: "emailContent", Message: "must not be empty"},
+		{Field: "daysAfterPreviousStep", Message: "must be between 0 and 365"},
+	}, validationErr.Fields)
+}
+
+func TestValidateCreateSequenceStep(t *testing.T) {
+	err := validateCreateSequenceStep(&openapi.CreateSequenceStepInput{
+		EmailSubject: "{{contact.name}}",
+		EmailContent: "Hi {{contact.firstName",
+	})
+	var validationErr *apperr.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []apperr.FieldError{
+		{Field: "emailSubject", Message: "line 1, column 1: unknown variable contact.name"},
+		{Field: "emailContent", Message: "line 1, column 23: unclosed expression, expected }}"},
+	}, validationErr.Fields)
+}
+
+func TestValidatePatchSequenceStep(t *testing.T) {
+	assert.NoError(t, validatePatchSequenceStep(&openapi.PatchSequenceStepInput{}))
+	assert.NoError(t, validatePatchSequenceStep(&openapi.PatchSequenceStepInput{
+		EmailSubject: nullable.NewNullableWithValue(strings.Repeat("a", 255)),
+	}))
+
+	err := validatePatchSequenceStep(&openapi.PatchSequenceStepInput{
+		EmailSubject:          nullable.NewNullableWithValue(""),
+		EmailContent:          nullable.NewNullNullable[string](),
+		DaysAfterPreviousStep: nullable.NewNullNullable[int](),
+	})
+	var validationErr *apperr.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []apperr.FieldError{
+		{Field: "emailSubject", Message: "must not be empty"},
+		{Field: "emailContent", Message: "must not be null"},
+		{Field: "daysAfterPreviousStep", Message: "must not be null"},
+	}, validationErr.Fields)
+
+	err = validatePatchSequenceStep(&openapi.PatchSequenceStepInput{
+		EmailContent: nullable.NewNullableWithValue("{{sequence.owner}}"),
+	})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, []apperr.FieldError{
+		{Field: "emailContent", Message: "line 1, column 1: unknown variable sequence.owner"},
+	}, validationErr.Fields)
+}
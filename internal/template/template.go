@@ -0,0 +1,417 @@
+// Package template implements the templates of email subjects and contents.
+// Text between {{ and }} is an expression: a dotted variable path or a quoted
+// string, followed by any number of filters separated by |, such as
+//
+//	Hi {{contact.firstName | default "there" | capitalize}},
+//
+// Variables missing from the data render empty, unless a default filter
+// supplies a value or the template is executed strictly.
+package template
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Data holds the values of variables. A variable path like contact.firstName
+// looks up "contact" and then "firstName" in the map[string]any found there.
+type Data map[string]any
+
+// Set sets the variable at path to value, creating the maps along the path
+// and replacing values that are in the way.
+func (d Data) Set(path string, value any) {
+	m := map[string]any(d)
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := m[key].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			m[key] = next
+		}
+		m = next
+	}
+	m[keys[len(keys)-1]] = value
+}
+
+type Template struct {
+	nodes []node
+}
+
+// node is either literal text or an expression.
+type node struct {
+	text string
+	expr *expr
+}
+
+type expr struct {
+	pos pos
+	// Either path or value is set.
+	path    string
+	value   string
+	filters []filter
+}
+
+type filter struct {
+	name string
+	args []string
+}
+
+type pos struct {
+	line, column int
+}
+
+// Error is a problem at a position in a template.
+type Error struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// MissingError lists the variables a strict execution had no value for.
+type MissingError struct {
+	Variables []string
+}
+
+func (e *MissingError) Error() string {
+	return "missing variables: " + strings.Join(e.Variables, ", ")
+}
+
+// filters maps the filter names to their number of arguments.
+var filters = map[string]int{
+	"default":    1,
+	"upper":      0,
+	"lower":      0,
+	"capitalize": 0,
+	"trim":       0,
+}
+
+func Parse(text string) (*Template, error) {
+	p := &parser{src: text, line: 1, column: 1}
+	var nodes []node
+	for p.rest() != "" {
+		literal := p.rest()
+		i := strings.Index(literal, "{{")
+		if i < 0 {
+			nodes = append(nodes, node{text: literal})
+			break
+		}
+		if i > 0 {
+			nodes = append(nodes, node{text: literal[:i]})
+		}
+		p.advance(i)
+
+		start := p.pos()
+		p.advance(len("{{"))
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		e.pos = start
+		nodes = append(nodes, node{expr: e})
+	}
+	return &Template{nodes: nodes}, nil
+}
+
+// Variables returns the variable paths t refers to, in order of their first
+// use.
+func (t *Template) Variables() []string {
+	var paths []string
+	for _, n := range t.nodes {
+		if n.expr != nil && n.expr.path != "" && !slices.Contains(paths, n.expr.path) {
+			paths = append(paths, n.expr.path)
+		}
+	}
+	return paths
+}
+
+// Check returns an error for the first variable known does not accept.
+func (t *Template) Check(known func(path string) bool) error {
+	for _, n := range t.nodes {
+		if n.expr != nil && n.expr.path != "" && !known(n.expr.path) {
+			return &Error{Line: n.expr.pos.line, Column: n.expr.pos.column, Message: fmt.Sprintf("unknown variable %s", n.expr.path)}
+		}
+	}
+	return nil
+}
+
+// Missing returns the variables data has no value for that are not given a
+// default either.
+func (t *Template) Missing(data Data) []string {
+	var missing []string
+	for _, n := range t.nodes {
+		if n.expr == nil || n.expr.path == "" || slices.Contains(missing, n.expr.path) {
+			continue
+		}
+		if _, ok := n.expr.eval(data); !ok {
+			missing = append(missing, n.expr.path)
+		}
+	}
+	return missing
+}
+
+type Options struct {
+	// Strict fails with a *MissingError when variables without a default have
+	// no value, instead of rendering them empty.
+	Strict bool
+	// Escape, when set, is applied to the value of every expression, such as
+	// html.EscapeString for HTML content.
+	Escape func(string) string
+}
+
+func (t *Template) Execute(data Data, opts Options) (string, error) {
+	if opts.Strict {
+		if missing := t.Missing(data); len(missing) > 0 {
+			return "", &MissingError{Variables: missing}
+		}
+	}
+
+	var b strings.Builder
+	for _, n := range t.nodes {
+		if n.expr == nil {
+			b.WriteString(n.text)
+			continue
+		}
+		value, _ := n.expr.eval(data)
+		if opts.Escape != nil {
+			value = opts.Escape(value)
+		}
+		b.WriteString(value)
+	}
+	return b.String(), nil
+}
+
+// eval returns the value of e and whether it has one. A default filter gives
+// a value to a missing or empty variable.
+func (e *expr) eval(data Data) (string, bool) {
+	value, ok := e.value, true
+	if e.path != "" {
+		value, ok = lookup(data, e.path)
+	}
+	for _, f := range e.filters {
+		switch f.name {
+		case "default":
+			if !ok || value == "" {
+				value, ok = f.args[0], true
+			}
+		case "upper":
+			value = strings.ToUpper(value)
+		case "lower":
+			value = strings.ToLower(value)
+		case "capitalize":
+			if r, size := utf8.DecodeRuneInString(value); size > 0 {
+				value = string(unicode.ToUpper(r)) + value[size:]
+			}
+		case "trim":
+			value = strings.TrimSpace(value)
+		}
+	}
+	return value, ok
+}
+
+// lookup formats the value at path in data. Null values count as missing.
+func lookup(data Data, path string) (string, bool) {
+	var current any = map[string]any(data)
+	for key := range strings.SplitSeq(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		if current, ok = m[key]; !ok {
+			return "", false
+		}
+	}
+
+	switch v := current.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case int:
+		return strconv.Itoa(v), true
+	case map[string]any, []any:
+		return "", false
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+type parser struct {
+	src          string
+	offset       int
+	line, column int
+}
+
+func (p *parser) rest() string {
+	return p.src[p.offset:]
+}
+
+func (p *parser) pos() pos {
+	return pos{line: p.line, column: p.column}
+}
+
+// advance moves past the next n bytes, keeping track of the line and column.
+func (p *parser) advance(n int) {
+	for _, r := range p.src[p.offset : p.offset+n] {
+		if r == '\n' {
+			p.line++
+			p.column = 1
+		} else {
+			p.column++
+		}
+	}
+	p.offset += n
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return &Error{Line: p.line, Column: p.column, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) skipSpace() {
+	rest := p.rest()
+	p.advance(len(rest) - len(strings.TrimLeft(rest, " \t\r\n")))
+}
+
+// expr parses an expression up to and including the closing braces.
+func (p *parser) expr() (*expr, error) {
+	p.skipSpace()
+	e := &expr{}
+	switch rest := p.rest(); {
+	case strings.HasPrefix(rest, `"`):
+		value, err := p.string()
+		if err != nil {
+			return nil, err
+		}
+		e.value = value
+	case rest == "" || strings.HasPrefix(rest, "}}"):
+		return nil, p.errorf("expected a variable or a string")
+	default:
+		path, err := p.path()
+		if err != nil {
+			return nil, err
+		}
+		e.path = path
+	}
+
+	for {
+		p.skipSpace()
+		rest := p.rest()
+		switch {
+		case strings.HasPrefix(rest, "}}"):
+			p.advance(len("}}"))
+			return e, nil
+		case strings.HasPrefix(rest, "|"):
+			p.advance(1)
+			f, err := p.filter()
+			if err != nil {
+				return nil, err
+			}
+			e.filters = append(e.filters, f)
+		case rest == "":
+			return nil, p.errorf("unclosed expression, expected }}")
+		default:
+			return nil, p.errorf("unexpected %q, expected | or }}", firstRune(rest))
+		}
+	}
+}
+
+func (p *parser) filter() (filter, error) {
+	p.skipSpace()
+	start := p.pos()
+	name := p.ident()
+	if name == "" {
+		return filter{}, p.errorf("expected a filter name")
+	}
+	arity, ok := filters[name]
+	if !ok {
+		return filter{}, &Error{Line: start.line, Column: start.column, Message: fmt.Sprintf("unknown filter %s", name)}
+	}
+
+	f := filter{name: name}
+	for {
+		p.skipSpace()
+		if !strings.HasPrefix(p.rest(), `"`) {
+			break
+		}
+		arg, err := p.string()
+		if err != nil {
+			return filter{}, err
+		}
+		f.args = append(f.args, arg)
+	}
+	if len(f.args) != arity {
+		return filter{}, &Error{
+			Line:    start.line,
+			Column:  start.column,
+			Message: fmt.Sprintf("filter %s takes %d arguments, got %d", name, arity, len(f.args)),
+		}
+	}
+	return f, nil
+}
+
+// path parses a dotted variable path.
+func (p *parser) path() (string, error) {
+	var parts []string
+	for {
+		ident := p.ident()
+		if ident == "" {
+			if rest := p.rest(); rest != "" {
+				return "", p.errorf("unexpected %q, expected a variable name", firstRune(rest))
+			}
+			return "", p.errorf("expected a variable name")
+		}
+		parts = append(parts, ident)
+		if !strings.HasPrefix(p.rest(), ".") {
+			return strings.Join(parts, "."), nil
+		}
+		p.advance(1)
+	}
+}
+
+// ident parses an identifier of letters, digits and underscores that does not
+// start with a digit, returning an empty string if there is none.
+func (p *parser) ident() string {
+	rest := p.rest()
+	n := 0
+	for n < len(rest) {
+		c := rest[n]
+		if c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || n > 0 && '0' <= c && c <= '9' {
+			n++
+			continue
+		}
+		break
+	}
+	p.advance(n)
+	return rest[:n]
+}
+
+// string parses a double-quoted string with Go escape sequences.
+func (p *parser) string() (string, error) {
+	rest := p.rest()
+	quoted, err := strconv.QuotedPrefix(rest)
+	if err != nil {
+		return "", p.errorf("unterminated string")
+	}
+	value, err := strconv.Unquote(quoted)
+	if err != nil {
+		return "", p.errorf("invalid string %s", quoted)
+	}
+	p.advance(len(quoted))
+	return value, nil
+}
+
+func firstRune(s string) string {
+	r, _ := utf8.DecodeRuneInString(s)
+	return string(r)
+}
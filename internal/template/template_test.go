@@ -0,0 +1,130 @@
+package template
+
+import (
+	"html"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var data = Data{
+	"contact": map[string]any{
+		"firstName": "jane",
+		"lastName":  "",
+		"email":     "jane@example.com",
+	},
+	"custom": map[string]any{
+		"company": "Acme & Co",
+		"seats":   float64(12),
+		"trial":   true,
+		"region":  nil,
+	},
+}
+
+func TestExecute(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"literal text", "Hello there", "Hello there"},
+		{"variable", "Hi {{contact.firstName}}!", "Hi jane!"},
+		{"spaces are optional", "Hi {{ contact.firstName }}!", "Hi jane!"},
+		{"default for a missing variable", `{{custom.title | default "friend"}}`, "friend"},
+		{"default for an empty variable", `{{contact.lastName | default "Doe"}}`, "Doe"},
+		{"default for null", `{{custom.region | default "EU"}}`, "EU"},
+		{"default leaves values alone", `{{contact.firstName | default "there"}}`, "jane"},
+		{"filters chain", `{{contact.firstName | upper}} {{custom.title | default "dear friend" | capitalize}}`, "JANE Dear friend"},
+		{"lower and trim", `{{"  MiXeD  " | trim | lower}}`, "mixed"},
+		{"numbers and booleans", "{{custom.seats}} seats, trial {{custom.trial}}", "12 seats, trial true"},
+		{"missing renders empty", "[{{custom.title}}]", "[]"},
+		{"a string literal escapes braces", `{{"{{"}}`, "{{"},
+		{"string escapes", `{{"say \"hi\""}}`, `say "hi"`},
+		{"closing braces alone are text", "}} {", "}} {"},
+		{"multiple lines", "Dear {{contact.firstName}},\n\n{{custom.company}}", "Dear jane,\n\nAcme & Co"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := Parse(tt.text)
+			require.NoError(t, err)
+
+			got, err := tmpl.Execute(data, Options{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExecuteEscape(t *testing.T) {
+	tmpl, err := Parse(`<p>{{custom.company}} & {{"<b>" | default "x"}}</p>`)
+	require.NoError(t, err)
+
+	got, err := tmpl.Execute(data, Options{Escape: html.EscapeString})
+	require.NoError(t, err)
+	assert.Equal(t, "<p>Acme &amp; Co & &lt;b&gt;</p>", got, "only values are escaped")
+}
+
+func TestExecuteStrict(t *testing.T) {
+	tmpl, err := Parse(`{{contact.firstName}} {{custom.title}} {{custom.region}} {{custom.title}} {{custom.team | default "sales"}}`)
+	require.NoError(t, err)
+
+	_, err = tmpl.Execute(data, Options{Strict: true})
+	var missingErr *MissingError
+	require.ErrorAs(t, err, &missingErr)
+	assert.Equal(t, []string{"custom.title", "custom.region"}, missingErr.Variables)
+	assert.Equal(t, []string{"custom.title", "custom.region"}, tmpl.Missing(data))
+
+	got, err := tmpl.Execute(data, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, "jane    sales", got)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"unclosed", "Hi {{contact.firstName", "line 1, column 23: unclosed expression, expected }}"},
+		{"empty", "Hi {{ }}", "line 1, column 7: expected a variable or a string"},
+		{"bad path", "{{contact.}}", "line 1, column 11: unexpected \"}\", expected a variable name"},
+		{"digit first", "{{1st}}", "line 1, column 3: unexpected \"1\", expected a variable name"},
+		{"unknown filter", "\n  {{contact.firstName | shout}}", "line 2, column 25: unknown filter shout"},
+		{"missing argument", "{{contact.firstName | default}}", "line 1, column 23: filter default takes 1 arguments, got 0"},
+		{"extra argument", `{{contact.firstName | upper "x"}}`, "line 1, column 23: filter upper takes 0 arguments, got 1"},
+		{"unterminated string", `{{"abc}}`, "line 1, column 3: unterminated string"},
+		{"two operands", "{{contact.firstName contact.lastName}}", "line 1, column 21: unexpected \"c\", expected | or }}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.text)
+			var templateErr *Error
+			require.ErrorAs(t, err, &templateErr)
+			assert.Equal(t, tt.want, err.Error())
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tmpl, err := Parse("{{contact.firstName}}\n{{contact.fristName}} {{sender.name}}")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"contact.firstName", "contact.fristName", "sender.name"}, tmpl.Variables())
+
+	known := func(path string) bool { return path != "contact.fristName" }
+	assert.EqualError(t, tmpl.Check(known), "line 2, column 1: unknown variable contact.fristName")
+	assert.NoError(t, tmpl.Check(func(string) bool { return true }))
+}
+
+func TestDataSet(t *testing.T) {
+	d := Data{"contact": map[string]any{"firstName": "jane"}, "custom": "flat"}
+	d.Set("contact.lastName", "Doe")
+	d.Set("custom.company", "Acme")
+	d.Set("sender.name", "Sales")
+
+	assert.Equal(t, Data{
+		"contact": map[string]any{"firstName": "jane", "lastName": "Doe"},
+		"custom":  map[string]any{"company": "Acme"},
+		"sender":  map[string]any{"name": "Sales"},
+	}, d)
+}
@@ -0,0 +1,65 @@
+// Package throttle decides when a mailbox may send its next email. Every
+// mailbox has a daily cap and a token bucket spacing its sends out; the state
+// is kept in the database so that it holds across worker replicas.
+package throttle
+
+import (
+	"math"
+	"time"
+)
+
+type Limits struct {
+	// Daily is the number of emails a mailbox sends per UTC day.
+	Daily int
+	// Interval is how long it takes to earn a token, i.e. the average time
+	// between sends.
+	Interval time.Duration
+	// Burst is the number of tokens a mailbox can save up, i.e. how many
+	// emails may go out back to back after a quiet period.
+	Burst int
+}
+
+type State struct {
+	// Day is the UTC day Sent counts the emails of.
+	Day    time.Time
+	Sent   int
+	Tokens float64
+	// UpdatedAt is when Tokens was last brought up to date.
+	UpdatedAt time.Time
+}
+
+// Full returns the state of a mailbox that has not sent anything yet.
+func Full(limits Limits, now time.Time) State {
+	return State{Day: day(now), Tokens: float64(limits.Burst), UpdatedAt: now}
+}
+
+// Take spends a token for one email. It returns the new state and zero when
+// the email may be sent now, or the unchanged state and how long to wait
+// otherwise: until the next UTC day when the daily cap is reached, or until
+// the next token is earned.
+func Take(limits Limits, state State, now time.Time) (State, time.Duration) {
+	if today := day(now); !today.Equal(state.Day) {
+		state.Day, state.Sent = today, 0
+	}
+	if state.Sent >= limits.Daily {
+		return state, state.Day.AddDate(0, 0, 1).Sub(now)
+	}
+
+	tokens := float64(limits.Burst)
+	if limits.Interval > 0 {
+		earned := float64(now.Sub(state.UpdatedAt)) / float64(limits.Interval)
+		tokens = math.Min(tokens, state.Tokens+math.Max(earned, 0))
+	}
+	if tokens < 1 {
+		return state, time.Duration(math.Ceil((1 - tokens) * float64(limits.Interval)))
+	}
+
+	state.Tokens = tokens - 1
+	state.UpdatedAt = now
+	state.Sent++
+	return state, 0
+}
+
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
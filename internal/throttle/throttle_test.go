@@ -0,0 +1,64 @@
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTake(t *testing.T) {
+	limits := Limits{Daily: 3, Interval: time.Minute, Burst: 2}
+	now := time.Date(2025, 3, 1, 22, 0, 0, 0, time.UTC)
+	state := Full(limits, now)
+
+	t.Run("sends a burst", func(t *testing.T) {
+		var wait time.Duration
+		state, wait = Take(limits, state, now)
+		assert.Zero(t, wait)
+		state, wait = Take(limits, state, now)
+		assert.Zero(t, wait)
+		assert.Equal(t, 2, state.Sent)
+	})
+
+	t.Run("spaces out sends once the bucket is empty", func(t *testing.T) {
+		next, wait := Take(limits, state, now.Add(15*time.Second))
+		assert.Equal(t, 45*time.Second, wait)
+		assert.Equal(t, state, next, "waiting leaves the state alone")
+
+		now = now.Add(time.Minute)
+		state, wait = Take(limits, state, now)
+		assert.Zero(t, wait)
+		assert.Equal(t, 3, state.Sent)
+	})
+
+	t.Run("defers to the next day once the cap is reached", func(t *testing.T) {
+		now = now.Add(time.Hour)
+		_, wait := Take(limits, state, now)
+		assert.Equal(t, 59*time.Minute, wait, "the next UTC day starts at midnight")
+
+		now = now.Add(wait)
+		var next State
+		next, wait = Take(limits, state, now)
+		assert.Zero(t, wait)
+		assert.Equal(t, 1, next.Sent)
+		assert.Equal(t, time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), next.Day)
+	})
+
+	t.Run("does not save up more than a burst", func(t *testing.T) {
+		state := Full(limits, now)
+		state.Tokens = 0
+		state, _ = Take(limits, state, now.Add(24*time.Hour))
+		assert.Equal(t, 1.0, state.Tokens)
+	})
+
+	t.Run("no interval means no spacing", func(t *testing.T) {
+		limits := Limits{Daily: 100, Burst: 1}
+		state := Full(limits, now)
+		for range 10 {
+			var wait time.Duration
+			state, wait = Take(limits, state, now)
+			assert.Zero(t, wait)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package tracking
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// Reasons an event looks like it was caused by software rather than by the
+// contact.
+const (
+	// AppleMPP is Apple Mail Privacy Protection, which loads the images of
+	// every email on delivery whether the email is read or not.
+	AppleMPP = "apple_mpp"
+	// Bot covers crawlers, link scanners of mail filters and HTTP libraries.
+	Bot = "bot"
+)
+
+// appleNetwork is the address block of Apple, which Mail Privacy Protection
+// fetches images from.
+var appleNetwork = netip.MustParsePrefix("17.0.0.0/8")
+
+// botAgents are lowercase fragments of the user agents of automated clients.
+var botAgents = []string{
+	"bot", "crawler", "spider", "slurp", "preview", "scanner", "headless",
+	"curl/", "wget/", "python-", "go-http-client", "java/", "okhttp",
+	"barracuda", "mimecast", "proofpoint", "symantec", "trendmicro",
+}
+
+// Automated returns why an event from a client with userAgent and ip looks
+// machine-made: AppleMPP, Bot or an empty string for events that look like
+// they were caused by the contact. ip may be invalid when it is unknown.
+func Automated(userAgent string, ip netip.Addr) string {
+	// Privacy Protection fetches from Apple's network with a bare user agent,
+	// without the details browsers and mail clients add.
+	if ip.IsValid() && appleNetwork.Contains(ip.Unmap()) && userAgent == "Mozilla/5.0" {
+		return AppleMPP
+	}
+	agent := strings.ToLower(strings.TrimSpace(userAgent))
+	if agent == "" {
+		return Bot
+	}
+	for _, fragment := range botAgents {
+		if strings.Contains(agent, fragment) {
+			return Bot
+		}
+	}
+	return ""
+}
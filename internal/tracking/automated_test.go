@@ -0,0 +1,53 @@
+package tracking
+
+import (
+	"bytes"
+	"image/gif"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutomated(t *testing.T) {
+	const (
+		thunderbird = "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Thunderbird/128.3.0"
+		gmailProxy  = "Mozilla/5.0 (Windows NT 5.1; rv:11.0) Gecko Firefox/11.0 (via ggpht.com GoogleImageProxy)"
+	)
+	tests := []struct {
+		name      string
+		userAgent string
+		ip        string
+		want      string
+	}{
+		{"mail client", thunderbird, "203.0.113.7", ""},
+		{"unknown address", thunderbird, "", ""},
+		{"gmail fetches images when emails are opened", gmailProxy, "66.249.84.1", ""},
+		{"apple privacy protection", "Mozilla/5.0", "17.58.63.10", AppleMPP},
+		{"apple over ipv6 mapped addresses", "Mozilla/5.0", "::ffff:17.58.63.10", AppleMPP},
+		{"mail client on apple's network", thunderbird, "17.58.63.10", ""},
+		{"bare user agent outside apple's network", "Mozilla/5.0", "198.51.100.1", ""},
+		{"bare user agent from an unknown address", "Mozilla/5.0", "", ""},
+		{"no user agent", "", "198.51.100.1", Bot},
+		{"crawler", "Mozilla/5.0 (compatible; Googlebot/2.1)", "198.51.100.1", Bot},
+		{"link scanner", "Barracuda Sentinel (EE)", "198.51.100.1", Bot},
+		{"http library", "curl/8.5.0", "198.51.100.1", Bot},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ip netip.Addr
+			if tt.ip != "" {
+				ip = netip.MustParseAddr(tt.ip)
+			}
+			assert.Equal(t, tt.want, Automated(tt.userAgent, ip))
+		})
+	}
+}
+
+func TestPixel(t *testing.T) {
+	img, err := gif.Decode(bytes.NewReader(Pixel))
+	require.NoError(t, err)
+	assert.Equal(t, 1, img.Bounds().Dx())
+	assert.Equal(t, 1, img.Bounds().Dy())
+}
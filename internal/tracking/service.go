@@ -0,0 +1,87 @@
+package tracking
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/render"
+)
+
+// ErrUnknownLink is returned for click tokens whose URL is not a link of the
+// email they were sent in, or whose send job has been deleted.
+var ErrUnknownLink = errors.New("unknown tracking link")
+
+// Pixel is a transparent 1x1 GIF, the image the tracking pixel URL serves.
+var Pixel = []byte("GIF89a\x01\x00\x01\x00\x80\x00\x00\x00\x00\x00\xff\xff\xff!\xf9\x04\x01\x00\x00\x00\x00,\x00\x00\x00\x00\x01\x00\x01\x00\x00\x02\x02D\x01\x00;")
+
+// Client is who requested a tracking URL. IP is invalid when it is unknown.
+type Client struct {
+	UserAgent string
+	IP        netip.Addr
+}
+
+type Service struct {
+	db     *pgxpool.Pool
+	signer *Signer
+}
+
+func NewService(db *pgxpool.Pool, signer *Signer) *Service {
+	return &Service{db: db, signer: signer}
+}
+
+// RecordOpen records that client loaded the tracking pixel with token. Opens
+// of send jobs that have been deleted are ignored.
+func (s *Service) RecordOpen(ctx context.Context, token string, client Client) error {
+	t, err := s.signer.Verify(Open, token)
+	if err != nil {
+		return err
+	}
+
+	return s.record(ctx, models.New(s.db), models.TrackingEventTypeOpen, t, client)
+}
+
+// RecordClick records that client followed the tracked link with token and
+// returns the URL to redirect it to. Only links of the step content the send
+// job was created with are redirected to, so that a leaked signing key does
+// not turn the API into an open redirect. The URL is returned along with the
+// error when only recording the click failed.
+func (s *Service) RecordClick(ctx context.Context, token string, client Client) (string, error) {
+	t, err := s.signer.Verify(Click, token)
+	if err != nil {
+		return "", err
+	}
+
+	q := models.New(s.db)
+	job, err := q.GetSendJobByID(ctx, t.SendJobID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrUnknownLink
+	}
+	if err != nil {
+		return "", err
+	}
+	if !render.HasLink(job.EmailContent, t.URL) {
+		return "", ErrUnknownLink
+	}
+
+	return t.URL, s.record(ctx, q, models.TrackingEventTypeClick, t, client)
+}
+
+func (s *Service) record(ctx context.Context, q *models.Queries, eventType models.TrackingEventType, t Token, client Client) error {
+	params := &models.CreateTrackingEventParams{
+		Type:      eventType,
+		UserAgent: client.UserAgent,
+		Automated: Automated(client.UserAgent, client.IP),
+		Url:       pgtype.Text{String: t.URL, Valid: t.URL != ""},
+		SendJobID: t.SendJobID,
+	}
+	if client.IP.IsValid() {
+		params.IpAddress = &client.IP
+	}
+	_, err := q.CreateTrackingEvent(ctx, params)
+	return err
+}
@@ -0,0 +1,119 @@
+package tracking
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sequenceID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	stepID     = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	janeID     = uuid.MustParse("00000000-0000-0000-0000-000000000010")
+)
+
+// createSendJob creates a send job of the first step for jane.
+func createSendJob(t *testing.T, pool *pgxpool.Pool, content string) uuid.UUID {
+	t.Helper()
+	var jobID uuid.UUID
+	err := pool.QueryRow(context.Background(), `
+		WITH e AS (
+			INSERT INTO enrollments (sequence_id, contact_id) VALUES ($1, $2) RETURNING id
+		)
+		INSERT INTO send_jobs (enrollment_id, step_id, email_subject, email_content, scheduled_at)
+		SELECT e.id, $3, 'Hi', $4, NOW() FROM e
+		RETURNING id`,
+		sequenceID, janeID, stepID, content,
+	).Scan(&jobID)
+	require.NoError(t, err)
+	return jobID
+}
+
+func TestRecordOpen(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	signer := newSigner(t)
+	svc := NewService(pool, signer)
+	ctx := context.Background()
+	jobID := createSendJob(t, pool, "<p>Hi</p>")
+	token := signer.Sign(Token{Kind: Open, SendJobID: jobID})
+
+	t.Run("records the client", func(t *testing.T) {
+		err := svc.RecordOpen(ctx, token, Client{UserAgent: "Thunderbird", IP: netip.MustParseAddr("203.0.113.7")})
+		require.NoError(t, err)
+		err = svc.RecordOpen(ctx, token, Client{UserAgent: "Mozilla/5.0", IP: netip.MustParseAddr("17.58.63.10")})
+		require.NoError(t, err)
+
+		rows, err := pool.Query(ctx, `
+			SELECT type::text, user_agent, COALESCE(host(ip_address), ''), automated
+			FROM tracking_events WHERE send_job_id = $1 ORDER BY created_at`, jobID)
+		require.NoError(t, err)
+		defer rows.Close()
+
+		var events [][4]string
+		for rows.Next() {
+			var e [4]string
+			require.NoError(t, rows.Scan(&e[0], &e[1], &e[2], &e[3]))
+			events = append(events, e)
+		}
+		require.NoError(t, rows.Err())
+		assert.Equal(t, [][4]string{
+			{"open", "Thunderbird", "203.0.113.7", ""},
+			{"open", "Mozilla/5.0", "17.58.63.10", AppleMPP},
+		}, events)
+	})
+
+	t.Run("rejects forged tokens", func(t *testing.T) {
+		err := svc.RecordOpen(ctx, newSigner(t).Sign(Token{Kind: Open, SendJobID: jobID}), Client{})
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("ignores deleted send jobs", func(t *testing.T) {
+		err := svc.RecordOpen(ctx, signer.Sign(Token{Kind: Open, SendJobID: uuid.New()}), Client{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestRecordClick(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	signer := newSigner(t)
+	svc := NewService(pool, signer)
+	ctx := context.Background()
+	jobID := createSendJob(t, pool, `<a href="https://example.com/u/{{contact.email}}">Account</a> <a href="{{custom.website}}">Website</a>`)
+	client := Client{UserAgent: "Thunderbird", IP: netip.MustParseAddr("203.0.113.7")}
+	sign := func(jobID uuid.UUID, url string) string {
+		return signer.Sign(Token{Kind: Click, SendJobID: jobID, URL: url})
+	}
+
+	t.Run("records the click", func(t *testing.T) {
+		url, err := svc.RecordClick(ctx, sign(jobID, "https://example.com/u/jane@example.com"), client)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/u/jane@example.com", url)
+
+		var eventType, recorded string
+		err = pool.QueryRow(ctx, "SELECT type::text, url FROM tracking_events WHERE send_job_id = $1", jobID).Scan(&eventType, &recorded)
+		require.NoError(t, err)
+		assert.Equal(t, "click", eventType)
+		assert.Equal(t, url, recorded)
+	})
+
+	t.Run("only redirects to links of the email", func(t *testing.T) {
+		_, err := svc.RecordClick(ctx, sign(jobID, "https://evil.example.com"), client)
+		assert.ErrorIs(t, err, ErrUnknownLink, "hosts filled in by templates could be anything")
+	})
+
+	t.Run("rejects deleted send jobs", func(t *testing.T) {
+		_, err := svc.RecordClick(ctx, sign(uuid.New(), "https://example.com/u/x"), client)
+		assert.ErrorIs(t, err, ErrUnknownLink)
+	})
+
+	t.Run("rejects open tokens", func(t *testing.T) {
+		_, err := svc.RecordClick(ctx, signer.Sign(Token{Kind: Open, SendJobID: jobID}), client)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
@@ -0,0 +1,105 @@
+// Package tracking records what contacts do with the emails they are sent.
+// Emails refer to the public tracking endpoints with signed tokens, so that
+// events cannot be made up for sends that do not exist.
+package tracking
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidToken = errors.New("invalid tracking token")
+
+// Kind is what a token tracks. A token of one kind is not accepted for
+// another.
+type Kind byte
+
+const (
+	Open  Kind = 'o'
+	Click Kind = 'c'
+)
+
+// Token identifies the send job an event belongs to, and for clicks the URL
+// of the link.
+type Token struct {
+	Kind      Kind
+	SendJobID uuid.UUID
+	URL       string
+}
+
+// macSize is the length of the signatures in tokens, which are truncated
+// HMAC-SHA256 sums.
+const macSize = 16
+
+// Signer signs and verifies tokens with a secret key.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner returns a signer using key, the base64 encoding of 32 random
+// bytes.
+func NewSigner(key string) (*Signer, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decoding key: %w", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes long, got %d", len(raw))
+	}
+	return &Signer{key: raw}, nil
+}
+
+// Sign encodes token as a URL-safe string.
+func (s *Signer) Sign(token Token) string {
+	payload := append([]byte{byte(token.Kind)}, token.SendJobID[:]...)
+	payload = append(payload, token.URL...)
+	return base64.RawURLEncoding.EncodeToString(append(payload, s.mac(payload)...))
+}
+
+// Verify decodes a token of kind signed by s.
+func (s *Signer) Verify(kind Kind, value string) (Token, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(raw) < 1+len(uuid.UUID{})+macSize {
+		return Token{}, ErrInvalidToken
+	}
+	payload, mac := raw[:len(raw)-macSize], raw[len(raw)-macSize:]
+	if !hmac.Equal(mac, s.mac(payload)) || Kind(payload[0]) != kind {
+		return Token{}, ErrInvalidToken
+	}
+	return Token{
+		Kind:      kind,
+		SendJobID: uuid.UUID(payload[1 : 1+len(uuid.UUID{})]),
+		URL:       string(payload[1+len(uuid.UUID{}):]),
+	}, nil
+}
+
+func (s *Signer) mac(payload []byte) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write(payload)
+	return h.Sum(nil)[:macSize]
+}
+
+// URLs builds the tracking URLs put into emails.
+type URLs struct {
+	// BaseURL is where contacts reach the API, such as
+	// "https://api.example.com".
+	BaseURL string
+	Signer  *Signer
+}
+
+// Open returns the URL of the tracking pixel of a send job.
+func (u *URLs) Open(sendJobID uuid.UUID) string {
+	return strings.TrimRight(u.BaseURL, "/") + "/t/o/" + u.Signer.Sign(Token{Kind: Open, SendJobID: sendJobID})
+}
+
+// Click returns the URL a link to url in an email of a send job is rewritten
+// to.
+func (u *URLs) Click(sendJobID uuid.UUID, url string) string {
+	return strings.TrimRight(u.BaseURL, "/") + "/t/c/" + u.Signer.Sign(Token{Kind: Click, SendJobID: sendJobID, URL: url})
+}
@@ -0,0 +1,83 @@
+package tracking
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSigner(t *testing.T) *Signer {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	signer, err := NewSigner(base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+	return signer
+}
+
+func TestSigner(t *testing.T) {
+	signer := newSigner(t)
+	token := Token{Kind: Open, SendJobID: uuid.New()}
+	signed := signer.Sign(token)
+
+	t.Run("verifies its tokens", func(t *testing.T) {
+		got, err := signer.Verify(Open, signed)
+		require.NoError(t, err)
+		assert.Equal(t, token, got)
+	})
+
+	t.Run("rejects tokens of other keys", func(t *testing.T) {
+		_, err := newSigner(t).Verify(Open, signed)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("carries the url of clicks", func(t *testing.T) {
+		click := Token{Kind: Click, SendJobID: token.SendJobID, URL: "https://example.com/?a=1&b=ü"}
+		got, err := signer.Verify(Click, signer.Sign(click))
+		require.NoError(t, err)
+		assert.Equal(t, click, got)
+	})
+
+	t.Run("rejects tokens of another kind", func(t *testing.T) {
+		_, err := signer.Verify(Click, signed)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("rejects tampered tokens", func(t *testing.T) {
+		raw, err := base64.RawURLEncoding.DecodeString(signed)
+		require.NoError(t, err)
+		raw[5] ^= 1
+		_, err = signer.Verify(Open, base64.RawURLEncoding.EncodeToString(raw))
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("rejects malformed tokens", func(t *testing.T) {
+		for _, value := range []string{"", "not base64!", signed[:len(signed)-2]} {
+			_, err := signer.Verify(Open, value)
+			assert.ErrorIs(t, err, ErrInvalidToken, value)
+		}
+	})
+}
+
+func TestNewSigner(t *testing.T) {
+	_, err := NewSigner("c2hvcnQ=")
+	assert.EqualError(t, err, "key must be 32 bytes long, got 5")
+	_, err = NewSigner("not base64")
+	assert.Error(t, err)
+}
+
+func TestURLs(t *testing.T) {
+	signer := newSigner(t)
+	urls := &URLs{BaseURL: "https://api.example.com/", Signer: signer}
+	sendJobID := uuid.New()
+
+	assert.Equal(t, "https://api.example.com/t/o/"+signer.Sign(Token{Kind: Open, SendJobID: sendJobID}), urls.Open(sendJobID))
+	assert.Equal(t,
+		"https://api.example.com/t/c/"+signer.Sign(Token{Kind: Click, SendJobID: sendJobID, URL: "https://example.com"}),
+		urls.Click(sendJobID, "https://example.com"))
+}
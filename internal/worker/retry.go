@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/pirellik/sequence-api/internal/sender"
+)
+
+// RetryPolicy decides whether and when a failed send is tried again.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts after which a job is given up.
+	MaxAttempts int
+	// BaseDelay is the delay after the first failed attempt. It doubles with
+	// every further attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between attempts.
+	MaxDelay time.Duration
+}
+
+// Backoff returns how long to wait after the given failed attempt, counting
+// from 1. The delay is randomised between half and all of its exponential
+// value so that jobs failing together are not retried together.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.MaxDelay)
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// Retry reports whether a send that failed with err on the given attempt is
+// tried again, and after how long. Permanent failures are never retried.
+func (p RetryPolicy) Retry(attempt int, err error) (time.Duration, bool) {
+	if sender.IsPermanent(err) || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	return p.Backoff(attempt), true
+}
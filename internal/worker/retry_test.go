@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"errors"
+	"net/textproto"
+	"testing"
+	"time"
+
+	"github.com/pirellik/sequence-api/internal/sender"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: time.Minute}
+
+	for _, tc := range []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: time.Second},
+		{attempt: 2, max: 2 * time.Second},
+		{attempt: 4, max: 8 * time.Second},
+		{attempt: 7, max: time.Minute},
+		{attempt: 100, max: time.Minute},
+	} {
+		for range 100 {
+			delay := policy.Backoff(tc.attempt)
+			assert.GreaterOrEqual(t, delay, tc.max/2, "attempt %d", tc.attempt)
+			assert.LessOrEqual(t, delay, tc.max, "attempt %d", tc.attempt)
+		}
+	}
+
+	assert.Zero(t, RetryPolicy{}.Backoff(3))
+}
+
+func TestRetry(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: time.Minute}
+	transient := &textproto.Error{Code: 421, Msg: "service not available"}
+
+	delay, ok := policy.Retry(1, transient)
+	assert.True(t, ok)
+	assert.Positive(t, delay)
+
+	_, ok = policy.Retry(2, errors.New("connection reset"))
+	assert.True(t, ok)
+
+	_, ok = policy.Retry(3, transient)
+	assert.False(t, ok, "attempts are exhausted")
+
+	_, ok = policy.Retry(1, &textproto.Error{Code: 550, Msg: "mailbox unavailable"})
+	assert.False(t, ok, "5xx replies are permanent")
+
+	_, ok = policy.Retry(1, sender.Permanent(errors.New("invalid address")))
+	assert.False(t, ok)
+}
@@ -0,0 +1,413 @@
+// Package worker delivers the send jobs published by the scheduler.
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db"
+	"github.com/pirellik/sequence-api/internal/db/models"
+	"github.com/pirellik/sequence-api/internal/queue"
+	"github.com/pirellik/sequence-api/internal/render"
+	"github.com/pirellik/sequence-api/internal/scheduler"
+	"github.com/pirellik/sequence-api/internal/secret"
+	"github.com/pirellik/sequence-api/internal/sender"
+	"github.com/pirellik/sequence-api/internal/throttle"
+	"github.com/pirellik/sequence-api/internal/tracking"
+)
+
+// errUnfinishedAttempt is why a job is given up on when an earlier attempt to
+// send it did not finish.
+var errUnfinishedAttempt = errors.New("an earlier attempt did not finish, the email may have been sent")
+
+type Options struct {
+	// Concurrency is the number of messages processed in parallel.
+	Concurrency int
+	// VisibilityTimeout is how long a received message stays hidden between
+	// heartbeats.
+	VisibilityTimeout time.Duration
+	// PollInterval is how long an idle worker waits before polling again.
+	PollInterval time.Duration
+	// SendTimeout bounds a single delivery attempt.
+	SendTimeout time.Duration
+	// Retry decides which failed jobs are retried and when.
+	Retry RetryPolicy
+	// From is the sender address of emails without a mailbox.
+	From string
+	// Mailbox returns the sender delivering through the SMTP account of a
+	// mailbox. When it is nil, the default sender delivers all emails.
+	Mailbox func(account sender.SMTPOptions) sender.Sender
+	// Secrets decrypts the SMTP passwords of mailboxes. It is only needed
+	// with Mailbox.
+	Secrets *secret.Box
+	// Tracking builds the tracking URLs of emails. Nothing is tracked when
+	// it is nil.
+	Tracking *tracking.URLs
+}
+
+type Worker struct {
+	db     *pgxpool.Pool
+	queue  queue.Queue
+	sender sender.Sender
+	opts   Options
+	now    func() time.Time
+}
+
+func New(db *pgxpool.Pool, q queue.Queue, s sender.Sender, opts Options) *Worker {
+	return &Worker{db: db, queue: q, sender: s, opts: opts, now: time.Now}
+}
+
+// Run processes messages until ctx is done. Messages being processed when ctx
+// is done are finished first.
+func (w *Worker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range w.opts.Concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.loop(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+func (w *Worker) loop(ctx context.Context) {
+	for ctx.Err() == nil {
+		messages, err := w.queue.Receive(ctx, 1, w.opts.VisibilityTimeout)
+		if err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "receiving messages", "err", err)
+		}
+		if len(messages) == 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(w.opts.PollInterval):
+			}
+			continue
+		}
+
+		for _, msg := range messages {
+			if err := w.process(context.WithoutCancel(ctx), msg); err != nil {
+				slog.ErrorContext(ctx, "processing message", "message_id", msg.ID, "err", err)
+			}
+		}
+	}
+}
+
+// process delivers the send job of msg. Messages can be delivered more than
+// once, so a job that has been sent or given up on already is only
+// acknowledged. Failed jobs are retried according to the retry policy and
+// moved to the dead letters when it gives up on them.
+//
+// Every attempt is recorded before the email is sent and finished after, so
+// that an attempt whose outcome is unknown, because the worker stopped or
+// could not record it, is never repeated. Such jobs are moved to the dead
+// letters instead, where they can be requeued once it is clear that the
+// email has not been sent.
+func (w *Worker) process(ctx context.Context, msg *queue.Message) error {
+	var body scheduler.SendJobMessage
+	if err := json.Unmarshal(msg.Body, &body); err != nil {
+		slog.ErrorContext(ctx, "dropping malformed message", "message_id", msg.ID, "err", err)
+		return w.queue.Ack(ctx, msg)
+	}
+
+	q := models.New(w.db)
+	job, err := q.GetSendJobForDelivery(ctx, body.SendJobID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The enrollment or the contact has been deleted since.
+		return w.queue.Ack(ctx, msg)
+	}
+	if err != nil {
+		return fmt.Errorf("getting send job: %w", err)
+	}
+
+	delivered, err := q.SendJobDelivered(ctx, job.ID)
+	if err != nil {
+		return fmt.Errorf("checking earlier sends: %w", err)
+	}
+	if delivered || job.DeadLettered || cancelled(job.EnrollmentState) {
+		return w.queue.Ack(ctx, msg)
+	}
+
+	// Deferred deliveries do not use up attempts, so they are counted from
+	// the recorded sends rather than from the deliveries of msg.
+	attempt := int(job.FailedAttempts) + 1
+
+	if job.UnfinishedAttemptAt.Valid {
+		// The attempt may still be running if another worker lost msg while
+		// sending, so it is given until its send timeout to finish.
+		if wait := job.UnfinishedAttemptAt.Time.Add(w.opts.SendTimeout).Sub(w.now()); wait > 0 {
+			return w.queue.Nack(ctx, msg, wait)
+		}
+		err := q.CreateDeadLetter(ctx, &models.CreateDeadLetterParams{
+			SendJobID: job.ID,
+			Attempts:  int32(attempt),
+			Error:     errUnfinishedAttempt.Error(),
+		})
+		if err != nil {
+			return fmt.Errorf("moving send job to dead letters: %w", err)
+		}
+		slog.ErrorContext(ctx, "giving up on send job", "send_job_id", job.ID, "attempt", attempt, "err", errUnfinishedAttempt)
+		return w.queue.Ack(ctx, msg)
+	}
+
+	if job.MailboxID.Valid {
+		wait, err := w.throttle(ctx, job.MailboxID.Bytes)
+		if err != nil {
+			return fmt.Errorf("throttling mailbox: %w", err)
+		}
+		if wait > 0 {
+			slog.DebugContext(ctx, "deferring send job", "send_job_id", job.ID, "mailbox_id", uuid.UUID(job.MailboxID.Bytes), "wait", wait)
+			return w.queue.Nack(ctx, msg, wait)
+		}
+	}
+
+	sendID, err := q.CreateSend(ctx, &models.CreateSendParams{
+		SendJobID:  job.ID,
+		Generation: job.Generation,
+		Attempt:    int32(attempt),
+		StartedAt:  pgtype.Timestamptz{Time: w.now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("recording send: %w", err)
+	}
+	sendErr := w.send(ctx, msg, job)
+
+	status, errText := models.SendStatusSent, pgtype.Text{}
+	var (
+		delay time.Duration
+		retry bool
+	)
+	if sendErr != nil {
+		status, errText = models.SendStatusFailed, pgtype.Text{String: sendErr.Error(), Valid: true}
+		delay, retry = w.opts.Retry.Retry(attempt, sendErr)
+	}
+	err = db.InTx(ctx, w.db, func(q *models.Queries) error {
+		err := q.FinishSend(ctx, &models.FinishSendParams{
+			ID:     sendID,
+			Status: status,
+			Error:  errText,
+		})
+		if err != nil || sendErr == nil || retry {
+			return err
+		}
+		return q.CreateDeadLetter(ctx, &models.CreateDeadLetterParams{
+			SendJobID: job.ID,
+			Attempts:  int32(attempt),
+			Error:     sendErr.Error(),
+		})
+	})
+	if err != nil {
+		// The attempt stays unfinished, so when msg times out the job is
+		// moved to the dead letters rather than sent again.
+		return fmt.Errorf("finishing send: %w", err)
+	}
+
+	switch {
+	case sendErr == nil:
+		return w.queue.Ack(ctx, msg)
+	case retry:
+		slog.WarnContext(ctx, "sending email", "send_job_id", job.ID, "attempt", attempt, "retry_in", delay, "err", sendErr)
+		return w.queue.Nack(ctx, msg, delay)
+	default:
+		slog.ErrorContext(ctx, "giving up on send job", "send_job_id", job.ID, "attempt", attempt, "err", sendErr)
+		return w.queue.Ack(ctx, msg)
+	}
+}
+
+// throttle takes a send slot of a mailbox. It returns zero when the job may be
+// sent now, or how long it has to wait for the next slot otherwise.
+func (w *Worker) throttle(ctx context.Context, mailboxID uuid.UUID) (time.Duration, error) {
+	now := w.now()
+
+	var wait time.Duration
+	err := db.InTx(ctx, w.db, func(q *models.Queries) error {
+		row, err := q.LockMailboxThrottle(ctx, mailboxID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			row, err = createThrottle(ctx, q, mailboxID, now)
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The mailbox has been deleted since the job was loaded.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		limits := throttle.Limits{
+			Daily:    int(row.DailyLimit),
+			Interval: time.Duration(row.SendIntervalSeconds) * time.Second,
+			Burst:    int(row.SendBurst),
+		}
+		state := throttle.State{
+			Day:       row.Day.Time,
+			Sent:      int(row.Sent),
+			Tokens:    row.Tokens,
+			UpdatedAt: row.UpdatedAt.Time,
+		}
+		if state, wait = throttle.Take(limits, state, now); wait > 0 {
+			return nil
+		}
+		return q.UpdateMailboxThrottle(ctx, &models.UpdateMailboxThrottleParams{
+			MailboxID: mailboxID,
+			Day:       pgtype.Date{Time: state.Day, Valid: true},
+			Sent:      int32(state.Sent),
+			Tokens:    state.Tokens,
+			UpdatedAt: pgtype.Timestamptz{Time: state.UpdatedAt, Valid: true},
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return wait, nil
+}
+
+// createThrottle sets up the throttling state of a mailbox that has not sent
+// anything yet and locks it.
+func createThrottle(ctx context.Context, q *models.Queries, mailboxID uuid.UUID, now time.Time) (*models.LockMailboxThrottleRow, error) {
+	limits, err := q.GetMailboxLimits(ctx, mailboxID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := throttle.Full(throttle.Limits{Burst: int(limits.SendBurst)}, now)
+	err = q.CreateMailboxThrottle(ctx, &models.CreateMailboxThrottleParams{
+		MailboxID: mailboxID,
+		Day:       pgtype.Date{Time: state.Day, Valid: true},
+		Tokens:    state.Tokens,
+		UpdatedAt: pgtype.Timestamptz{Time: state.UpdatedAt, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return q.LockMailboxThrottle(ctx, mailboxID)
+}
+
+// send delivers job while keeping msg hidden from other workers.
+func (w *Worker) send(ctx context.Context, msg *queue.Message, job *models.GetSendJobForDeliveryRow) error {
+	ctx, stop := queue.Heartbeat(ctx, w.queue, msg, w.opts.VisibilityTimeout)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(ctx, w.opts.SendTimeout)
+	defer cancel()
+
+	s, from, err := w.senderFor(job)
+	if err != nil {
+		return err
+	}
+	email, err := renderEmail(from, job, w.renderOptions(job))
+	if err != nil {
+		// The step or the contact has to be fixed before the job can be sent.
+		return sender.Permanent(err)
+	}
+	return s.Send(ctx, email)
+}
+
+// senderFor returns the sender and the from address of job, which are those
+// of its mailbox if it has one.
+func (w *Worker) senderFor(job *models.GetSendJobForDeliveryRow) (sender.Sender, string, error) {
+	if !job.FromAddress.Valid {
+		return w.sender, w.opts.From, nil
+	}
+
+	from := (&mail.Address{Name: job.FromName.String, Address: job.FromAddress.String}).String()
+	if w.opts.Mailbox == nil {
+		return w.sender, from, nil
+	}
+
+	account := sender.SMTPOptions{
+		Host:     job.SmtpHost.String,
+		Port:     int(job.SmtpPort.Int32),
+		Username: job.SmtpUsername.String,
+	}
+	if len(job.SmtpPassword) > 0 {
+		password, err := w.opts.Secrets.Open(job.SmtpPassword, job.MailboxID.Bytes[:])
+		if err != nil {
+			// Passwords stored before they were bound to their mailbox are
+			// sealed without associated data until they are next updated.
+			password, err = w.opts.Secrets.Open(job.SmtpPassword, nil)
+		}
+		if err != nil {
+			// Retrying does not help until the encryption key is fixed, after
+			// which the dead letter can be requeued.
+			return nil, "", sender.Permanent(fmt.Errorf("decrypting smtp password: %w", err))
+		}
+		account.Password = string(password)
+	}
+	return w.opts.Mailbox(account), from, nil
+}
+
+// renderOptions returns how the step of job is rendered. Rendering is strict,
+// adds a tracking pixel if the sequence tracks opens and rewrites links if it
+// tracks clicks.
+func (w *Worker) renderOptions(job *models.GetSendJobForDeliveryRow) render.Options {
+	opts := render.Options{Strict: true}
+	if w.opts.Tracking == nil {
+		return opts
+	}
+	if job.OpenTrackingEnabled {
+		opts.PixelURL = w.opts.Tracking.Open(job.ID)
+	}
+	if job.ClickTrackingEnabled {
+		opts.TrackLink = func(url string) string {
+			return w.opts.Tracking.Click(job.ID, url)
+		}
+	}
+	return opts
+}
+
+// renderEmail renders the step of job for its contact.
+func renderEmail(from string, job *models.GetSendJobForDeliveryRow, opts render.Options) (*sender.Email, error) {
+	vars := &render.Variables{
+		Contact: &render.Contact{
+			Email:        job.ContactEmail,
+			FirstName:    job.ContactFirstName,
+			LastName:     job.ContactLastName,
+			TimeZone:     job.ContactTimeZone,
+			CustomFields: job.ContactCustomFields,
+		},
+		Sender:   senderVariables(from),
+		Sequence: render.Sequence{Name: job.SequenceName},
+	}
+	step := render.Step{Subject: job.EmailSubject, Content: job.EmailContent}
+	rendered, err := render.Render(step, vars.Data(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sender.Email{
+		From:    from,
+		To:      job.ContactEmail,
+		Subject: rendered.Subject,
+		HTML:    rendered.HTML,
+		Text:    rendered.Text,
+	}, nil
+}
+
+// senderVariables splits the from address into the sender variables of
+// templates.
+func senderVariables(from string) *render.Sender {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return &render.Sender{Email: from}
+	}
+	return &render.Sender{Name: addr.Name, Email: addr.Address}
+}
+
+// cancelled reports whether jobs of an enrollment in state should no longer
+// be sent. Paused enrollments still get the steps that fell due before they
+// were paused.
+func cancelled(state models.EnrollmentState) bool {
+	return state == models.EnrollmentStateStopped || state == models.EnrollmentStateFailed
+}
@@ -0,0 +1,419 @@
+package worker
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/textproto"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pirellik/sequence-api/internal/db/dbtest"
+	"github.com/pirellik/sequence-api/internal/deadletter"
+	"github.com/pirellik/sequence-api/internal/enrollment"
+	"github.com/pirellik/sequence-api/internal/queue"
+	"github.com/pirellik/sequence-api/internal/scheduler"
+	"github.com/pirellik/sequence-api/internal/secret"
+	"github.com/pirellik/sequence-api/internal/sender"
+	"github.com/pirellik/sequence-api/internal/tracking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	sequenceID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	janeID     = uuid.MustParse("00000000-0000-0000-0000-000000000010")
+	johnID     = uuid.MustParse("00000000-0000-0000-0000-000000000011")
+	salesID    = uuid.MustParse("00000000-0000-0000-0000-000000000020")
+)
+
+// setup enrolls the contact, schedules its first step and returns the
+// enrollment and the send job.
+func setup(t *testing.T, pool *pgxpool.Pool, contactID uuid.UUID) (uuid.UUID, uuid.UUID) {
+	t.Helper()
+	ctx := context.Background()
+
+	enrolled, err := enrollment.NewService(pool).Enroll(ctx, sequenceID, contactID)
+	require.NoError(t, err)
+
+	_, err = pool.Exec(ctx, "UPDATE enrollments SET next_send_at = NOW() WHERE id = $1", enrolled.ID)
+	require.NoError(t, err)
+	scheduled, err := scheduler.New(pool, queue.NewMemory(), scheduler.Options{BatchSize: 10}).Tick(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, scheduled)
+
+	var jobID uuid.UUID
+	err = pool.QueryRow(ctx, "SELECT id FROM send_jobs WHERE enrollment_id = $1", enrolled.ID).Scan(&jobID)
+	require.NoError(t, err)
+	return enrolled.ID, jobID
+}
+
+func enqueue(t *testing.T, q queue.Queue, jobID uuid.UUID) {
+	t.Helper()
+	body, err := json.Marshal(scheduler.SendJobMessage{SendJobID: jobID})
+	require.NoError(t, err)
+	_, err = q.Enqueue(context.Background(), body, 0)
+	require.NoError(t, err)
+}
+
+// processNext receives the next message and processes it.
+func processNext(t *testing.T, w *Worker, q queue.Queue) {
+	t.Helper()
+	ctx := context.Background()
+	messages, err := q.Receive(ctx, 1, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	require.NoError(t, w.process(ctx, messages[0]))
+}
+
+func sendStatuses(t *testing.T, pool *pgxpool.Pool, jobID uuid.UUID) []string {
+	t.Helper()
+	rows, err := pool.Query(context.Background(), "SELECT status::text FROM sends WHERE send_job_id = $1 ORDER BY attempt", jobID)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var statuses []string
+	for rows.Next() {
+		var status string
+		require.NoError(t, rows.Scan(&status))
+		statuses = append(statuses, status)
+	}
+	require.NoError(t, rows.Err())
+	return statuses
+}
+
+func newWorker(pool *pgxpool.Pool, q queue.Queue, s sender.Sender) *Worker {
+	return New(pool, q, s, Options{
+		VisibilityTimeout: time.Minute,
+		SendTimeout:       time.Minute,
+		From:              "Sequence API <no-reply@example.com>",
+		// Failed messages come back right away.
+		Retry: RetryPolicy{MaxAttempts: 2},
+	})
+}
+
+func TestWorker(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	_, jobID := setup(t, pool, janeID)
+
+	sendQueue := queue.NewMemory()
+	outbox := sender.NewMemory()
+	w := newWorker(pool, sendQueue, outbox)
+
+	t.Run("retries failed sends", func(t *testing.T) {
+		outbox.Fail(errors.New("connection refused"))
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		assert.Empty(t, outbox.Sent())
+		assert.Equal(t, []string{"failed"}, sendStatuses(t, pool, jobID))
+		assert.Equal(t, 1, sendQueue.Len(), "the message is retried")
+	})
+
+	t.Run("delivers the job", func(t *testing.T) {
+		outbox.Fail(nil)
+		processNext(t, w, sendQueue)
+
+		sent := outbox.Sent()
+		require.Len(t, sent, 1)
+		assert.Equal(t, "jane@example.com", sent[0].To)
+		assert.Equal(t, "Initial Subject", sent[0].Subject)
+		assert.Equal(t, []string{"failed", "sent"}, sendStatuses(t, pool, jobID))
+		assert.Zero(t, sendQueue.Len())
+	})
+
+	t.Run("skips duplicate messages", func(t *testing.T) {
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		assert.Len(t, outbox.Sent(), 1)
+		assert.Equal(t, []string{"failed", "sent"}, sendStatuses(t, pool, jobID))
+		assert.Zero(t, sendQueue.Len())
+	})
+}
+
+func countDeadLetters(t *testing.T, pool *pgxpool.Pool, jobID uuid.UUID) int {
+	t.Helper()
+	var count int
+	err := pool.QueryRow(context.Background(), "SELECT COUNT(*) FROM dead_letters WHERE send_job_id = $1", jobID).Scan(&count)
+	require.NoError(t, err)
+	return count
+}
+
+func TestWorkerPermanentFailure(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	_, jobID := setup(t, pool, janeID)
+
+	sendQueue := queue.NewMemory()
+	outbox := sender.NewMemory()
+	w := newWorker(pool, sendQueue, outbox)
+
+	outbox.Fail(&textproto.Error{Code: 550, Msg: "mailbox unavailable"})
+	enqueue(t, sendQueue, jobID)
+	processNext(t, w, sendQueue)
+
+	assert.Equal(t, []string{"failed"}, sendStatuses(t, pool, jobID))
+	assert.Equal(t, 1, countDeadLetters(t, pool, jobID))
+	assert.Zero(t, sendQueue.Len(), "permanent failures are not retried")
+
+	t.Run("skips duplicate messages", func(t *testing.T) {
+		outbox.Fail(nil)
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		assert.Empty(t, outbox.Sent())
+		assert.Equal(t, []string{"failed"}, sendStatuses(t, pool, jobID))
+	})
+}
+
+func TestWorkerExhaustedAttempts(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	_, jobID := setup(t, pool, janeID)
+
+	sendQueue := queue.NewMemory()
+	outbox := sender.NewMemory()
+	w := newWorker(pool, sendQueue, outbox)
+
+	outbox.Fail(&textproto.Error{Code: 421, Msg: "service not available"})
+	enqueue(t, sendQueue, jobID)
+
+	processNext(t, w, sendQueue)
+	assert.Zero(t, countDeadLetters(t, pool, jobID))
+	assert.Equal(t, 1, sendQueue.Len())
+
+	processNext(t, w, sendQueue)
+	assert.Equal(t, []string{"failed", "failed"}, sendStatuses(t, pool, jobID))
+	assert.Equal(t, 1, countDeadLetters(t, pool, jobID))
+	assert.Zero(t, sendQueue.Len())
+
+	t.Run("gets a fresh set of attempts when requeued", func(t *testing.T) {
+		ctx := context.Background()
+		var letterID uuid.UUID
+		err := pool.QueryRow(ctx, "SELECT id FROM dead_letters WHERE send_job_id = $1", jobID).Scan(&letterID)
+		require.NoError(t, err)
+		require.NoError(t, deadletter.NewService(pool).Requeue(ctx, letterID))
+
+		// The message can arrive before the job is marked as published.
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		assert.Zero(t, countDeadLetters(t, pool, jobID))
+		assert.Equal(t, 1, sendQueue.Len(), "the job is retried")
+	})
+}
+
+func TestWorkerUnfinishedAttempt(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	_, jobID := setup(t, pool, janeID)
+
+	_, err := pool.Exec(context.Background(), "INSERT INTO sends (send_job_id, attempt, status, started_at) VALUES ($1, 1, 'sending', NOW())", jobID)
+	require.NoError(t, err)
+
+	sendQueue := queue.NewMemory()
+	outbox := sender.NewMemory()
+	w := newWorker(pool, sendQueue, outbox)
+
+	enqueue(t, sendQueue, jobID)
+	processNext(t, w, sendQueue)
+
+	assert.Empty(t, outbox.Sent())
+	assert.Zero(t, countDeadLetters(t, pool, jobID))
+	assert.Equal(t, 1, sendQueue.Len(), "the attempt may still finish")
+
+	t.Run("gives up once the attempt has timed out", func(t *testing.T) {
+		w.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		assert.Empty(t, outbox.Sent(), "the email may have been sent")
+		assert.Equal(t, []string{"sending"}, sendStatuses(t, pool, jobID))
+		assert.Equal(t, 1, countDeadLetters(t, pool, jobID))
+	})
+}
+
+func TestWorkerStoppedEnrollment(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	enrollmentID, jobID := setup(t, pool, janeID)
+
+	_, err := enrollment.NewService(pool).Stop(context.Background(), enrollmentID)
+	require.NoError(t, err)
+
+	sendQueue := queue.NewMemory()
+	outbox := sender.NewMemory()
+	enqueue(t, sendQueue, jobID)
+	processNext(t, newWorker(pool, sendQueue, outbox), sendQueue)
+
+	assert.Empty(t, outbox.Sent())
+	assert.Empty(t, sendStatuses(t, pool, jobID))
+	assert.Zero(t, sendQueue.Len())
+}
+
+func TestWorkerMailbox(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+	_, jobID := setup(t, pool, janeID)
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	box, err := secret.NewBox(base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+
+	password, err := box.Seal([]byte("hunter2"), salesID[:])
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, "UPDATE mailboxes SET smtp_password = $1 WHERE id = $2", password, salesID)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, "UPDATE send_jobs SET mailbox_id = $1 WHERE id = $2", salesID, jobID)
+	require.NoError(t, err)
+
+	sendQueue := queue.NewMemory()
+	defaultSender, mailboxSender := sender.NewMemory(), sender.NewMemory()
+	var account sender.SMTPOptions
+	w := newWorker(pool, sendQueue, defaultSender)
+	w.opts.Secrets = box
+	w.opts.Mailbox = func(a sender.SMTPOptions) sender.Sender {
+		account = a
+		return mailboxSender
+	}
+
+	enqueue(t, sendQueue, jobID)
+	processNext(t, w, sendQueue)
+
+	assert.Empty(t, defaultSender.Sent())
+	sent := mailboxSender.Sent()
+	require.Len(t, sent, 1)
+	assert.Equal(t, `"Sales" <sales@example.com>`, sent[0].From)
+	assert.Equal(t, sender.SMTPOptions{Host: "smtp.example.com", Port: 587, Username: "sales", Password: "hunter2"}, account)
+}
+
+func TestWorkerThrottle(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+	_, first := setup(t, pool, janeID)
+	_, second := setup(t, pool, johnID)
+
+	_, err := pool.Exec(ctx, "UPDATE mailboxes SET daily_limit = 1, send_interval_seconds = 0 WHERE id = $1", salesID)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, "UPDATE send_jobs SET mailbox_id = $1", salesID)
+	require.NoError(t, err)
+
+	sendQueue := queue.NewMemory()
+	outbox := sender.NewMemory()
+	w := newWorker(pool, sendQueue, outbox)
+
+	enqueue(t, sendQueue, first)
+	processNext(t, w, sendQueue)
+	assert.Len(t, outbox.Sent(), 1)
+
+	enqueue(t, sendQueue, second)
+	processNext(t, w, sendQueue)
+	assert.Len(t, outbox.Sent(), 1, "the daily limit is reached")
+	assert.Empty(t, sendStatuses(t, pool, second), "deferring is not an attempt")
+	assert.Equal(t, 1, sendQueue.Len(), "the job is deferred, not dropped")
+
+	received, err := sendQueue.Receive(ctx, 1, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, received, "the job waits for the next day")
+
+	t.Run("sends again the next day", func(t *testing.T) {
+		tomorrow := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+		w.now = func() time.Time { return tomorrow }
+
+		enqueue(t, sendQueue, second)
+		processNext(t, w, sendQueue)
+		assert.Len(t, outbox.Sent(), 2)
+	})
+}
+
+func TestWorkerTemplates(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+	_, jobID := setup(t, pool, janeID)
+
+	sendQueue := queue.NewMemory()
+	outbox := sender.NewMemory()
+	w := newWorker(pool, sendQueue, outbox)
+
+	t.Run("renders the step for the contact", func(t *testing.T) {
+		_, err := pool.Exec(ctx, `UPDATE send_jobs SET email_subject = $1, email_content = $2 WHERE id = $3`,
+			"{{sequence.name}} for {{custom.company}}",
+			`<p>Hi {{contact.firstName}}, {{sender.name | default "the team"}}</p>`,
+			jobID)
+		require.NoError(t, err)
+
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		sent := outbox.Sent()
+		require.Len(t, sent, 1)
+		assert.Equal(t, "Test Sequence for Acme", sent[0].Subject)
+		assert.Equal(t, "<p>Hi Jane, Sequence API</p>", sent[0].HTML)
+		assert.Equal(t, "Hi Jane, Sequence API", sent[0].Text)
+	})
+
+	t.Run("gives up on missing variables", func(t *testing.T) {
+		_, jobID := setup(t, pool, johnID)
+		_, err := pool.Exec(ctx, "UPDATE send_jobs SET email_subject = '{{custom.company}}' WHERE id = $1", jobID)
+		require.NoError(t, err)
+
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		assert.Len(t, outbox.Sent(), 1)
+		assert.Equal(t, []string{"failed"}, sendStatuses(t, pool, jobID))
+		assert.Zero(t, sendQueue.Len(), "retrying does not help")
+	})
+}
+
+func TestWorkerTracking(t *testing.T) {
+	pool := dbtest.New(t, "../db/fixtures")
+	ctx := context.Background()
+	_, jobID := setup(t, pool, janeID)
+	_, err := pool.Exec(ctx, `UPDATE send_jobs SET email_content = '<a href="https://example.com">Acme</a>' WHERE id = $1`, jobID)
+	require.NoError(t, err)
+
+	key := make([]byte, 32)
+	_, err = rand.Read(key)
+	require.NoError(t, err)
+	signer, err := tracking.NewSigner(base64.StdEncoding.EncodeToString(key))
+	require.NoError(t, err)
+
+	sendQueue := queue.NewMemory()
+	outbox := sender.NewMemory()
+	w := newWorker(pool, sendQueue, outbox)
+	w.opts.Tracking = &tracking.URLs{BaseURL: "https://api.example.com", Signer: signer}
+
+	t.Run("tracks opens and clicks when the sequence does", func(t *testing.T) {
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		sent := outbox.Sent()
+		require.Len(t, sent, 1)
+		clickURL := "https://api.example.com/t/c/" + signer.Sign(tracking.Token{Kind: tracking.Click, SendJobID: jobID, URL: "https://example.com"})
+		assert.Contains(t, sent[0].HTML, `<a href="`+clickURL+`">Acme</a>`)
+		assert.Contains(t, sent[0].HTML, `<img src="https://api.example.com/t/o/`+signer.Sign(tracking.Token{Kind: tracking.Open, SendJobID: jobID})+`"`)
+		assert.Equal(t, "Acme ("+clickURL+")", sent[0].Text)
+	})
+
+	t.Run("leaves other emails alone", func(t *testing.T) {
+		_, err := pool.Exec(ctx, "UPDATE sequences SET open_tracking_enabled = false, click_tracking_enabled = false WHERE id = $1", sequenceID)
+		require.NoError(t, err)
+		_, jobID := setup(t, pool, johnID)
+		_, err = pool.Exec(ctx, `UPDATE send_jobs SET email_content = '<a href="https://example.com">Acme</a>' WHERE id = $1`, jobID)
+		require.NoError(t, err)
+
+		enqueue(t, sendQueue, jobID)
+		processNext(t, w, sendQueue)
+
+		sent := outbox.Sent()
+		require.Len(t, sent, 2)
+		assert.NotContains(t, sent[1].HTML, "<img")
+		assert.NotContains(t, sent[1].HTML, "/t/c/")
+	})
+}
@@ -0,0 +1,205 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+	// maxIdempotentBodySize bounds the request bodies read into memory to be
+	// hashed.
+	maxIdempotentBodySize = 1 << 20
+)
+
+var (
+	ErrIdempotencyKeyInvalid = errors.New("invalid idempotency key")
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was used for a different request")
+	ErrIdempotencyKeyInUse   = errors.New("request with the same idempotency key is in progress")
+)
+
+// IdempotentResponse is the response recorded for an idempotency key.
+type IdempotentResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// IdempotencyRecord is what a store holds for an idempotency key.
+type IdempotencyRecord struct {
+	RequestHash []byte
+	// Response is nil while the request that claimed the key is in progress.
+	Response *IdempotentResponse
+}
+
+// IdempotencyStore persists the responses of requests sent with an
+// Idempotency-Key header.
+type IdempotencyStore interface {
+	// Reserve claims key for a request with the given hash until leaseUntil,
+	// after which a claim without a response can be taken over. It returns
+	// nil when the key has been claimed, or the record stored for the key when
+	// it is already taken.
+	Reserve(ctx context.Context, key string, requestHash []byte, leaseUntil time.Time) (*IdempotencyRecord, error)
+	// Complete stores the response of the request that claimed key until
+	// expiresAt.
+	Complete(ctx context.Context, key string, response *IdempotentResponse, expiresAt time.Time) error
+	// Release drops the claim on key so that the request can be retried.
+	Release(ctx context.Context, key string) error
+}
+
+// Idempotency makes POST requests carrying an Idempotency-Key header safe to
+// retry. The first request with a key is processed and its response stored
+// for ttl; later requests with the same key and body get the stored response
+// replayed. Server errors are not stored, so such requests can be retried.
+// A key stays claimed for lease while its request is processed, so that a
+// request that never finishes, because the process died, does not hold it
+// for longer. Failures are reported through errorHandler using the
+// ErrIdempotencyKey* errors.
+func Idempotency(
+	store IdempotencyStore,
+	ttl time.Duration,
+	lease time.Duration,
+	errorHandler func(http.ResponseWriter, *http.Request, error),
+) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				errorHandler(w, r, ErrIdempotencyKeyInvalid)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+			if err != nil {
+				errorHandler(w, r, err)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			hash := requestHash(r, body)
+			record, err := store.Reserve(r.Context(), key, hash, time.Now().Add(lease))
+			if err != nil {
+				errorHandler(w, r, err)
+				return
+			}
+
+			switch {
+			case record == nil:
+			case !bytes.Equal(record.RequestHash, hash):
+				errorHandler(w, r, ErrIdempotencyKeyReused)
+				return
+			case record.Response == nil:
+				errorHandler(w, r, ErrIdempotencyKeyInUse)
+				return
+			default:
+				replay(w, record.Response)
+				return
+			}
+
+			// The response has been sent already, so it has to be stored even
+			// if the client went away in the meantime.
+			ctx := context.WithoutCancel(r.Context())
+
+			recorder := &responseRecorder{ResponseWriter: w}
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				// The handler panicked, which is a server error as well.
+				if err := store.Release(ctx, key); err != nil {
+					slog.ErrorContext(ctx, "releasing idempotency key", "err", err)
+				}
+			}()
+			next.ServeHTTP(recorder, r)
+			completed = true
+
+			if recorder.status() >= http.StatusInternalServerError {
+				err = store.Release(ctx, key)
+			} else {
+				err = store.Complete(ctx, key, recorder.response(), time.Now().Add(ttl))
+			}
+			if err != nil {
+				slog.ErrorContext(ctx, "storing idempotent response", "err", err)
+			}
+		})
+	}
+}
+
+// requestHash identifies a request by its method, path, query and body, so
+// that a key reused for another endpoint is detected as well.
+func requestHash(r *http.Request, body []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.Path))
+	h.Write([]byte{0})
+	h.Write([]byte(r.URL.RawQuery))
+	h.Write([]byte{0})
+	h.Write(body)
+	return h.Sum(nil)
+}
+
+func replay(w http.ResponseWriter, response *IdempotentResponse) {
+	for name, values := range response.Header {
+		w.Header()[name] = values
+	}
+	w.Header().Set(IdempotentReplayedHeader, "true")
+	w.WriteHeader(response.StatusCode)
+	_, _ = w.Write(response.Body)
+}
+
+// responseRecorder passes the response through while keeping a copy of it.
+type responseRecorder struct {
+	http.ResponseWriter
+	statusCode int
+	header     http.Header
+	body       bytes.Buffer
+}
+
+func (w *responseRecorder) WriteHeader(status int) {
+	if w.statusCode == 0 {
+		w.statusCode = status
+		w.header = w.ResponseWriter.Header().Clone()
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	if w.statusCode == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) status() int {
+	if w.statusCode == 0 {
+		return http.StatusOK
+	}
+	return w.statusCode
+}
+
+func (w *responseRecorder) response() *IdempotentResponse {
+	header := w.header
+	if header == nil {
+		header = w.ResponseWriter.Header().Clone()
+	}
+	return &IdempotentResponse{
+		StatusCode: w.status(),
+		Header:     header,
+		Body:       w.body.Bytes(),
+	}
+}
@@ -0,0 +1,203 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*IdempotencyRecord
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: make(map[string]*IdempotencyRecord)}
+}
+
+func (s *memoryStore) Reserve(_ context.Context, key string, requestHash []byte, _ time.Time) (*IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		return record, nil
+	}
+	s.records[key] = &IdempotencyRecord{RequestHash: requestHash}
+	return nil, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, key string, response *IdempotentResponse, _ time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[key].Response = response
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	var (
+		calls  int
+		panics bool
+	)
+	status := http.StatusCreated
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if panics {
+			panic("handler failed")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(calls) + `}`))
+	})
+
+	var handledErr error
+	errorHandler := func(w http.ResponseWriter, r *http.Request, err error) {
+		handledErr = err
+		w.WriteHeader(http.StatusTeapot)
+	}
+
+	store := newMemoryStore()
+	handler := Idempotency(store, time.Hour, time.Minute, errorHandler)(next)
+
+	sendTo := func(method, target, key, body string) *httptest.ResponseRecorder {
+		handledErr = nil
+		r := httptest.NewRequest(method, target, strings.NewReader(body))
+		if key != "" {
+			r.Header.Set(IdempotencyKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+	send := func(method, key, body string) *httptest.ResponseRecorder {
+		return sendTo(method, "/v1/sequences", key, body)
+	}
+
+	t.Run("replays the first response", func(t *testing.T) {
+		first := send(http.MethodPost, "key-1", `{"name":"a"}`)
+		assert.Equal(t, http.StatusCreated, first.Code)
+		assert.Equal(t, `{"call":1}`, first.Body.String())
+		assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+
+		second := send(http.MethodPost, "key-1", `{"name":"a"}`)
+		assert.Equal(t, http.StatusCreated, second.Code)
+		assert.Equal(t, `{"call":1}`, second.Body.String())
+		assert.Equal(t, "application/json", second.Header().Get("Content-Type"))
+		assert.Equal(t, "true", second.Header().Get(IdempotentReplayedHeader))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("rejects a different body", func(t *testing.T) {
+		send(http.MethodPost, "key-1", `{"name":"b"}`)
+		assert.ErrorIs(t, handledErr, ErrIdempotencyKeyReused)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("rejects a different query", func(t *testing.T) {
+		sendTo(http.MethodPost, "/v1/sequences?dryRun=true", "key-1", `{"name":"a"}`)
+		assert.ErrorIs(t, handledErr, ErrIdempotencyKeyReused)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("rejects a key in progress", func(t *testing.T) {
+		store.records["key-2"] = &IdempotencyRecord{RequestHash: requestHash(
+			httptest.NewRequest(http.MethodPost, "/v1/sequences", nil), []byte(`{}`),
+		)}
+
+		send(http.MethodPost, "key-2", `{}`)
+		assert.ErrorIs(t, handledErr, ErrIdempotencyKeyInUse)
+	})
+
+	t.Run("rejects a long key", func(t *testing.T) {
+		send(http.MethodPost, strings.Repeat("k", 256), `{}`)
+		assert.ErrorIs(t, handledErr, ErrIdempotencyKeyInvalid)
+	})
+
+	t.Run("releases the key on server errors", func(t *testing.T) {
+		calls = 0
+		status = http.StatusInternalServerError
+		send(http.MethodPost, "key-3", `{}`)
+		assert.NotContains(t, store.records, "key-3")
+
+		status = http.StatusCreated
+		w := send(http.MethodPost, "key-3", `{}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("releases the key when the handler panics", func(t *testing.T) {
+		calls = 0
+		panics = true
+		assert.Panics(t, func() { send(http.MethodPost, "key-5", `{}`) })
+		assert.NotContains(t, store.records, "key-5")
+
+		panics = false
+		w := send(http.MethodPost, "key-5", `{}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("rejects large bodies", func(t *testing.T) {
+		calls = 0
+		send(http.MethodPost, "key-6", strings.Repeat("x", maxIdempotentBodySize+1))
+		var maxBytesErr *http.MaxBytesError
+		assert.ErrorAs(t, handledErr, &maxBytesErr)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("ignores requests without a key", func(t *testing.T) {
+		calls = 0
+		send(http.MethodPost, "", `{}`)
+		send(http.MethodPost, "", `{}`)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("ignores other methods", func(t *testing.T) {
+		calls = 0
+		send(http.MethodPut, "key-4", `{}`)
+		send(http.MethodPut, "key-4", `{}`)
+		assert.Equal(t, 2, calls)
+		assert.NotContains(t, store.records, "key-4")
+	})
+}
+
+type failingStore struct {
+	memoryStore
+}
+
+func (s *failingStore) Reserve(context.Context, string, []byte, time.Time) (*IdempotencyRecord, error) {
+	return nil, errors.New("store unavailable")
+}
+
+func TestIdempotencyStoreError(t *testing.T) {
+	var handledErr error
+	handler := Idempotency(&failingStore{}, time.Hour, time.Minute, func(w http.ResponseWriter, r *http.Request, err error) {
+		handledErr = err
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("handler must not be called")
+	}))
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/sequences", strings.NewReader(`{}`))
+	r.Header.Set(IdempotencyKeyHeader, "key")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	require.Error(t, handledErr)
+	assert.Contains(t, handledErr.Error(), "store unavailable")
+}
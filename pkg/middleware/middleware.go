@@ -1,8 +1,11 @@
 package middleware
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
+	"net/netip"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/pirellik/sequence-api/pkg/logger"
@@ -30,6 +33,49 @@ func RequestID(next http.Handler) http.Handler {
 	})
 }
 
+type clientIPKey struct{}
+
+// ClientIP stores the IP address of the client in the request context, where
+// ClientIPFrom finds it. That is the address the request came from, unless it
+// came from one of trustedProxies: then the X-Forwarded-For header is read
+// from the right, skipping the proxies, since anything to the left of the
+// address the last trusted proxy added may have been made up by the client.
+func ClientIP(trustedProxies []netip.Prefix) func(http.Handler) http.Handler {
+	trusted := func(ip netip.Addr) bool {
+		for _, prefix := range trustedProxies {
+			if prefix.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var ip netip.Addr
+			if addrPort, err := netip.ParseAddrPort(r.RemoteAddr); err == nil {
+				ip = addrPort.Addr().Unmap()
+			}
+			hops := strings.Split(r.Header.Get("X-Forwarded-For"), ",")
+			for i := len(hops) - 1; i >= 0 && ip.IsValid() && trusted(ip); i-- {
+				hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+				if err != nil {
+					break
+				}
+				ip = hop.Unmap()
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip)))
+		})
+	}
+}
+
+// ClientIPFrom returns the IP address stored by ClientIP, which is invalid
+// when it is unknown.
+func ClientIPFrom(ctx context.Context) netip.Addr {
+	ip, _ := ctx.Value(clientIPKey{}).(netip.Addr)
+	return ip
+}
+
 func Logging(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		writer := &writerWithStatus{ResponseWriter: w}
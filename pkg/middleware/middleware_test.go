@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIP(t *testing.T) {
+	proxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")}
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{"remote address", "203.0.113.7:51234", "", "203.0.113.7"},
+		{"ipv6", "[2001:db8::1]:443", "", "2001:db8::1"},
+		{"mapped ipv4", "[::ffff:203.0.113.7]:51234", "", "203.0.113.7"},
+		{"unknown", "pipe", "", "invalid IP"},
+		{"forwarded by a trusted proxy", "10.0.0.1:80", "198.51.100.4", "198.51.100.4"},
+		{"forwarded by trusted proxies", "10.0.0.1:80", "198.51.100.4, 10.0.0.2", "198.51.100.4"},
+		{"made up by the client", "10.0.0.1:80", "17.0.0.1, 198.51.100.4", "198.51.100.4"},
+		{"forwarded by an untrusted client", "203.0.113.7:51234", "17.0.0.1", "203.0.113.7"},
+		{"trusted proxy without header", "[fd00::1]:80", "", "fd00::1"},
+		{"malformed header", "10.0.0.1:80", "198.51.100.4, unknown", "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got netip.Addr
+			handler := ClientIP(proxies)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = ClientIPFrom(r.Context())
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			if tt.forwarded != "" {
+				r.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}